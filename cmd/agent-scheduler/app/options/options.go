@@ -48,6 +48,7 @@ const (
 	defaultLockObjectNamespace        = "volcano-system"
 	defaultNodeWorkers                = 20
 	defaultScheduleWorkerCount        = 1
+	defaultDecisionLogTopNodes        = 3
 )
 
 // ServerOption is the main context object for the controller manager.
@@ -119,6 +120,9 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.Uint32Var(&s.NodeWorkerThreads, "node-worker-threads", defaultNodeWorkers, "The number of threads syncing node operations.")
 	fs.DurationVar(&s.ResourceSyncTimeout, "resource-sync-timeout", defaultResourceSyncTimeout, "timeout on waiting for handler handling initial resources synchronization before starting scheduler, default is 60s, 0 skip waiting")
 	fs.BoolVar(&s.DisableDefaultSchedulerConfig, "disable-default-scheduler-config", false, "The flag indicates whether the scheduler should avoid using the default configuration if the provided scheduler configuration is invalid.")
+	fs.StringVar(&s.DecisionLogSink, "decision-log-sink", "", "The sink the binding decision of every bound pod is written to, stdout|file|event; the decisions are not written if it is empty")
+	fs.StringVar(&s.DecisionLogFile, "decision-log-file", "", "The file the binding decisions are appended to when --decision-log-sink is file")
+	fs.IntVar(&s.DecisionLogTopNodes, "decision-log-top-nodes", defaultDecisionLogTopNodes, "The number of the alternative nodes with the highest scores kept in a binding decision")
 	fs.Uint32Var(&s.ScheduleWorkerCount, "scheduler-worker-count", defaultScheduleWorkerCount, "The flag indicates the number of worker threads scheduling in parallel")
 	fs.StringVar(&s.ShardingMode, "scheduler-sharding-mode", util.NoneShardingMode, "The node sharding mode for scheduling, the mode could be none(default, schedule pod without shard)|hard(schedule pod within nodes in shard)|soft(chedule pod within nodes in shard in priority)")
 	fs.StringVar(&s.ShardName, "scheduler-sharding-name", defaultShardName, "The name of shard used for this scheduler, scheduler schedule pods based on nodes in shard if sharding mode is hard/soft")
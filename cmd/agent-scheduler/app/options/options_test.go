@@ -79,6 +79,7 @@ func TestAddFlags(t *testing.T) {
 		MinPercentageOfNodesToFind:    defaultMinPercentageOfNodesToFind,
 		PercentageOfNodesToFind:       defaultPercentageOfNodesToFind,
 		NodeWorkerThreads:             defaultNodeWorkers,
+		DecisionLogTopNodes:           defaultDecisionLogTopNodes,
 		CacheDumpFileDir:              "/tmp",
 		DisableDefaultSchedulerConfig: false,
 		ResourceSyncTimeout:           60 * time.Second,
@@ -51,6 +51,12 @@ const (
 	defaultPercentageOfNodesToFind    = 0
 	defaultLockObjectNamespace        = "volcano-system"
 	defaultNodeWorkers                = 20
+	defaultDecisionTraceSessions      = 10
+	defaultRuntimeHistoryConfigMap    = "volcano-system/volcano-scheduler-runtime-history"
+	defaultCheckpointEvictionTimeout  = 5 * time.Minute
+	defaultSessionTriggerPriority     = 1000
+	defaultMinSessionInterval         = 100 * time.Millisecond
+	defaultDecisionLogTopNodes        = 3
 )
 
 var (
@@ -99,6 +105,42 @@ type ServerOption struct {
 	EnableCacheDumper bool
 	NodeWorkerThreads uint32
 
+	// EnableDecisionTrace records the predicate failures, node scores and statement operations of each task
+	// and serves them on the /debug/decisions endpoint of the metrics server.
+	EnableDecisionTrace bool
+	// DecisionTraceSessions is the number of the most recent sessions whose decisions are kept.
+	DecisionTraceSessions int
+	// EnableWhatIf serves dry-run placement of hypothetical jobs against the live cache snapshot on /debug/whatif.
+	EnableWhatIf bool
+	// EnableRuntimePredictor predicts the running duration of jobs from the completed Volcano Jobs with the same
+	// queue, namespace and images.
+	EnableRuntimePredictor bool
+	// RuntimeHistoryConfigMap is the namespace/name of the ConfigMap the runtime history is persisted in.
+	RuntimeHistoryConfigMap string
+	// CheckpointEvictionTimeout is the maximum time the scheduler waits for a pod which opted in to checkpoint
+	// before eviction to acknowledge the checkpoint request before evicting it.
+	CheckpointEvictionTimeout time.Duration
+	// EnableIncrementalSnapshot keeps a copy of the cache which only the changes since the last snapshot are applied
+	// to, instead of copying all the jobs, nodes and queues under the lock of the cache for every session.
+	EnableIncrementalSnapshot bool
+	// EnableSessionTrigger starts a session ahead of the schedule period once a job with a priority of at least
+	// SessionTriggerPriority gets pending tasks.
+	EnableSessionTrigger bool
+	// SessionTriggerPriority is the lowest priority of the jobs which trigger a session.
+	SessionTriggerPriority int32
+	// MinSessionInterval is the minimum time between the starts of two sessions when a session is triggered.
+	MinSessionInterval time.Duration
+	// EnableQueuePosition sets the Queued condition on the waiting PodGroups, which tells their position in their
+	// queue, on session close.
+	EnableQueuePosition bool
+	// DecisionLogSink is where the binding decision of every bound pod is written to, one of stdout, file and
+	// event; the decisions are not written if it is empty.
+	DecisionLogSink string
+	// DecisionLogFile is the file the decisions are appended to by the file sink.
+	DecisionLogFile string
+	// DecisionLogTopNodes is the number of the alternative nodes with the highest scores kept in a decision.
+	DecisionLogTopNodes int
+
 	// GateRemovalWorkerNum is the number of async workers for scheduling gate removal.
 	// Only used when SchedulingGatesQueueAdmission feature gate is enabled.
 	GateRemovalWorkerNum int
@@ -179,6 +221,20 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.EnableCacheDumper, "cache-dumper", true, "Enable the cache dumper, it's true by default")
 	fs.StringVar(&s.CacheDumpFileDir, "cache-dump-dir", "/tmp", "The target dir where the json file put at when dump cache info to json file")
 	fs.Uint32Var(&s.NodeWorkerThreads, "node-worker-threads", defaultNodeWorkers, "The number of threads syncing node operations.")
+	fs.BoolVar(&s.EnableDecisionTrace, "enable-decision-trace", false, "Enable tracing the scheduling decisions of each task and serve them on /debug/decisions; it is false by default")
+	fs.BoolVar(&s.EnableWhatIf, "enable-what-if", false, "Enable the dry-run placement of hypothetical jobs against the live cache snapshot on /debug/whatif; it is false by default")
+	fs.BoolVar(&s.EnableRuntimePredictor, "enable-runtime-predictor", false, "Enable predicting the running duration of jobs from the history of completed Volcano Jobs; it is false by default")
+	fs.StringVar(&s.RuntimeHistoryConfigMap, "runtime-history-configmap", defaultRuntimeHistoryConfigMap, "The namespace/name of the ConfigMap the runtime history of completed jobs is persisted in")
+	fs.DurationVar(&s.CheckpointEvictionTimeout, "checkpoint-eviction-timeout", defaultCheckpointEvictionTimeout, "The maximum time to wait for a pod which opted in to checkpoint before eviction to acknowledge the checkpoint before evicting it")
+	fs.BoolVar(&s.EnableIncrementalSnapshot, "enable-incremental-snapshot", false, "Enable applying only the changes since the last session to the snapshot of the cache; it is false by default")
+	fs.BoolVar(&s.EnableSessionTrigger, "enable-session-trigger", false, "Enable starting a session ahead of the schedule period when a job with a priority of at least --session-trigger-priority arrives; it is false by default")
+	fs.Int32Var(&s.SessionTriggerPriority, "session-trigger-priority", defaultSessionTriggerPriority, "The lowest priority of the jobs which start a session ahead of the schedule period when session trigger is enabled")
+	fs.DurationVar(&s.MinSessionInterval, "min-session-interval", defaultMinSessionInterval, "The minimum time between the starts of two sessions when a session is started ahead of the schedule period")
+	fs.BoolVar(&s.EnableQueuePosition, "enable-queue-position", false, "Enable setting the position of the waiting podgroups in their queue on their status; it is false by default")
+	fs.StringVar(&s.DecisionLogSink, "decision-log-sink", "", "The sink the binding decision of every bound pod is written to, stdout|file|event; the decisions are not written if it is empty")
+	fs.StringVar(&s.DecisionLogFile, "decision-log-file", "", "The file the binding decisions are appended to when --decision-log-sink is file")
+	fs.IntVar(&s.DecisionLogTopNodes, "decision-log-top-nodes", defaultDecisionLogTopNodes, "The number of the alternative nodes with the highest scores kept in a binding decision")
+	fs.IntVar(&s.DecisionTraceSessions, "decision-trace-sessions", defaultDecisionTraceSessions, "The number of the most recent sessions whose scheduling decisions are kept when decision trace is enabled")
 	fs.IntVar(&s.GateRemovalWorkerNum, "gate-removal-worker-num", 5, "The number of async workers for scheduling gate removal (used when SchedulingGatesQueueAdmission is enabled).")
 	fs.StringSliceVar(&s.IgnoredCSIProvisioners, "ignored-provisioners", nil, "The provisioners that will be ignored during pod pvc request computation and preemption.")
 	fs.DurationVar(&s.ResourceSyncTimeout, "resource-sync-timeout", defaultResourceSyncTimeout, "timeout on waiting for handler handling initial resources synchronization before starting scheduler, default is 60s, 0 skip waiting")
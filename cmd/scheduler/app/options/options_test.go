@@ -83,6 +83,12 @@ func TestAddFlags(t *testing.T) {
 		MinPercentageOfNodesToFind:    defaultMinPercentageOfNodesToFind,
 		PercentageOfNodesToFind:       defaultPercentageOfNodesToFind,
 		NodeWorkerThreads:             defaultNodeWorkers,
+		DecisionTraceSessions:         defaultDecisionTraceSessions,
+		RuntimeHistoryConfigMap:       defaultRuntimeHistoryConfigMap,
+		CheckpointEvictionTimeout:     defaultCheckpointEvictionTimeout,
+		SessionTriggerPriority:        defaultSessionTriggerPriority,
+		MinSessionInterval:            defaultMinSessionInterval,
+		DecisionLogTopNodes:           defaultDecisionLogTopNodes,
 		GateRemovalWorkerNum:          5,
 		CacheDumpFileDir:              "/tmp",
 		DisableDefaultSchedulerConfig: false,
@@ -29,6 +29,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/simulator"
 	"volcano.sh/volcano/pkg/signals"
 	commonutil "volcano.sh/volcano/pkg/util"
 
@@ -80,8 +81,8 @@ func Run(opt *options.ServerOption) error {
 	// k8smetrics.Goroutines which is used by Kubernetes scheduler framework plugins
 	metrics.InitKubeSchedulerRelatedMetrics()
 
-	if opt.EnableMetrics || opt.EnablePprof {
-		go startMetricsServer(opt)
+	if opt.EnableMetrics || opt.EnablePprof || opt.EnableDecisionTrace || opt.EnableWhatIf {
+		go startMetricsServer(opt, sched)
 	}
 
 	if opt.EnableHealthz {
@@ -151,7 +152,7 @@ func Run(opt *options.ServerOption) error {
 	return fmt.Errorf("lost lease")
 }
 
-func startMetricsServer(opt *options.ServerOption) {
+func startMetricsServer(opt *options.ServerOption, sched *scheduler.Scheduler) {
 	mux := http.NewServeMux()
 
 	if opt.EnableMetrics {
@@ -166,6 +167,14 @@ func startMetricsServer(opt *options.ServerOption) {
 		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 	}
 
+	if tracer := sched.DecisionTracer(); tracer != nil {
+		mux.Handle("/debug/decisions", tracer)
+	}
+
+	if opt.EnableWhatIf {
+		mux.Handle("/debug/whatif", simulator.NewWhatIfHandler(sched))
+	}
+
 	server := &http.Server{
 		Addr:              opt.ListenAddress,
 		Handler:           mux,
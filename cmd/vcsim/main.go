@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/component-base/cli"
+
+	"volcano.sh/volcano/cmd/cli/util"
+	"volcano.sh/volcano/pkg/scheduler/simulator"
+
+	// Import default actions/plugins.
+	_ "volcano.sh/volcano/pkg/scheduler/actions"
+	_ "volcano.sh/volcano/pkg/scheduler/plugins"
+)
+
+type simulateFlags struct {
+	snapshot      string
+	schedulerConf string
+	output        string
+}
+
+func main() {
+	flags := &simulateFlags{}
+	rootCmd := cobra.Command{
+		Use:   "vcsim",
+		Short: "replay a scheduler cache snapshot offline",
+		Long: `replay a scheduler cache snapshot dumped by vc-scheduler through the actions and plugins of a
+scheduler configuration, and print the bind, pipeline and evict decisions without an api server.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckError(cmd, run(flags, os.Stdout))
+		},
+	}
+
+	rootCmd.Flags().StringVarP(&flags.snapshot, "snapshot", "s", "", "the snapshot-<ts>.json file dumped by vc-scheduler")
+	rootCmd.Flags().StringVarP(&flags.schedulerConf, "scheduler-conf", "c", "", "the scheduler configuration file, the default configuration is used if empty")
+	rootCmd.Flags().StringVarP(&flags.output, "output", "o", "wide", "output format, one of: wide, json")
+
+	code := cli.Run(&rootCmd)
+	os.Exit(code)
+}
+
+func run(flags *simulateFlags, out io.Writer) error {
+	if flags.snapshot == "" {
+		return fmt.Errorf("the snapshot file is required")
+	}
+
+	var schedulerConf string
+	if flags.schedulerConf != "" {
+		data, err := os.ReadFile(flags.schedulerConf)
+		if err != nil {
+			return fmt.Errorf("failed to read scheduler configuration: %v", err)
+		}
+		schedulerConf = string(data)
+	}
+
+	snapshot, err := simulator.LoadSnapshotFile(flags.snapshot)
+	if err != nil {
+		return err
+	}
+	sim, err := simulator.New(schedulerConf)
+	if err != nil {
+		return err
+	}
+	result, err := sim.Run(snapshot)
+	if err != nil {
+		return err
+	}
+
+	switch flags.output {
+	case "json":
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	case "wide", "":
+		printResult(result, out)
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %s", flags.output)
+	}
+}
+
+func printResult(result *simulator.Result, out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "DECISION\tTASK\tJOB\tNODE\n")
+	for _, d := range result.Binds {
+		fmt.Fprintf(w, "Bind\t%s\t%s\t%s\n", d.Task, d.Job, d.Node)
+	}
+	for _, d := range result.Pipelines {
+		fmt.Fprintf(w, "Pipeline\t%s\t%s\t%s\n", d.Task, d.Job, d.Node)
+	}
+	for _, d := range result.Evictions {
+		fmt.Fprintf(w, "Evict\t%s\t%s\t%s\n", d.Task, d.Job, d.Node)
+	}
+	w.Flush()
+
+	if len(result.Pending) == 0 {
+		return
+	}
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "PENDING JOB\tQUEUE\tPHASE\tREASON\n")
+	for _, p := range result.Pending {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Job, p.Queue, p.Phase, p.Reason)
+	}
+	w.Flush()
+}
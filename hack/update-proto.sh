@@ -0,0 +1,79 @@
+#!/usr/bin/env bash
+
+# Copyright 2026 The Volcano Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Regenerates the Go code of the protobuf APIs with pinned versions of protoc and its plugins, which are
+# installed into _output/protoc on first use.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT=$(unset CDPATH && cd "$(dirname "${BASH_SOURCE[0]}")"/.. && pwd)
+
+PROTOC_VERSION=${PROTOC_VERSION:-29.3}
+PROTOC_GEN_GO_VERSION=${PROTOC_GEN_GO_VERSION:-v1.36.11}
+PROTOC_GEN_GO_GRPC_VERSION=${PROTOC_GEN_GO_GRPC_VERSION:-v1.6.0}
+
+PROTOC_DIR=${SCRIPT_ROOT}/_output/protoc/${PROTOC_VERSION}
+PROTO_DIRS=(
+  pkg/scheduler/plugins/extender/proto/v1
+)
+
+install_protoc() {
+  if [[ -x "${PROTOC_DIR}/bin/protoc" ]]; then
+    return
+  fi
+
+  local os arch
+  case "$(uname -s)" in
+    Linux) os=linux ;;
+    Darwin) os=osx ;;
+    *) echo "unsupported OS $(uname -s)" >&2; exit 1 ;;
+  esac
+  case "$(uname -m)" in
+    x86_64 | amd64) arch=x86_64 ;;
+    aarch64 | arm64) arch=aarch_64 ;;
+    *) echo "unsupported architecture $(uname -m)" >&2; exit 1 ;;
+  esac
+
+  local tmp
+  tmp=$(mktemp -d)
+  trap "rm -rf ${tmp}" RETURN
+  curl -sSfL -o "${tmp}/protoc.zip" \
+    "https://github.com/protocolbuffers/protobuf/releases/download/v${PROTOC_VERSION}/protoc-${PROTOC_VERSION}-${os}-${arch}.zip"
+  mkdir -p "${PROTOC_DIR}"
+  unzip -q -o "${tmp}/protoc.zip" -d "${PROTOC_DIR}"
+}
+
+install_plugins() {
+  GOBIN="${PROTOC_DIR}/bin" go install "google.golang.org/protobuf/cmd/protoc-gen-go@${PROTOC_GEN_GO_VERSION}"
+  GOBIN="${PROTOC_DIR}/bin" go install "google.golang.org/grpc/cmd/protoc-gen-go-grpc@${PROTOC_GEN_GO_GRPC_VERSION}"
+}
+
+install_protoc
+install_plugins
+
+export PATH="${PROTOC_DIR}/bin:${PATH}"
+for dir in "${PROTO_DIRS[@]}"; do
+  echo "generating ${dir}"
+  (
+    cd "${SCRIPT_ROOT}/${dir}"
+    protoc -I . -I "${PROTOC_DIR}/include" \
+      --go_out=paths=source_relative:. \
+      --go-grpc_out=paths=source_relative:. \
+      *.proto
+  )
+done
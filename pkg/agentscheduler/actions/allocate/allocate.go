@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/klog/v2"
 
 	"volcano.sh/volcano/cmd/agent-scheduler/app/options"
@@ -28,6 +29,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/api"
 	vcache "volcano.sh/volcano/pkg/scheduler/cache"
 	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/decisionlog"
 	vfwk "volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/util"
 	commonutil "volcano.sh/volcano/pkg/util"
@@ -104,11 +106,14 @@ func (alloc *Action) allocateTask(fwk *framework.Framework, schedCtx *agentapi.S
 		}
 		return err
 	}
-	bestNodes := alloc.prioritizeNodes(fwk, task, predicatedNodes, schedCtx.NodesInShard)
+	bestNodes, nodeScores := alloc.prioritizeNodes(fwk, task, predicatedNodes, schedCtx.NodesInShard)
+	bindContext := alloc.CreateBindContext(fwk, schedCtx)
+	bindContext.NodeScores = nodeScores
 	result := &agentapi.PodScheduleResult{
-		SuggestedNodes: bestNodes,
-		SchedCtx:       schedCtx,
-		BindContext:    alloc.CreateBindContext(fwk, schedCtx),
+		SuggestedNodes:   bestNodes,
+		SchedCtx:         schedCtx,
+		BindContext:      bindContext,
+		ScheduleCycleUID: bindContext.ScheduleCycleUID,
 	}
 	fwk.Cache.RecordCandidateNodesInBinder(bestNodes)
 	alloc.SendResultToBinder(fwk, result)
@@ -119,7 +124,7 @@ func (alloc *Action) allocateTask(fwk *framework.Framework, schedCtx *agentapi.S
 func (alloc *Action) predicateFeasibleNodes(fwk *framework.Framework, task *api.TaskInfo, allNodes []*api.NodeInfo, nodesInShard sets.Set[string]) ([]*api.NodeInfo, *api.FitErrors) {
 	var predicateNodes []*api.NodeInfo
 	var fitErrors *api.FitErrors
-	ph := util.NewPredicateHelper()
+	ph := util.NewPredicateHelper(false)
 
 	// Create a predicate closure that captures fwk for this scheduling cycle.
 	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
@@ -159,8 +164,9 @@ func (alloc *Action) predicateFeasibleNodes(fwk *framework.Framework, task *api.
 	return predicateNodes, nil
 }
 
-// prioritizeNodes selects the highest score node that idle resource meet task requirement.
-func (alloc *Action) prioritizeNodes(fwk *framework.Framework, task *api.TaskInfo, predicateNodes []*api.NodeInfo, nodesInShard sets.Set[string]) []*api.NodeInfo {
+// prioritizeNodes selects the highest score node that idle resource meet task requirement. It also returns the highest
+// scores of the nodes if the decision log is enabled.
+func (alloc *Action) prioritizeNodes(fwk *framework.Framework, task *api.TaskInfo, predicateNodes []*api.NodeInfo, nodesInShard sets.Set[string]) ([]*api.NodeInfo, []decisionlog.NodeScore) {
 	var candidateNodes [2][]*api.NodeInfo
 	var candidateNodesInShard []*api.NodeInfo
 	var candidateNodesInOtherShards []*api.NodeInfo
@@ -186,6 +192,7 @@ func (alloc *Action) prioritizeNodes(fwk *framework.Framework, task *api.TaskInf
 	candidateNodes[1] = candidateNodesInOtherShards
 
 	var bestNodes = []*api.NodeInfo{}
+	var scores []decisionlog.NodeScore
 	for index, nodes := range candidateNodes {
 		if index > 0 && shardingMode != commonutil.SoftShardingMode {
 			//only SoftShardingMode need check nodes in other shard
@@ -202,20 +209,25 @@ func (alloc *Action) prioritizeNodes(fwk *framework.Framework, task *api.TaskInf
 		case len(nodes) == 1: // If only one node after predicate, just use it.
 			bestNodes = append(bestNodes, nodes[0])
 		case len(nodes) > 1: // If more than one node after predicate, using "the best" one
-			nodeScores := util.PrioritizeNodes(task, nodes, fwk.BatchNodeOrderFn, fwk.NodeOrderMapFn, fwk.NodeOrderReduceFn)
+			nodeScores := util.PrioritizeNodes(task, nodes, fwk.BatchNodeOrderFn, fwk.NodeOrderMapFn, fwk.NodeOrderReduceFn, false)
 			bestNodes = util.SelectBestNodes(nodeScores, alloc.candidateNodeCount, fwk.GetSnapshot().NodesInBinder)
+			if options.ServerOpts.DecisionLogSink != "" {
+				// One more than the alternatives, which are still enough once the chosen node is taken out.
+				scores = decisionlog.TopNodeScores(nodeScores, options.ServerOpts.DecisionLogTopNodes+1)
+			}
 		}
 		if len(bestNodes) > 0 {
 			break
 		}
 	}
-	return bestNodes
+	return bestNodes, scores
 }
 
 func (alloc *Action) CreateBindContext(fwk *framework.Framework, schedCtx *agentapi.SchedulingContext) *agentapi.BindContext {
 	bindContext := &agentapi.BindContext{
-		SchedCtx:   schedCtx,
-		Extensions: make(map[string]vcache.BindContextExtension),
+		SchedCtx:         schedCtx,
+		Extensions:       make(map[string]vcache.BindContextExtension),
+		ScheduleCycleUID: uuid.NewUUID(),
 	}
 
 	for _, plugin := range fwk.Plugins {
@@ -23,6 +23,7 @@ import (
 
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/decisionlog"
 )
 
 // SchedulingContext contains all information needed for scheduling a task
@@ -39,6 +40,10 @@ type BindContext struct {
 	SchedCtx *SchedulingContext
 	// Extensions stores extra bind context information of each plugin
 	Extensions map[string]cache.BindContextExtension
+	// ScheduleCycleUID is the uid of the scheduling cycle which placed the pod.
+	ScheduleCycleUID types.UID
+	// NodeScores are the highest scores of the nodes for the pod, only kept if the decision log is enabled.
+	NodeScores []decisionlog.NodeScore
 }
 
 // PodScheduleResult contains the scheduling result for a pod
@@ -66,6 +66,7 @@ import (
 	agentapi "volcano.sh/volcano/pkg/agentscheduler/api"
 	"volcano.sh/volcano/pkg/features"
 	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/decisionlog"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
 	k8sutil "volcano.sh/volcano/pkg/scheduler/plugins/util/k8s"
 	schedulercache "volcano.sh/volcano/pkg/schedulercommon/cache"
@@ -162,6 +163,9 @@ type SchedulerCache struct {
 
 	shardingMode string
 
+	// decisionLog writes the binding decisions of the bound pods, nil if it is disabled.
+	decisionLog *decisionlog.Log
+
 	registeredHandlers map[string]cache.ResourceEventHandlerRegistration
 
 	// timeout on waiting for handlers handle initial resource synchronization before starting scheduling, 0 will skip waiting
@@ -315,6 +319,23 @@ func (sc *SchedulerCache) setBatchBindParallel() {
 	}
 }
 
+// setDecisionLog sets the log of the binding decisions if a sink is configured.
+func (sc *SchedulerCache) setDecisionLog(opt *options.ServerOption) {
+	if opt.DecisionLogSink == "" {
+		return
+	}
+	sink, err := decisionlog.NewSink(opt.DecisionLogSink, opt.DecisionLogFile, sc.Recorder)
+	if err != nil {
+		klog.Errorf("Failed to create the sink of the decision log, decision log is disabled: %v", err)
+		return
+	}
+	var shard string
+	if opt.ShardingMode == util.HardShardingMode || opt.ShardingMode == util.SoftShardingMode {
+		shard = opt.ShardName
+	}
+	sc.decisionLog = decisionlog.NewLog(sc.schedulerName, shard, opt.DecisionLogTopNodes, sink)
+}
+
 func newSchedulerCache(config *rest.Config, opt *options.ServerOption) *SchedulerCache {
 	kubeClient, err := kubernetes.NewForConfig(config)
 	if err != nil {
@@ -364,6 +385,7 @@ func newSchedulerCache(config *rest.Config, opt *options.ServerOption) *Schedule
 		binder = NewDefaultBinder(sc.kubeClient, sc.Recorder)
 	}
 	sc.Binder = GetBindMethod()
+	sc.setDecisionLog(opt)
 
 	sc.StatusUpdater = &defaultStatusUpdater{
 		kubeclient: sc.kubeClient,
@@ -655,6 +677,7 @@ func (sc *SchedulerCache) Bind(ctx context.Context, bindContexts []*agentapi.Bin
 		if reason, ok := errMsg[task.UID]; !ok {
 			sc.Recorder.Eventf(task.Pod, v1.EventTypeNormal, "Scheduled", "Successfully assigned %v/%v to %v", task.Namespace, task.Name, task.NodeName)
 			metrics.UpdateTaskScheduleDuration(metrics.TaskStageBound, metrics.Duration(task.Pod.CreationTimestamp.Time))
+			sc.decisionLog.Bound(task.Pod, task.NodeName, bindContext.ScheduleCycleUID, bindContext.NodeScores)
 		} else {
 			unschedulableMsg := fmt.Sprintf("failed to bind to node %s: %s", task.NodeName, reason)
 			if err := sc.TaskUnschedulable(task, schedulingapi.PodReasonSchedulerError, unschedulableMsg); err != nil {
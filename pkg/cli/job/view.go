@@ -32,7 +32,9 @@ import (
 	"k8s.io/client-go/rest"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/apis/pkg/client/clientset/versioned"
+	"volcano.sh/volcano/pkg/cli/podgroup"
 	"volcano.sh/volcano/pkg/cli/util"
 )
 
@@ -81,6 +83,7 @@ func ViewJob(ctx context.Context) error {
 		return nil
 	}
 	PrintJobInfo(job, os.Stdout)
+	PrintQueuePosition(GetPodGroup(ctx, jobClient, job), os.Stdout)
 	PrintEvents(GetEvents(ctx, config, job), os.Stdout)
 	return nil
 }
@@ -206,6 +209,28 @@ func PrintJobInfo(job *v1alpha1.Job, writer io.Writer) {
 	}
 }
 
+// PrintQueuePosition print the position of the waiting job in its queue into writer.
+func PrintQueuePosition(pg *schedulingv1beta1.PodGroup, writer io.Writer) {
+	if pg == nil {
+		return
+	}
+	if cond := podgroup.QueuedCondition(pg); cond != nil {
+		WriteLine(writer, Level0, "Queue Position:\t%s\n", cond.Message)
+	}
+}
+
+// GetPodGroup get the podgroup of the job, nil if it is not found.
+func GetPodGroup(ctx context.Context, jobClient versioned.Interface, job *v1alpha1.Job) *schedulingv1beta1.PodGroup {
+	// Podgroups are named after the job and its uid, the ones created by old controllers after the job only.
+	for _, name := range []string{fmt.Sprintf("%s-%s", job.Name, job.UID), job.Name} {
+		pg, err := jobClient.SchedulingV1beta1().PodGroups(job.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			return pg
+		}
+	}
+	return nil
+}
+
 // PrintEvents print event info to writer.
 func PrintEvents(events []coreV1.Event, writer io.Writer) {
 	if len(events) > 0 {
@@ -17,6 +17,7 @@ limitations under the License.
 package job
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"math"
@@ -31,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 )
 
 func TestViewJob(t *testing.T) {
@@ -162,3 +164,27 @@ func TestInitViewFlags(t *testing.T) {
 	}
 
 }
+
+func TestPrintQueuePosition(t *testing.T) {
+	pg := &schedulingv1beta1.PodGroup{
+		Status: schedulingv1beta1.PodGroupStatus{
+			Conditions: []schedulingv1beta1.PodGroupCondition{
+				{Type: schedulingv1beta1.PodGroupQueuedType, Message: "position 2 in queue q1, queue 1 in scheduling order"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	PrintQueuePosition(pg, &buf)
+	expected := "Queue Position:\tposition 2 in queue q1, queue 1 in scheduling order\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+
+	buf.Reset()
+	PrintQueuePosition(&schedulingv1beta1.PodGroup{}, &buf)
+	PrintQueuePosition(nil, &buf)
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing printed for podgroup which is not waiting, got %q", buf.String())
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podgroup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/apis/pkg/client/clientset/versioned"
+	"volcano.sh/volcano/pkg/cli/util"
+)
+
+const (
+	// Namespace of the podgroup
+	Namespace string = "Namespace"
+	// Name of the podgroup
+	Name string = "Name"
+	// Queue of the podgroup
+	Queue string = "Queue"
+	// Phase of the podgroup
+	Phase string = "Phase"
+	// MinMember of the podgroup
+	MinMember string = "MinMember"
+	// Position of the podgroup in its queue
+	Position string = "Position"
+)
+
+type listFlags struct {
+	util.CommonFlags
+
+	Namespace    string
+	QueueName    string
+	allNamespace bool
+}
+
+var listPodGroupFlags = &listFlags{}
+
+// InitListFlags inits all flags.
+func InitListFlags(cmd *cobra.Command) {
+	util.InitFlags(cmd, &listPodGroupFlags.CommonFlags)
+
+	cmd.Flags().StringVarP(&listPodGroupFlags.Namespace, "namespace", "n", "default", "the namespace of podgroup")
+	cmd.Flags().StringVarP(&listPodGroupFlags.QueueName, "queue", "q", "", "list podgroup with specified queue name")
+	cmd.Flags().BoolVarP(&listPodGroupFlags.allNamespace, "all-namespaces", "", false, "list podgroups in all namespaces")
+}
+
+// ListPodGroups lists the podgroups with their position in the queue.
+func ListPodGroups(ctx context.Context) error {
+	config, err := util.BuildConfig(listPodGroupFlags.Master, listPodGroupFlags.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	if listPodGroupFlags.allNamespace {
+		listPodGroupFlags.Namespace = ""
+	}
+
+	vcClient := versioned.NewForConfigOrDie(config)
+	pgList, err := vcClient.SchedulingV1beta1().PodGroups(listPodGroupFlags.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	var podGroups []v1beta1.PodGroup
+	for _, pg := range pgList.Items {
+		if listPodGroupFlags.QueueName == "" || pg.Spec.Queue == listPodGroupFlags.QueueName {
+			podGroups = append(podGroups, pg)
+		}
+	}
+	if len(podGroups) == 0 {
+		fmt.Printf("No resources found\n")
+		return nil
+	}
+
+	PrintPodGroups(podGroups, os.Stdout, listPodGroupFlags.allNamespace)
+	return nil
+}
+
+// PrintPodGroups prints podgroup information.
+func PrintPodGroups(podGroups []v1beta1.PodGroup, writer io.Writer, showNamespace bool) {
+	if showNamespace {
+		fmt.Fprintf(writer, "%-25s", Namespace)
+	}
+	_, err := fmt.Fprintf(writer, "%-50s%-25s%-12s%-12s%s\n", Name, Queue, Phase, MinMember, Position)
+	if err != nil {
+		fmt.Printf("Failed to print podgroup command result: %s.\n", err)
+	}
+
+	for _, pg := range podGroups {
+		if showNamespace {
+			fmt.Fprintf(writer, "%-25s", pg.Namespace)
+		}
+		position := "-"
+		if cond := QueuedCondition(&pg); cond != nil {
+			position = cond.Message
+		}
+		_, err = fmt.Fprintf(writer, "%-50s%-25s%-12s%-12d%s\n", pg.Name, pg.Spec.Queue, pg.Status.Phase, pg.Spec.MinMember, position)
+		if err != nil {
+			fmt.Printf("Failed to print podgroup command result: %s.\n", err)
+		}
+	}
+}
+
+// QueuedCondition returns the condition set by the scheduler on a waiting podgroup, which tells its position
+// in the queue; nil if the podgroup is not waiting.
+func QueuedCondition(pg *v1beta1.PodGroup) *v1beta1.PodGroupCondition {
+	for i, c := range pg.Status.Conditions {
+		if c.Type == v1beta1.PodGroupQueuedType {
+			return &pg.Status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podgroup
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func TestPrintPodGroups(t *testing.T) {
+	podGroups := []v1beta1.PodGroup{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: "ns1"},
+			Spec:       v1beta1.PodGroupSpec{Queue: "q1", MinMember: 2},
+			Status: v1beta1.PodGroupStatus{
+				Phase: v1beta1.PodGroupPending,
+				Conditions: []v1beta1.PodGroupCondition{
+					{Type: v1beta1.PodGroupUnschedulableType, Message: "0/1 nodes are available"},
+					{Type: v1beta1.PodGroupQueuedType, Message: "position 1 in queue q1, queue 1 in scheduling order"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pg2", Namespace: "ns1"},
+			Spec:       v1beta1.PodGroupSpec{Queue: "q1", MinMember: 1},
+			Status:     v1beta1.PodGroupStatus{Phase: v1beta1.PodGroupRunning},
+		},
+	}
+
+	var buf bytes.Buffer
+	PrintPodGroups(podGroups, &buf, true)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %q", buf.String())
+	}
+	if !strings.HasPrefix(lines[0], Namespace) || !strings.HasSuffix(lines[0], Position) {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+	if !strings.HasSuffix(lines[1], "position 1 in queue q1, queue 1 in scheduling order") {
+		t.Errorf("expected queue position of pg1, got %q", lines[1])
+	}
+	if !strings.HasSuffix(lines[2], "-") {
+		t.Errorf("expected no queue position of pg2, got %q", lines[2])
+	}
+}
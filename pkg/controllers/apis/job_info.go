@@ -18,12 +18,25 @@ package apis
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 )
 
+const (
+	// CheckpointKey is the pod annotation of the sequence number of the checkpoint the pod takes before it is killed.
+	CheckpointKey = "volcano.sh/checkpoint"
+	// CheckpointTimeoutKey is the pod annotation of the time in seconds the pod is given to take the checkpoint when
+	// it is killed by a planned action.
+	CheckpointTimeoutKey = "volcano.sh/checkpoint-timeout"
+	// CheckpointConfirmationFmt is the format of the termination message of the first container of a pod which has
+	// taken the checkpoint of the sequence number.
+	CheckpointConfirmationFmt = "checkpoint-%d done"
+)
+
 // JobInfo struct.
 type JobInfo struct {
 	Namespace string
@@ -33,6 +46,9 @@ type JobInfo struct {
 	Pods map[string]map[string]*v1.Pod
 	// Partitions taskName:PartitionInfo
 	Partitions map[string]*PartitionInfo
+	// Checkpoints podName:checkpoint confirmed by the pod when it was deleted. They are kept in memory only, so the
+	// checkpoints confirmed before the controller restarts are never committed.
+	Checkpoints map[string]batch.JobCheckpoint
 }
 
 type PartitionInfo struct {
@@ -48,8 +64,9 @@ func (ji *JobInfo) Clone() *JobInfo {
 		Name:      ji.Name,
 		Job:       ji.Job,
 
-		Pods:       make(map[string]map[string]*v1.Pod, len(ji.Pods)),
-		Partitions: make(map[string]*PartitionInfo, len(ji.Partitions)),
+		Pods:        make(map[string]map[string]*v1.Pod, len(ji.Pods)),
+		Partitions:  make(map[string]*PartitionInfo, len(ji.Partitions)),
+		Checkpoints: make(map[string]batch.JobCheckpoint, len(ji.Checkpoints)),
 	}
 
 	for key, pods := range ji.Pods {
@@ -59,6 +76,10 @@ func (ji *JobInfo) Clone() *JobInfo {
 		}
 	}
 
+	for podName, checkpoint := range ji.Checkpoints {
+		job.Checkpoints[podName] = checkpoint
+	}
+
 	for taskName, partitionInfo := range ji.Partitions {
 		job.Partitions[taskName] = &PartitionInfo{}
 		partition := make(map[string]map[string]*v1.Pod, len(partitionInfo.Partition))
@@ -211,6 +232,16 @@ func (ji *JobInfo) DeletePod(pod *v1.Pod) error {
 		}
 	}
 
+	// Only the last deleted pod of the name counts for the checkpoint.
+	if checkpoint, confirmed := GetConfirmedCheckpoint(pod); confirmed {
+		if ji.Checkpoints == nil {
+			ji.Checkpoints = make(map[string]batch.JobCheckpoint)
+		}
+		ji.Checkpoints[pod.Name] = checkpoint
+	} else {
+		delete(ji.Checkpoints, pod.Name)
+	}
+
 	if ji.Partitions != nil {
 		if partitionInfo, found := ji.Partitions[taskName]; found {
 			partitionID := GetPartitionID(pod)
@@ -255,3 +286,25 @@ func GetPartitionID(pod *v1.Pod) string {
 	}
 	return ""
 }
+
+// GetConfirmedCheckpoint returns the checkpoint the pod has confirmed by the termination message of its first
+// container, which it writes only after the checkpoint is done.
+func GetConfirmedCheckpoint(pod *v1.Pod) (batch.JobCheckpoint, bool) {
+	sequence, err := strconv.Atoi(pod.Annotations[CheckpointKey])
+	if err != nil || len(pod.Spec.Containers) == 0 {
+		return batch.JobCheckpoint{}, false
+	}
+	version, err := strconv.Atoi(pod.Annotations[batch.JobVersion])
+	if err != nil {
+		return batch.JobCheckpoint{}, false
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != pod.Spec.Containers[0].Name || status.State.Terminated == nil {
+			continue
+		}
+		if strings.TrimSpace(status.State.Terminated.Message) == fmt.Sprintf(CheckpointConfirmationFmt, sequence) {
+			return batch.JobCheckpoint{Sequence: int32(sequence), Version: int32(version)}, true
+		}
+	}
+	return batch.JobCheckpoint{}, false
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package apis
 
 import (
+	"reflect"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -334,6 +335,52 @@ func TestJobInfo_DeletePod(t *testing.T) {
 	}
 }
 
+// TestJobInfo_DeletePodCheckpoint tests that DeletePod records the checkpoint confirmed by the deleted pod
+func TestJobInfo_DeletePodCheckpoint(t *testing.T) {
+	buildPod := func(name, checkpoint, message string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-ns",
+				Annotations: map[string]string{
+					batch.TaskSpecKey: "task-1",
+					batch.JobVersion:  "2",
+					CheckpointKey:     checkpoint,
+				},
+			},
+			Spec: v1.PodSpec{Containers: []v1.Container{{Name: "main"}, {Name: "sidecar"}}},
+			Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{
+				{Name: "sidecar", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Message: "checkpoint-3 done"}}},
+				{Name: "main", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Message: message}}},
+			}},
+		}
+	}
+
+	ji := &JobInfo{
+		Pods: map[string]map[string]*v1.Pod{},
+		Checkpoints: map[string]batch.JobCheckpoint{
+			"pod-3": {Sequence: 2, Version: 1},
+		},
+	}
+	for _, pod := range []*v1.Pod{
+		buildPod("pod-1", "3", "checkpoint-3 done\n"),
+		buildPod("pod-2", "3", ""),
+		buildPod("pod-3", "3", "checkpoint-2 done"),
+	} {
+		if err := ji.DeletePod(pod); err != nil {
+			t.Fatalf("DeletePod failed: %v", err)
+		}
+	}
+
+	expected := map[string]batch.JobCheckpoint{"pod-1": {Sequence: 3, Version: 2}}
+	if !reflect.DeepEqual(ji.Checkpoints, expected) {
+		t.Errorf("DeletePod failed: expected checkpoints %v, got %v", expected, ji.Checkpoints)
+	}
+	if cloned := ji.Clone(); !reflect.DeepEqual(cloned.Checkpoints, expected) {
+		t.Errorf("Clone failed: expected checkpoints %v, got %v", expected, cloned.Checkpoints)
+	}
+}
+
 // TestGetPartitionID tests the GetPartitionID helper function
 func TestGetPartitionID(t *testing.T) {
 	// Pod with partition label
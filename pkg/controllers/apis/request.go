@@ -25,6 +25,17 @@ import (
 	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
 )
 
+// FailureClass is the class of the failure which triggers a request.
+type FailureClass string
+
+const (
+	// UserFailure is caused by the workload itself, e.g. the container exits with a non-zero code.
+	UserFailure FailureClass = ""
+	// InfrastructureFailure is caused by the cluster, e.g. the node is lost, the container is killed for out of
+	// memory or the devices of the pod fail to be allocated.
+	InfrastructureFailure FailureClass = "Infrastructure"
+)
+
 // Request struct.
 type Request struct {
 	Namespace   string
@@ -37,8 +48,11 @@ type Request struct {
 	PartitionID string
 	Event       v1alpha1.Event
 	ExitCode    int32
-	Action      v1alpha1.Action
-	JobVersion  int32
+	Failure     FailureClass
+	// NodeName is the node the pod of the request ran on.
+	NodeName   string
+	Action     v1alpha1.Action
+	JobVersion int32
 }
 
 // String function returns the request in string format.
@@ -31,3 +31,9 @@ const (
 	// is successfully deleted.
 	SuccessfulDeletePodReason = "SuccessfulDelete"
 )
+
+// BlockNodesReason is added in an event when the pods of a job are not scheduled to the nodes they failed on any more.
+const BlockNodesReason = "BlockNodes"
+
+// restartBackoffJitter is the maximum factor the restart backoff of a job is jittered by.
+const restartBackoffJitter = 0.2
@@ -19,11 +19,16 @@ package helpers
 import (
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	"volcano.sh/volcano/pkg/controllers/apis"
@@ -38,6 +43,22 @@ const (
 	// OutOfSyncKey is the pod annotation indicates that the pod should be restarted.
 	// And vcjob events (e.g. PodFailed, PodEvicted) of the pod with the annotation will be ignored.
 	OutOfSyncKey = "volcano.sh/controller-out-of-sync"
+	// CheckpointPluginName is the name of the job plugin which checkpoints the pods of the job before they are killed.
+	CheckpointPluginName = "checkpoint"
+	// MaxInfrastructureRetryKey is the job annotation of the maximum number of restarts caused by infrastructure
+	// failures, maxRetry of the job by default.
+	MaxInfrastructureRetryKey = "volcano.sh/max-infrastructure-retry"
+	// RestartBackoffKey is the job annotation of the delay before the pods of the job are recreated for its first
+	// restart, which is doubled for every following restart.
+	RestartBackoffKey = "volcano.sh/restart-backoff"
+	// MaxRestartBackoffKey is the job annotation of the maximum delay before the pods of the job are recreated.
+	MaxRestartBackoffKey = "volcano.sh/max-restart-backoff"
+	// DefaultMaxRestartBackoff is the default maximum delay before the pods of the job are recreated.
+	DefaultMaxRestartBackoff = 5 * time.Minute
+	// NodeFailureThresholdKey is the job annotation of the number of failures of the job on a node after which the
+	// pods of the job are not scheduled to the node any more. A kill of the job counts one failure on a node however
+	// many of its pods failed on it. Failed nodes are not tracked without it.
+	NodeFailureThresholdKey = "volcano.sh/node-failure-threshold"
 )
 
 // GetPodIndexUnderTask returns task Index.
@@ -175,6 +196,132 @@ func GetTaskReplicasUnderJob(taskName string, job *batch.Job) int32 {
 	return 0
 }
 
+// IsElasticJob checks whether the job is annotated as elastic.
+func IsElasticJob(job *batch.Job) bool {
+	return job.Annotations[api.JobElastic] == "true"
+}
+
+// GetRunningMembersUnderTask return the domain names of the running pods of the task in an elastic job, in the
+// order of their indices, from the pods of the job.
+func GetRunningMembersUnderTask(taskName string, job *batch.Job, pods []*v1.Pod) []string {
+	ts, found := GetTaskSpec(job, taskName)
+	if !found {
+		return nil
+	}
+	indices := make([]int, 0, len(pods))
+	for _, pod := range pods {
+		if pod.Status.Phase != v1.PodRunning || pod.DeletionTimestamp != nil || GetTaskKey(pod) != taskName {
+			continue
+		}
+		index, err := GetTaskIndexOfPod(pod)
+		if err != nil {
+			klog.Warningf("Failed to get the index of pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
+			continue
+		}
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	members := make([]string, 0, len(indices))
+	for _, index := range indices {
+		members = append(members, MakeDomainName(ts, job, index))
+	}
+	return members
+}
+
+// ListJobPods lists the pods controlled by the job.
+func ListJobPods(lister corelisters.PodLister, job *batch.Job) ([]*v1.Pod, error) {
+	pods, err := lister.Pods(job.Namespace).List(labels.SelectorFromSet(labels.Set{batch.JobNameKey: job.Name}))
+	if err != nil {
+		return nil, err
+	}
+	owned := make([]*v1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if metav1.IsControlledBy(pod, job) {
+			owned = append(owned, pod)
+		}
+	}
+	return owned, nil
+}
+
+// HasCheckpoint checks whether the pods of the job take a checkpoint before they are killed.
+func HasCheckpoint(job *batch.Job) bool {
+	_, found := job.Spec.Plugins[CheckpointPluginName]
+	return found
+}
+
+// ClassifyPodFailure returns the class of the failure of the pod. The pod fails for the infrastructure if it is
+// evicted or lost with its node, if the kubelet rejects it, e.g. for the devices it requests, or if any of its
+// containers is killed for out of memory.
+func ClassifyPodFailure(pod *v1.Pod) apis.FailureClass {
+	switch pod.Status.Reason {
+	case "Evicted", "NodeLost", "Shutdown", "Terminated", "UnexpectedAdmissionError":
+		return apis.InfrastructureFailure
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.DisruptionTarget && condition.Status == v1.ConditionTrue {
+			return apis.InfrastructureFailure
+		}
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && status.State.Terminated.Reason == "OOMKilled" {
+			return apis.InfrastructureFailure
+		}
+	}
+	return apis.UserFailure
+}
+
+// GetMaxInfrastructureRetry returns the maximum number of restarts of the job caused by infrastructure failures.
+func GetMaxInfrastructureRetry(job *batch.Job) int32 {
+	if value, found := job.Annotations[MaxInfrastructureRetryKey]; found {
+		if maxRetry, err := strconv.Atoi(value); err == nil && maxRetry >= 0 {
+			return int32(maxRetry)
+		}
+		klog.Warningf("Invalid annotation %s=%s of Job <%s/%s>, use maxRetry %d instead",
+			MaxInfrastructureRetryKey, value, job.Namespace, job.Name, job.Spec.MaxRetry)
+	}
+	return job.Spec.MaxRetry
+}
+
+// GetRetryBudgets returns the remaining number of restarts of the job for user failures and for infrastructure
+// failures. The restarts which are not caused by infrastructure failures, e.g. the ones by commands, are counted as
+// user failures.
+func GetRetryBudgets(job *batch.Job, status *batch.JobStatus) (user, infrastructure int32) {
+	infrastructureRetries := status.InfrastructureRetryCount
+	return job.Spec.MaxRetry - (status.RetryCount - infrastructureRetries), GetMaxInfrastructureRetry(job) - infrastructureRetries
+}
+
+// GetNodeFailureThreshold returns the number of failures of the job on a node after which the pods of the job are
+// not scheduled to the node, or 0 if failed nodes of the job are not tracked.
+func GetNodeFailureThreshold(job *batch.Job) int {
+	value, found := job.Annotations[NodeFailureThresholdKey]
+	if !found {
+		return 0
+	}
+	threshold, err := strconv.Atoi(value)
+	if err != nil || threshold <= 0 {
+		klog.Warningf("Invalid annotation %s=%s of Job <%s/%s>, failed nodes are not tracked",
+			NodeFailureThresholdKey, value, job.Namespace, job.Name)
+		return 0
+	}
+	return threshold
+}
+
+// GetBlockedNodes returns the sorted nodes which the pods of the job have failed on for the threshold of times.
+func GetBlockedNodes(job *batch.Job, status *batch.JobStatus) []string {
+	threshold := int32(GetNodeFailureThreshold(job))
+	if threshold == 0 {
+		return nil
+	}
+	var nodes []string
+	for node, count := range status.FailedNodes {
+		if count >= threshold {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
 // IsOutOfSyncPod checks whether the pod is marked as out-of-sync.
 func IsOutOfSyncPod(pod *v1.Pod) bool {
 	if pod.Annotations == nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package helpers
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/apis"
 	"volcano.sh/volcano/pkg/scheduler/api"
 )
 
@@ -661,6 +663,113 @@ func TestIsOutOfSyncPod(t *testing.T) {
 	}
 }
 
+func TestClassifyPodFailure(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   v1.PodStatus
+		expected apis.FailureClass
+	}{
+		{
+			name: "container exits with non-zero code is a user failure",
+			status: v1.PodStatus{
+				Phase: v1.PodFailed,
+				ContainerStatuses: []v1.ContainerStatus{{
+					State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+				}},
+			},
+			expected: apis.UserFailure,
+		},
+		{
+			name: "container killed for out of memory is an infrastructure failure",
+			status: v1.PodStatus{
+				Phase: v1.PodFailed,
+				ContainerStatuses: []v1.ContainerStatus{{
+					State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+				}},
+			},
+			expected: apis.InfrastructureFailure,
+		},
+		{
+			name:     "pod evicted by the kubelet is an infrastructure failure",
+			status:   v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"},
+			expected: apis.InfrastructureFailure,
+		},
+		{
+			name:     "pod rejected for its devices is an infrastructure failure",
+			status:   v1.PodStatus{Phase: v1.PodFailed, Reason: "UnexpectedAdmissionError"},
+			expected: apis.InfrastructureFailure,
+		},
+		{
+			name: "pod disrupted with its node is an infrastructure failure",
+			status: v1.PodStatus{
+				Phase:      v1.PodFailed,
+				Conditions: []v1.PodCondition{{Type: v1.DisruptionTarget, Status: v1.ConditionTrue, Reason: "DeletionByTaintManager"}},
+			},
+			expected: apis.InfrastructureFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-pod"}, Status: tc.status}
+			if result := ClassifyPodFailure(pod); result != tc.expected {
+				t.Errorf("expected ClassifyPodFailure to return %q, got %q", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestGetRetryBudgets(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		annotations            map[string]string
+		retryCount             int32
+		infrastructureRetries  int32
+		expectedUser           int32
+		expectedInfrastructure int32
+	}{
+		{
+			name:                   "infrastructure failures have the budget of maxRetry by default",
+			retryCount:             3,
+			infrastructureRetries:  1,
+			expectedUser:           3,
+			expectedInfrastructure: 4,
+		},
+		{
+			name:                   "infrastructure failures have their own budget",
+			annotations:            map[string]string{MaxInfrastructureRetryKey: "10"},
+			retryCount:             5,
+			infrastructureRetries:  4,
+			expectedUser:           4,
+			expectedInfrastructure: 6,
+		},
+		{
+			name:                   "invalid budget falls back to maxRetry",
+			annotations:            map[string]string{MaxInfrastructureRetryKey: "-1"},
+			retryCount:             2,
+			expectedUser:           3,
+			expectedInfrastructure: 5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			job := &batch.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "job1", Annotations: tc.annotations},
+				Spec:       batch.JobSpec{MaxRetry: 5},
+			}
+			status := &batch.JobStatus{
+				RetryCount:               tc.retryCount,
+				InfrastructureRetryCount: tc.infrastructureRetries,
+			}
+			user, infrastructure := GetRetryBudgets(job, status)
+			if user != tc.expectedUser || infrastructure != tc.expectedInfrastructure {
+				t.Errorf("expected budgets (%d, %d), got (%d, %d)", tc.expectedUser, tc.expectedInfrastructure, user, infrastructure)
+			}
+		})
+	}
+}
+
 func TestOutOfSyncJSONPatch(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -683,3 +792,38 @@ func TestOutOfSyncJSONPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBlockedNodes(t *testing.T) {
+	testCases := []struct {
+		name          string
+		annotations   map[string]string
+		failures      map[string]int32
+		expectedNodes []string
+	}{
+		{
+			name:     "failed nodes are not blocked without the threshold",
+			failures: map[string]int32{"n1": 3},
+		},
+		{
+			name:          "nodes reaching the threshold are blocked",
+			annotations:   map[string]string{NodeFailureThresholdKey: "2"},
+			failures:      map[string]int32{"n3": 2, "n1": 5, "n2": 1},
+			expectedNodes: []string{"n1", "n3"},
+		},
+		{
+			name:        "invalid threshold blocks no node",
+			annotations: map[string]string{NodeFailureThresholdKey: "0"},
+			failures:    map[string]int32{"n1": 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			job := &batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "job1", Annotations: tc.annotations}}
+			status := &batch.JobStatus{FailedNodes: tc.failures}
+			if nodes := GetBlockedNodes(job, status); !reflect.DeepEqual(nodes, tc.expectedNodes) {
+				t.Errorf("expected blocked nodes %v, got %v", tc.expectedNodes, nodes)
+			}
+		})
+	}
+}
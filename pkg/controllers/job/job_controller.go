@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/spf13/pflag"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -86,6 +87,15 @@ type delayAction struct {
 	// The delay before the action is executed
 	delay time.Duration
 
+	// Whether the pods killed by the action take a checkpoint before they exit
+	checkpoint bool
+
+	// The class of the failure caused the action
+	failure apis.FailureClass
+
+	// The node the pod failed or was evicted on, which caused the action
+	failedNode string
+
 	// The cancel function of the action
 	cancel context.CancelFunc
 }
@@ -150,12 +160,23 @@ type jobcontroller struct {
 	// delayActionMap stores delayed actions for jobs, where outer map key is job key (namespace/name),
 	// inner map key is pod name, and value is the delayed action to be performed
 	delayActionMap map[string]map[string]*delayAction
+
+	// nodeSuspicionEnabled raises the suspicion of the nodes which jobs stop scheduling their pods to for the
+	// failures on them.
+	nodeSuspicionEnabled bool
 }
 
 func (cc *jobcontroller) Name() string {
 	return "job-controller"
 }
 
+// AddFlags implements framework.FlagProvider, registering the flags of the job controller.
+func (cc *jobcontroller) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&cc.nodeSuspicionEnabled, "node-suspicion-enabled", false,
+		"Raise the suspicion annotation of the nodes which jobs stop scheduling their pods to for the failures on them, "+
+			"so that the nodeorder plugin of the scheduler can deprioritize the nodes for all jobs")
+}
+
 // Initialize creates the new Job controller.
 func (cc *jobcontroller) Initialize(opt *framework.ControllerOption) error {
 	cc.kubeClient = opt.KubeClient
@@ -266,6 +287,7 @@ func (cc *jobcontroller) Initialize(opt *framework.ControllerOption) error {
 	// Register actions
 	state.SyncJob = cc.syncJob
 	state.KillJob = cc.killJob
+	state.CheckpointJob = cc.checkpointJob
 	state.KillTarget = cc.killTarget
 	return nil
 }
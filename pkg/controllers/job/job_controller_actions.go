@@ -18,26 +18,34 @@ package job
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
 	"volcano.sh/apis/pkg/apis/helpers"
 	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/controllers/apis"
 	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
 	"volcano.sh/volcano/pkg/controllers/job/state"
 	"volcano.sh/volcano/pkg/controllers/metrics"
+	commonutil "volcano.sh/volcano/pkg/util"
 )
 
 var calMutex sync.Mutex
@@ -65,7 +73,8 @@ func (cc *jobcontroller) generateRelatedPodGroupName(job *batch.Job) string {
 	return fmt.Sprintf("%s-%s", job.Name, string(job.UID))
 }
 
-func (cc *jobcontroller) killTarget(jobInfo *apis.JobInfo, target state.Target, updateStatus state.UpdateStatusFn) error {
+func (cc *jobcontroller) killTarget(jobInfo *apis.JobInfo, action state.Action, updateStatus state.UpdateStatusFn) error {
+	target := action.Target
 	switch target.Type {
 	case state.TargetTypeTask:
 		klog.V(3).Infof("Killing task <%s> of Job <%s/%s>, current version %d", target.TaskName, jobInfo.Namespace, jobInfo.Name, jobInfo.Job.Status.Version)
@@ -78,17 +87,30 @@ func (cc *jobcontroller) killTarget(jobInfo *apis.JobInfo, target state.Target,
 		defer klog.V(3).Infof("Finished partition <%s> of Job <%s/%s> killing, current version %d", target.PartitionName, jobInfo.Namespace, jobInfo.Name, jobInfo.Job.Status.Version)
 	default:
 	}
-	return cc.killPods(jobInfo, nil, &target, updateStatus)
+	return cc.killPods(jobInfo, nil, &target, false, action, updateStatus)
 }
 
-func (cc *jobcontroller) killJob(jobInfo *apis.JobInfo, podRetainPhase state.PhaseMap, updateStatus state.UpdateStatusFn) error {
+func (cc *jobcontroller) killJob(jobInfo *apis.JobInfo, podRetainPhase state.PhaseMap, action state.Action, updateStatus state.UpdateStatusFn) error {
 	klog.V(3).Infof("Killing Job <%s/%s>, current version %d", jobInfo.Namespace, jobInfo.Name, jobInfo.Job.Status.Version)
 	defer klog.V(3).Infof("Finished Job <%s/%s> killing, current version %d", jobInfo.Namespace, jobInfo.Name, jobInfo.Job.Status.Version)
 
-	return cc.killPods(jobInfo, podRetainPhase, nil, updateStatus)
+	return cc.killPods(jobInfo, podRetainPhase, nil, false, action, updateStatus)
 }
 
-func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.PhaseMap, target *state.Target, updateStatus state.UpdateStatusFn) error {
+// checkpointJob kills the pods of the job like killJob, but gives the pods the time to take the checkpoint of the job
+// before they exit, and records the checkpoint as pending until the pods are gone.
+func (cc *jobcontroller) checkpointJob(jobInfo *apis.JobInfo, podRetainPhase state.PhaseMap, action state.Action, updateStatus state.UpdateStatusFn) error {
+	if !jobhelpers.HasCheckpoint(jobInfo.Job) {
+		return cc.killJob(jobInfo, podRetainPhase, action, updateStatus)
+	}
+
+	klog.V(3).Infof("Killing Job <%s/%s> with checkpoint, current version %d", jobInfo.Namespace, jobInfo.Name, jobInfo.Job.Status.Version)
+	defer klog.V(3).Infof("Finished Job <%s/%s> killing with checkpoint, current version %d", jobInfo.Namespace, jobInfo.Name, jobInfo.Job.Status.Version)
+
+	return cc.killPods(jobInfo, podRetainPhase, nil, true, action, updateStatus)
+}
+
+func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.PhaseMap, target *state.Target, checkpoint bool, action state.Action, updateStatus state.UpdateStatusFn) error {
 	job := jobInfo.Job
 	if job.DeletionTimestamp != nil {
 		klog.Infof("Job <%s/%s> is terminating, skip management process.",
@@ -103,6 +125,12 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 	var total int
 
 	podsToKill := make(map[string]*v1.Pod)
+	// The nodes the killed pods failed on, each is counted once for a kill however many pods failed on it.
+	failedNodes := sets.New[string]()
+	// The nodes the killed pods failed on for the infrastructure.
+	infrastructureNodes := map[string]bool{}
+	// The checkpoint taken by the killed pods.
+	var pendingCheckpoint *batch.JobCheckpoint
 
 	if target != nil {
 		switch target.Type {
@@ -145,6 +173,10 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 		}
 		total += len(podsToKill)
 	} else {
+		// The killed pods were created for the version before the bump.
+		if checkpoint {
+			pendingCheckpoint = &batch.JobCheckpoint{Sequence: job.Status.LatestCheckpoint + 1, Version: job.Status.Version}
+		}
 		// Job version is bumped only when job is killed
 		job.Status.Version++
 		for _, pods := range jobInfo.Pods {
@@ -156,9 +188,15 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 					continue
 				}
 
-				maxRetry := job.Spec.MaxRetry
+				// It is the last retry if the restart runs out of the budget of its failure class, after which the job
+				// fails, see restartingUpdateStatus.
+				userBudget, infrastructureBudget := jobhelpers.GetRetryBudgets(job, &job.Status)
+				budget := userBudget
+				if action.Failure == apis.InfrastructureFailure {
+					budget = infrastructureBudget
+				}
 				lastRetry := false
-				if job.Status.RetryCount >= maxRetry-1 {
+				if budget <= 1 {
 					lastRetry = true
 				}
 
@@ -198,10 +236,20 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 			continue
 		}
 
-		err := cc.deleteJobPod(job.Name, pod)
+		var gracePeriod *int64
+		if checkpoint {
+			gracePeriod = checkpointGracePeriod(pod)
+		}
+		err := cc.deleteJobPod(job.Name, pod, gracePeriod)
 		if err == nil {
 			klog.V(3).InfoS("Deleted Pod of Job", "Job", klog.KObj(job), "Pod", klog.KObj(pod), "UID", pod.UID)
 			terminating++
+			if pod.Status.Phase == v1.PodFailed && len(pod.Spec.NodeName) != 0 {
+				failedNodes.Insert(pod.Spec.NodeName)
+				if jobhelpers.ClassifyPodFailure(pod) == apis.InfrastructureFailure {
+					infrastructureNodes[pod.Spec.NodeName] = true
+				}
+			}
 			continue
 		}
 		// record the error, and then collect the pod info like retained pod
@@ -228,6 +276,21 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 	job.Status.Unknown = unknown
 	job.Status.TaskStatusCount = taskStatusCount
 
+	// The pod which failed or was evicted on the node may be gone already, e.g. lost with the node.
+	if len(action.FailedNode) != 0 && action.Failed() {
+		failedNodes.Insert(action.FailedNode)
+		if action.Failure == apis.InfrastructureFailure {
+			infrastructureNodes[action.FailedNode] = true
+		}
+	}
+
+	// Count the failures on the nodes, so that the recreated pods are not scheduled back to the bad ones.
+	blockedNodes := recordNodeFailures(job, sets.List(failedNodes))
+
+	if pendingCheckpoint != nil {
+		job.Status.PendingCheckpoint = pendingCheckpoint
+	}
+
 	if updateStatus != nil {
 		if updateStatus(&job.Status) {
 			job.Status.State.LastTransitionTime = metav1.Now()
@@ -236,6 +299,17 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 		}
 	}
 
+	// The job is restarted for failures, hold its pods back for a while so that it doesn't run out of its retries in
+	// seconds. A planned restart, e.g. issued by a command, is restarted at once, and so are a resumed job and a job
+	// which runs out of its retries to fail at once.
+	var backoff time.Duration
+	if action.Failed() && job.Status.RetryCount > jobInfo.Job.Status.RetryCount && !isAborted(jobInfo.Job) && hasRetryBudget(job) {
+		if backoff = restartBackoff(job); backoff > 0 {
+			restartAfter := metav1.NewTime(time.Now().Add(backoff))
+			job.Status.RestartAfter = &restartAfter
+		}
+	}
+
 	// Update running duration
 	runningDuration := metav1.Duration{Duration: job.Status.State.LastTransitionTime.Sub(jobInfo.Job.CreationTimestamp.Time)}
 	klog.V(3).Infof("Running duration is %s", runningDuration.ToUnstructured())
@@ -262,6 +336,16 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 			newJob.Namespace, newJob.Name, e)
 		return e
 	}
+	if backoff > 0 {
+		klog.V(3).Infof("Job <%s/%s> is restarted for %d times, recreate its pods after %s",
+			job.Namespace, job.Name, job.Status.RetryCount, backoff)
+		cc.syncJobAfter(job, backoff)
+	}
+	if len(blockedNodes) != 0 {
+		cc.recorder.Eventf(job, v1.EventTypeWarning, BlockNodesReason,
+			"Pods failed on nodes %v for %d times, stop scheduling pods to them", blockedNodes, jobhelpers.GetNodeFailureThreshold(job))
+		cc.raiseNodeSuspicion(blockedNodes, infrastructureNodes)
+	}
 
 	// Delete PodGroup only on full job kills; targeted pod restarts (RestartPodAction)
 	// must keep the PodGroup alive so the scheduler does not need to re-enqueue and
@@ -287,6 +371,148 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 	return nil
 }
 
+// restartBackoff returns the delay before the pods of the job are recreated for its latest restart. The delay is
+// doubled for every restart up to the maximum, and jittered so that the jobs failed together don't restart together.
+func restartBackoff(job *batch.Job) time.Duration {
+	value, found := job.Annotations[jobhelpers.RestartBackoffKey]
+	if !found {
+		return 0
+	}
+	backoff, err := time.ParseDuration(value)
+	if err != nil || backoff <= 0 {
+		klog.Warningf("Invalid annotation %s=%s of Job <%s/%s>, restart it without backoff",
+			jobhelpers.RestartBackoffKey, value, job.Namespace, job.Name)
+		return 0
+	}
+	maxBackoff := jobhelpers.DefaultMaxRestartBackoff
+	if value, found := job.Annotations[jobhelpers.MaxRestartBackoffKey]; found {
+		if maxBackoff, err = time.ParseDuration(value); err != nil || maxBackoff <= 0 {
+			klog.Warningf("Invalid annotation %s=%s of Job <%s/%s>, use %s instead",
+				jobhelpers.MaxRestartBackoffKey, value, job.Namespace, job.Name, jobhelpers.DefaultMaxRestartBackoff)
+			maxBackoff = jobhelpers.DefaultMaxRestartBackoff
+		}
+	}
+
+	for i := int32(1); i < job.Status.RetryCount && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return wait.Jitter(min(backoff, maxBackoff), restartBackoffJitter)
+}
+
+func isAborted(job *batch.Job) bool {
+	return job.Status.State.Phase == batch.Aborting || job.Status.State.Phase == batch.Aborted
+}
+
+func hasRetryBudget(job *batch.Job) bool {
+	userBudget, infrastructureBudget := jobhelpers.GetRetryBudgets(job, &job.Status)
+	return userBudget > 0 && infrastructureBudget > 0
+}
+
+// recordNodeFailures counts a failure of the job on each of the nodes in the job status, and returns the nodes which
+// reach the failure threshold of the job with it.
+func recordNodeFailures(job *batch.Job, failedNodes []string) []string {
+	threshold := jobhelpers.GetNodeFailureThreshold(job)
+	if threshold == 0 || len(failedNodes) == 0 {
+		return nil
+	}
+	if job.Status.FailedNodes == nil {
+		job.Status.FailedNodes = map[string]int32{}
+	}
+	var blockedNodes []string
+	for _, node := range failedNodes {
+		job.Status.FailedNodes[node]++
+		if job.Status.FailedNodes[node] == int32(threshold) {
+			blockedNodes = append(blockedNodes, node)
+		}
+	}
+	return blockedNodes
+}
+
+// raiseNodeSuspicion raises the suspicion of the nodes a job stops scheduling its pods to for the infrastructure
+// failures on them, by which the nodeorder plugin of the scheduler deprioritizes the nodes for all jobs. The nodes the
+// job is blocked from for its own failures, e.g. the exit codes of its pods, are not suspected. It's best effort and
+// doesn't fail the job action.
+func (cc *jobcontroller) raiseNodeSuspicion(nodes []string, infrastructureNodes map[string]bool) {
+	if !cc.nodeSuspicionEnabled {
+		return
+	}
+	for _, name := range nodes {
+		if !infrastructureNodes[name] {
+			continue
+		}
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			node, err := cc.kubeClient.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			// The resource version makes the patch fail on conflict, so that concurrent raises are not lost.
+			patch, err := json.Marshal(map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"resourceVersion": node.ResourceVersion,
+					"annotations": map[string]string{
+						commonutil.NodeSuspicionKey:     strconv.Itoa(commonutil.GetNodeSuspicion(node, now) + 1),
+						commonutil.NodeSuspicionTimeKey: now.UTC().Format(time.RFC3339),
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			_, err = cc.kubeClient.CoreV1().Nodes().Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{})
+			return err
+		})
+		if err != nil {
+			klog.Errorf("Failed to raise the suspicion of node %s: %v", name, err)
+		}
+	}
+}
+
+// resolveCheckpoint resolves the pending checkpoint of the job once the pods which take it are gone, and then syncs
+// the job again to recreate its pods.
+func (cc *jobcontroller) resolveCheckpoint(jobInfo *apis.JobInfo) error {
+	job := jobInfo.Job
+	version := strconv.Itoa(int(job.Status.PendingCheckpoint.Version))
+	for _, pods := range jobInfo.Pods {
+		for _, pod := range pods {
+			// The killed pods may not be terminating in the cache yet, but the retained ones never take the checkpoint.
+			if pod.DeletionTimestamp != nil || (pod.Annotations[batch.JobVersion] == version &&
+				pod.Status.Phase != v1.PodSucceeded && pod.Status.Phase != v1.PodFailed) {
+				klog.V(3).Infof("Job <%s/%s> is waiting for pod <%s> to take checkpoint %d",
+					job.Namespace, job.Name, pod.Name, job.Status.PendingCheckpoint.Sequence)
+				return nil
+			}
+		}
+	}
+
+	job = job.DeepCopy()
+	state.ResolveCheckpoint(jobInfo, &job.Status)
+	newJob, err := cc.vcClient.BatchV1alpha1().Jobs(job.Namespace).UpdateStatus(context.TODO(), job, metav1.UpdateOptions{})
+	if err != nil {
+		klog.Errorf("Failed to update status of Job %v/%v: %v", job.Namespace, job.Name, err)
+		return err
+	}
+	if e := cc.cache.Update(newJob); e != nil {
+		klog.Errorf("ResolveCheckpoint - Failed to update Job %v/%v in cache:  %v",
+			newJob.Namespace, newJob.Name, e)
+		return e
+	}
+	cc.syncJobAfter(newJob, 0)
+	return nil
+}
+
+// syncJobAfter syncs the job again after the delay.
+func (cc *jobcontroller) syncJobAfter(job *batch.Job, delay time.Duration) {
+	req := apis.Request{
+		Namespace: job.Namespace,
+		JobName:   job.Name,
+		JobUid:    job.UID,
+		Action:    busv1alpha1.SyncJobAction,
+	}
+	key := jobhelpers.GetJobKeyByReq(&req)
+	cc.getWorkerQueue(key).AddAfter(req, delay)
+}
+
 func (cc *jobcontroller) initiateJob(job *batch.Job) (*batch.Job, error) {
 	klog.V(3).Infof("Starting to initiate Job <%s/%s>", job.Namespace, job.Name)
 	jobInstance, err := cc.initJobStatus(job)
@@ -356,6 +582,20 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 		return nil
 	}
 
+	// A restarting job waits for its restart backoff to be over before its pods are recreated.
+	if job.Status.State.Phase == batch.Restarting && job.Status.RestartAfter != nil {
+		if backoff := time.Until(job.Status.RestartAfter.Time); backoff > 0 {
+			klog.V(3).Infof("Job <%s/%s> is backing off from restart, recreate its pods after %s",
+				job.Namespace, job.Name, backoff)
+			return nil
+		}
+	}
+
+	// A restarting job recreates its pods only after the pending checkpoint is resolved.
+	if job.Status.State.Phase == batch.Restarting && job.Status.PendingCheckpoint != nil {
+		return cc.resolveCheckpoint(jobInfo)
+	}
+
 	// deep copy job to prevent mutate it
 	job = job.DeepCopy()
 
@@ -562,7 +802,7 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 	for _, pod := range podToDelete {
 		go func(pod *v1.Pod) {
 			defer waitDeletionGroup.Done()
-			err := cc.deleteJobPod(job.Name, pod)
+			err := cc.deleteJobPod(job.Name, pod, nil)
 			if err != nil {
 				// Failed to delete Pod, waitCreationGroup a moment and then create it again
 				// This is to ensure all podsMap under the same Job created
@@ -600,6 +840,12 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 		ControlledResources: job.Status.ControlledResources,
 		Conditions:          job.Status.Conditions,
 		RetryCount:          job.Status.RetryCount,
+
+		InfrastructureRetryCount: job.Status.InfrastructureRetryCount,
+		RestartAfter:             job.Status.RestartAfter,
+		LatestCheckpoint:         job.Status.LatestCheckpoint,
+		FailedNodes:              job.Status.FailedNodes,
+		PendingCheckpoint:        job.Status.PendingCheckpoint,
 	}
 
 	if updateStatus != nil {
@@ -839,6 +1085,7 @@ func (cc *jobcontroller) createOrUpdatePodGroup(job *batch.Job) error {
 		}
 		// Adding PgSubGroupPolicy Information for PodGroup
 		setPgSubGroupPolicy(pg, job.Spec.Tasks)
+		updatePgRunningEstimate(pg, job)
 
 		if _, err = cc.vcClient.SchedulingV1beta1().PodGroups(job.Namespace).Create(context.TODO(), pg, metav1.CreateOptions{}); err != nil {
 			if !apierrors.IsAlreadyExists(err) {
@@ -913,12 +1160,15 @@ func (cc *jobcontroller) shouldUpdateExistingPodGroup(pg *scheduling.PodGroup, j
 	if updatePgSubGroupPolicy(pg, job.Spec.Tasks) {
 		pgShouldUpdate = true
 	}
+	if updatePgRunningEstimate(pg, job) {
+		pgShouldUpdate = true
+	}
 
 	return pgShouldUpdate
 }
 
-func (cc *jobcontroller) deleteJobPod(jobName string, pod *v1.Pod) error {
-	err := cc.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+func (cc *jobcontroller) deleteJobPod(jobName string, pod *v1.Pod, gracePeriodSeconds *int64) error {
+	err := cc.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{GracePeriodSeconds: gracePeriodSeconds})
 	if err != nil && !apierrors.IsNotFound(err) {
 		klog.Errorf("Failed to delete pod %s/%s for Job %s, err %#v",
 			pod.Namespace, pod.Name, jobName, err)
@@ -1126,6 +1376,26 @@ func updatePgSubGroupPolicy(pg *scheduling.PodGroup, tasks []batch.TaskSpec) boo
 	return subGroupPolicyShouldUpdate
 }
 
+// updatePgRunningEstimate passes the running estimate of the job to the scheduler by the podgroup annotation.
+func updatePgRunningEstimate(pg *scheduling.PodGroup, job *batch.Job) bool {
+	if job.Spec.RunningEstimate == nil {
+		return false
+	}
+
+	estimate := job.Spec.RunningEstimate.Duration.String()
+	if pg.Annotations[commonutil.JobRunningEstimate] == estimate {
+		return false
+	}
+	// The annotations of a newly built podgroup are shared with the job, copy them before updating.
+	annotations := make(map[string]string, len(pg.Annotations)+1)
+	for k, v := range pg.Annotations {
+		annotations[k] = v
+	}
+	annotations[commonutil.JobRunningEstimate] = estimate
+	pg.Annotations = annotations
+	return true
+}
+
 func getSubGroupPolicy(taskSpec batch.TaskSpec) scheduling.SubGroupPolicySpec {
 	subGroupPolicy := scheduling.SubGroupPolicySpec{
 		Name:         taskSpec.Name,
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -29,14 +30,19 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
 	schedulingapi "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
 	"volcano.sh/volcano/pkg/controllers/apis"
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
 	"volcano.sh/volcano/pkg/controllers/job/state"
+	commonutil "volcano.sh/volcano/pkg/util"
 )
 
 func TestKillJobFunc(t *testing.T) {
@@ -208,7 +214,7 @@ func TestKillJobFunc(t *testing.T) {
 				testcase.JobInfo.Job.Status.ControlledResources["plugin-"+name] = name
 			}
 
-			err = fakeController.killJob(testcase.JobInfo, testcase.PodRetainPhase, testcase.UpdateStatus)
+			err = fakeController.killJob(testcase.JobInfo, testcase.PodRetainPhase, state.Action{}, testcase.UpdateStatus)
 			if err != nil {
 				t.Errorf("Case %d (%s): expected: No Error, but got error %v.", i, testcase.Name, err)
 			}
@@ -1012,7 +1018,7 @@ func TestDeleteJobPod(t *testing.T) {
 				}
 			}
 
-			err := fakeController.deleteJobPod(testcase.Job.Name, testcase.DeletePod)
+			err := fakeController.deleteJobPod(testcase.Job.Name, testcase.DeletePod, nil)
 			if err != testcase.ExpextVal {
 				t.Errorf("Expected return value to be equal to expected: %s, but got: %s", testcase.ExpextVal, err)
 			}
@@ -1342,7 +1348,7 @@ func TestPodsToKill(t *testing.T) {
 			}
 
 			// Execute killPods
-			err = fakeController.killPods(testcase.JobInfo, testcase.PodRetainPhase, testcase.Target, testcase.UpdateStatus)
+			err = fakeController.killPods(testcase.JobInfo, testcase.PodRetainPhase, testcase.Target, false, state.Action{}, testcase.UpdateStatus)
 			if !errors.Is(err, testcase.ExpectVal) {
 				if testcase.ExpectVal == nil {
 					t.Errorf("Test case %d (%s): expected no error, but got error %v", i, testcase.Name, err)
@@ -1438,7 +1444,7 @@ func TestKillPodsPodGroupDeletion(t *testing.T) {
 				t.Fatalf("Error adding job to cache: %v", err)
 			}
 
-			err = fakeController.killPods(jobInfo, state.PodRetainPhaseNone, testcase.Target, nil)
+			err = fakeController.killPods(jobInfo, state.PodRetainPhaseNone, testcase.Target, false, state.Action{}, nil)
 			if err != nil {
 				t.Fatalf("killPods returned unexpected error: %v", err)
 			}
@@ -1456,6 +1462,317 @@ func TestKillPodsPodGroupDeletion(t *testing.T) {
 	}
 }
 
+func TestRestartBackoff(t *testing.T) {
+	testcases := []struct {
+		Name        string
+		Annotations map[string]string
+		RetryCount  int32
+		Expected    time.Duration
+	}{
+		{
+			Name:       "no backoff by default",
+			RetryCount: 3,
+			Expected:   0,
+		},
+		{
+			Name:        "first restart waits for the backoff",
+			Annotations: map[string]string{jobhelpers.RestartBackoffKey: "10s"},
+			RetryCount:  1,
+			Expected:    10 * time.Second,
+		},
+		{
+			Name:        "backoff is doubled for every restart",
+			Annotations: map[string]string{jobhelpers.RestartBackoffKey: "10s"},
+			RetryCount:  4,
+			Expected:    80 * time.Second,
+		},
+		{
+			Name:        "backoff is capped",
+			Annotations: map[string]string{jobhelpers.RestartBackoffKey: "10s", jobhelpers.MaxRestartBackoffKey: "1m"},
+			RetryCount:  10,
+			Expected:    time.Minute,
+		},
+		{
+			Name:        "invalid backoff is ignored",
+			Annotations: map[string]string{jobhelpers.RestartBackoffKey: "ten seconds"},
+			RetryCount:  1,
+			Expected:    0,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "test", Annotations: testcase.Annotations},
+				Status:     v1alpha1.JobStatus{RetryCount: testcase.RetryCount},
+			}
+			backoff := restartBackoff(job)
+			maxJittered := time.Duration(float64(testcase.Expected) * (1 + restartBackoffJitter))
+			if backoff < testcase.Expected || backoff > maxJittered {
+				t.Errorf("expected backoff in [%s, %s], got %s", testcase.Expected, maxJittered, backoff)
+			}
+		})
+	}
+}
+
+func TestKillPodsRestartBackoff(t *testing.T) {
+	namespace := "test"
+
+	testcases := []struct {
+		Name          string
+		Phase         v1alpha1.JobPhase
+		Action        state.Action
+		ExpectBackoff bool
+	}{
+		{
+			Name:          "job restarted for pod failure backs off",
+			Phase:         v1alpha1.Running,
+			Action:        state.Action{Action: busv1alpha1.RestartJobAction},
+			ExpectBackoff: true,
+		},
+		{
+			Name:          "job restarted for infrastructure failure backs off",
+			Phase:         v1alpha1.Running,
+			Action:        state.Action{Action: busv1alpha1.RestartJobAction, Checkpoint: true, Failure: apis.InfrastructureFailure},
+			ExpectBackoff: true,
+		},
+		{
+			Name:          "job restarted by command recreates its pods at once",
+			Phase:         v1alpha1.Running,
+			Action:        state.Action{Action: busv1alpha1.RestartJobAction, Checkpoint: true},
+			ExpectBackoff: false,
+		},
+		{
+			Name:          "resumed job does not back off",
+			Phase:         v1alpha1.Aborted,
+			Action:        state.Action{Action: busv1alpha1.ResumeJobAction},
+			ExpectBackoff: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "job1",
+					Namespace:       namespace,
+					ResourceVersion: "100",
+					Annotations:     map[string]string{jobhelpers.RestartBackoffKey: "1m"},
+				},
+				Spec: v1alpha1.JobSpec{MaxRetry: 3},
+				Status: v1alpha1.JobStatus{
+					State: v1alpha1.JobState{Phase: testcase.Phase},
+				},
+			}
+			jobInfo := &apis.JobInfo{
+				Namespace: namespace,
+				Name:      job.Name,
+				Job:       job,
+				Pods:      map[string]map[string]*v1.Pod{},
+			}
+
+			fakeController := newFakeController()
+			state.KillJob = fakeController.killJob
+			state.CheckpointJob = fakeController.checkpointJob
+			if _, err := fakeController.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Error creating job: %v", err)
+			}
+			if err := fakeController.cache.Add(job); err != nil {
+				t.Fatalf("Error adding job to cache: %v", err)
+			}
+
+			if err := state.NewState(jobInfo).Execute(testcase.Action); err != nil {
+				t.Fatalf("Execute returned unexpected error: %v", err)
+			}
+
+			newJob, err := fakeController.cache.Get(fmt.Sprintf("%s/%s", namespace, job.Name))
+			if err != nil {
+				t.Fatalf("Error getting job from cache: %v", err)
+			}
+			if retries := newJob.Job.Status.RetryCount; retries != 1 {
+				t.Errorf("expected 1 retry, got %d", retries)
+			}
+			restartAfter := newJob.Job.Status.RestartAfter
+			if backoff := restartAfter != nil && time.Until(restartAfter.Time) > 0; backoff != testcase.ExpectBackoff {
+				t.Errorf("expected backoff %v, got restart after %v", testcase.ExpectBackoff, restartAfter)
+			}
+		})
+	}
+}
+
+func TestKillPodsLastRetryKeepsFailedPods(t *testing.T) {
+	namespace := "test"
+
+	testcases := []struct {
+		Name         string
+		Status       v1alpha1.JobStatus
+		Failure      apis.FailureClass
+		ExpectKilled []string
+	}{
+		{
+			Name:         "user failure running out of the user budget keeps the failed pods",
+			Status:       v1alpha1.JobStatus{RetryCount: 2},
+			Failure:      apis.UserFailure,
+			ExpectKilled: []string{"job1-task1-1"},
+		},
+		{
+			Name:         "infrastructure failure running out of the infrastructure budget keeps the failed pods",
+			Status:       v1alpha1.JobStatus{RetryCount: 2, InfrastructureRetryCount: 2},
+			Failure:      apis.InfrastructureFailure,
+			ExpectKilled: []string{"job1-task1-1"},
+		},
+		{
+			Name:         "infrastructure failure with infrastructure budget left kills the failed pods",
+			Status:       v1alpha1.JobStatus{RetryCount: 2},
+			Failure:      apis.InfrastructureFailure,
+			ExpectKilled: []string{"job1-task1-0", "job1-task1-1"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: namespace, ResourceVersion: "100"},
+				Spec:       v1alpha1.JobSpec{MaxRetry: 3},
+				Status:     testcase.Status,
+			}
+			job.Status.State.Phase = v1alpha1.Running
+			failedPod := buildPod(namespace, "job1-task1-0", v1.PodFailed, nil)
+			runningPod := buildPod(namespace, "job1-task1-1", v1.PodRunning, nil)
+			jobInfo := &apis.JobInfo{
+				Namespace: namespace,
+				Name:      job.Name,
+				Job:       job,
+				Pods:      map[string]map[string]*v1.Pod{"task1": {failedPod.Name: failedPod, runningPod.Name: runningPod}},
+			}
+
+			fakeController := newFakeController()
+			state.KillJob = fakeController.killJob
+			if _, err := fakeController.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Error creating job: %v", err)
+			}
+			if err := fakeController.cache.Add(job); err != nil {
+				t.Fatalf("Error adding job to cache: %v", err)
+			}
+			for _, pod := range []*v1.Pod{failedPod, runningPod} {
+				if _, err := fakeController.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("Error creating pod: %v", err)
+				}
+			}
+
+			action := state.Action{Action: busv1alpha1.RestartJobAction, Failure: testcase.Failure}
+			if err := state.NewState(jobInfo).Execute(action); err != nil {
+				t.Fatalf("Execute returned unexpected error: %v", err)
+			}
+
+			var killed []string
+			for _, action := range fakeController.kubeClient.(*kubeclient.Clientset).Actions() {
+				if deleteAction, ok := action.(k8stesting.DeleteAction); ok && action.GetResource().Resource == "pods" {
+					killed = append(killed, deleteAction.GetName())
+				}
+			}
+			sort.Strings(killed)
+			if !reflect.DeepEqual(killed, testcase.ExpectKilled) {
+				t.Errorf("expected killed pods %v, got %v", testcase.ExpectKilled, killed)
+			}
+		})
+	}
+}
+
+func TestKillPodsNodeFailures(t *testing.T) {
+	namespace := "test"
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "job1",
+			Namespace:       namespace,
+			ResourceVersion: "100",
+			Annotations:     map[string]string{jobhelpers.NodeFailureThresholdKey: "2"},
+		},
+		Spec: v1alpha1.JobSpec{MaxRetry: 3},
+		Status: v1alpha1.JobStatus{
+			State:       v1alpha1.JobState{Phase: v1alpha1.Running},
+			FailedNodes: map[string]int32{"n1": 1, "n4": 1},
+		},
+	}
+	buildPod := func(name, node string, phase v1.PodPhase) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       v1.PodSpec{NodeName: node},
+			Status:     v1.PodStatus{Phase: phase},
+		}
+	}
+	jobInfo := &apis.JobInfo{
+		Namespace: namespace,
+		Name:      job.Name,
+		Job:       job,
+		Pods: map[string]map[string]*v1.Pod{
+			"task1": {
+				"job1-task1-0": buildPod("job1-task1-0", "n1", v1.PodFailed),
+				"job1-task1-1": buildPod("job1-task1-1", "n2", v1.PodFailed),
+				"job1-task1-2": buildPod("job1-task1-2", "n3", v1.PodRunning),
+				"job1-task1-3": buildPod("job1-task1-3", "n4", v1.PodFailed),
+				"job1-task1-4": buildPod("job1-task1-4", "n2", v1.PodFailed),
+			},
+		},
+	}
+	// n1 and n4 reach the threshold, but only n1 fails for the infrastructure. Two pods failed on n2, which counts
+	// once, and the pod which triggers the restart was lost with n5.
+	jobInfo.Pods["task1"]["job1-task1-0"].Status.Reason = "Evicted"
+
+	fakeController := newFakeController()
+	fakeController.nodeSuspicionEnabled = true
+	if _, err := fakeController.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Error creating job: %v", err)
+	}
+	if err := fakeController.cache.Add(job); err != nil {
+		t.Fatalf("Error adding job to cache: %v", err)
+	}
+	// The suspicion of n1 raised long ago has expired.
+	expired := time.Now().Add(-commonutil.NodeSuspicionExpiry).Format(time.RFC3339)
+	for _, name := range []string{"n1", "n2", "n3", "n4"} {
+		node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if name == "n1" {
+			node.Annotations = map[string]string{commonutil.NodeSuspicionKey: "5", commonutil.NodeSuspicionTimeKey: expired}
+		}
+		if _, err := fakeController.kubeClient.CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("Error creating node: %v", err)
+		}
+	}
+
+	action := state.Action{Action: busv1alpha1.RestartJobAction, Failure: apis.InfrastructureFailure, FailedNode: "n5"}
+	err := fakeController.killPods(jobInfo, state.PodRetainPhaseNone, nil, false, action, func(status *v1alpha1.JobStatus) bool {
+		status.State.Phase = v1alpha1.Restarting
+		state.CountRetry(status, action.Failure)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("killPods returned unexpected error: %v", err)
+	}
+
+	newJob, err := fakeController.cache.Get(fmt.Sprintf("%s/%s", namespace, job.Name))
+	if err != nil {
+		t.Fatalf("Error getting job from cache: %v", err)
+	}
+	expectedFailures := map[string]int32{"n1": 2, "n2": 1, "n4": 2, "n5": 1}
+	if failures := newJob.Job.Status.FailedNodes; !reflect.DeepEqual(failures, expectedFailures) {
+		t.Errorf("expected node failures %v, got %v", expectedFailures, failures)
+	}
+	for name, expected := range map[string]int{"n1": 1, "n2": 0, "n3": 0, "n4": 0} {
+		node, err := fakeController.kubeClient.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Error getting node: %v", err)
+		}
+		if suspicion := commonutil.GetNodeSuspicion(node, time.Now()); suspicion != expected {
+			t.Errorf("expected suspicion %d of node %s, got %d", expected, name, suspicion)
+		}
+	}
+	for _, action := range fakeController.kubeClient.(*kubeclient.Clientset).Actions() {
+		if action.GetResource().Resource == "nodes" && action.GetVerb() == "update" {
+			t.Errorf("expected nodes to be patched, got %s of node", action.GetVerb())
+		}
+	}
+}
+
 func TestGetSubGroupPolicy(t *testing.T) {
 	highestTierAllowed := 1
 
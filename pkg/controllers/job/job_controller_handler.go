@@ -268,11 +268,13 @@ func (cc *jobcontroller) updatePod(oldObj, newObj interface{}) {
 
 	event := bus.OutOfSyncEvent
 	var exitCode int32
+	var failure apis.FailureClass
 
 	switch newPod.Status.Phase {
 	case v1.PodFailed:
 		if oldPod.Status.Phase != v1.PodFailed {
 			event = bus.PodFailedEvent
+			failure = jobhelpers.ClassifyPodFailure(newPod)
 			// TODO: currently only one container pod is supported by volcano
 			// Once multi containers pod is supported, update accordingly.
 			if len(newPod.Status.ContainerStatuses) > 0 && newPod.Status.ContainerStatuses[0].State.Terminated != nil {
@@ -314,6 +316,8 @@ func (cc *jobcontroller) updatePod(oldObj, newObj interface{}) {
 		PartitionID: apis.GetPartitionID(newPod),
 		Event:       event,
 		ExitCode:    exitCode,
+		Failure:     failure,
+		NodeName:    newPod.Spec.NodeName,
 		JobVersion:  int32(dVersion),
 	}
 
@@ -375,8 +379,13 @@ func (cc *jobcontroller) deletePod(obj interface{}) {
 	}
 
 	event := bus.PodEvictedEvent
+	var failure apis.FailureClass
 	if jobhelpers.IsOutOfSyncPod(pod) || !cc.cache.HasPod(pod) {
 		event = bus.OutOfSyncEvent
+	} else {
+		// The pod is deleted by others than the controller. It's an infrastructure failure only if the cluster
+		// disrupts the pod, e.g. evicts or preempts it, or collects it with its lost node, but not if a user deletes it.
+		failure = jobhelpers.ClassifyPodFailure(pod)
 	}
 
 	req := apis.Request{
@@ -388,6 +397,8 @@ func (cc *jobcontroller) deletePod(obj interface{}) {
 		PodUID:      pod.UID,
 		PartitionID: apis.GetPartitionID(pod),
 		Event:       event,
+		Failure:     failure,
+		NodeName:    pod.Spec.NodeName,
 		JobVersion:  int32(dVersion),
 	}
 
@@ -35,7 +35,9 @@ import (
 	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
 	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+	"volcano.sh/volcano/pkg/controllers/apis"
 	"volcano.sh/volcano/pkg/controllers/framework"
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
 )
 
 func newController() *jobcontroller {
@@ -569,6 +571,78 @@ func TestDeletePodFunc(t *testing.T) {
 	}
 }
 
+func TestDeletePodFailure(t *testing.T) {
+	namespace := "test"
+	annotations := map[string]string{
+		batch.JobNameKey:  "job1",
+		batch.JobVersion:  "0",
+		batch.TaskSpecKey: "task1",
+	}
+
+	testcases := []struct {
+		Name            string
+		deletePod       *v1.Pod
+		ExpectedEvent   bus.Event
+		ExpectedFailure apis.FailureClass
+	}{
+		{
+			Name:            "pod deleted by a user is a user failure",
+			deletePod:       buildPod(namespace, "pod1", v1.PodRunning, nil),
+			ExpectedEvent:   bus.PodEvictedEvent,
+			ExpectedFailure: apis.UserFailure,
+		},
+		{
+			Name: "pod disrupted by the cluster is an infrastructure failure",
+			deletePod: func() *v1.Pod {
+				pod := buildPod(namespace, "pod1", v1.PodRunning, nil)
+				pod.Status.Conditions = []v1.PodCondition{{Type: v1.DisruptionTarget, Status: v1.ConditionTrue, Reason: "DeletionByTaintManager"}}
+				return pod
+			}(),
+			ExpectedEvent:   bus.PodEvictedEvent,
+			ExpectedFailure: apis.InfrastructureFailure,
+		},
+		{
+			Name: "pod deleted by the controller is no failure",
+			deletePod: func() *v1.Pod {
+				pod := buildPod(namespace, "pod1", v1.PodRunning, nil)
+				pod.Annotations[jobhelpers.OutOfSyncKey] = "true"
+				pod.Status.Conditions = []v1.PodCondition{{Type: v1.DisruptionTarget, Status: v1.ConditionTrue}}
+				return pod
+			}(),
+			ExpectedEvent:   bus.OutOfSyncEvent,
+			ExpectedFailure: apis.UserFailure,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			controller := newController()
+			job := &batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: namespace}}
+			controller.addJob(job)
+			addPodAnnotation(testcase.deletePod, annotations)
+			controller.addPod(testcase.deletePod)
+
+			key := fmt.Sprintf("%s/%s", namespace, job.Name)
+			queue := controller.getWorkerQueue(key)
+			for queue.Len() > 0 {
+				item, _ := queue.Get()
+				queue.Done(item)
+			}
+
+			controller.deletePod(testcase.deletePod)
+			if queue.Len() != 1 {
+				t.Fatalf("expected 1 request, got %d", queue.Len())
+			}
+			item, _ := queue.Get()
+			req := item.(apis.Request)
+			if req.Event != testcase.ExpectedEvent || req.Failure != testcase.ExpectedFailure {
+				t.Errorf("expected event %s with failure %q, got event %s with failure %q",
+					testcase.ExpectedEvent, testcase.ExpectedFailure, req.Event, req.Failure)
+			}
+		})
+	}
+}
+
 func TestUpdatePodGroupFunc(t *testing.T) {
 
 	namespace := "test"
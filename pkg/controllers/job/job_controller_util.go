@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -176,9 +177,56 @@ func createJobPod(job *batch.Job, template *v1.PodTemplateSpec, ix int, jobForwa
 		pod.Labels[batch.TaskPartitionID] = strconv.Itoa(partitionID)
 	}
 
+	// Keep the pod off the nodes the pods of the job failed on too many times.
+	addNodeBlocklist(pod, jobhelpers.GetBlockedNodes(job, &job.Status))
+
 	return pod
 }
 
+// addNodeBlocklist requires the pod not to be scheduled to the nodes. The requirement is added to every term of the
+// required node affinity of the pod, as the terms are ORed.
+func addNodeBlocklist(pod *v1.Pod, nodes []string) {
+	if len(nodes) == 0 {
+		return
+	}
+	requirement := v1.NodeSelectorRequirement{
+		Key:      metav1.ObjectNameField,
+		Operator: v1.NodeSelectorOpNotIn,
+		Values:   nodes,
+	}
+
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &v1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &v1.NodeAffinity{}
+	}
+	nodeAffinity := pod.Spec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &v1.NodeSelector{}
+	}
+	nodeSelector := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		nodeSelector.NodeSelectorTerms = []v1.NodeSelectorTerm{{}}
+	}
+	for i := range nodeSelector.NodeSelectorTerms {
+		nodeSelector.NodeSelectorTerms[i].MatchFields = append(nodeSelector.NodeSelectorTerms[i].MatchFields, requirement)
+	}
+}
+
+// checkpointGracePeriod returns the grace period to delete the pod with when it is killed with the checkpoint of the
+// job, which is the checkpoint timeout of the pod if it is longer than the grace period of the pod, otherwise nil.
+func checkpointGracePeriod(pod *v1.Pod) *int64 {
+	timeout, err := strconv.ParseInt(pod.Annotations[apis.CheckpointTimeoutKey], 10, 64)
+	if err != nil {
+		return nil
+	}
+	if pod.Spec.TerminationGracePeriodSeconds != nil && *pod.Spec.TerminationGracePeriodSeconds >= timeout {
+		return nil
+	}
+	return &timeout
+}
+
 func applyPolicies(job *batch.Job, req *apis.Request) (delayAct *delayAction) {
 	delayAct = &delayAction{
 		jobKey:    jobcache.JobKeyByReq(req),
@@ -188,7 +236,12 @@ func applyPolicies(job *batch.Job, req *apis.Request) (delayAct *delayAction) {
 		podUID:    req.PodUID,
 		partition: req.PartitionID,
 		// default action is sync job
-		action: v1alpha1.SyncJobAction,
+		action:     v1alpha1.SyncJobAction,
+		checkpoint: isPlanned(req),
+		failure:    req.Failure,
+	}
+	if req.Event == v1alpha1.PodFailedEvent || req.Event == v1alpha1.PodEvictedEvent {
+		delayAct.failedNode = req.NodeName
 	}
 
 	if len(req.Action) != 0 {
@@ -215,6 +268,14 @@ func applyPolicies(job *batch.Job, req *apis.Request) (delayAct *delayAction) {
 		return
 	}
 
+	// An elastic job gives up the pods of its highest ranks when the scheduler shrinks it, they are recreated to wait
+	// for resources instead of triggering the policies of the job.
+	if isElasticShrink(job, req) {
+		klog.V(3).Infof("Pod <%s> of elastic Job <%s/%s> is evicted above minAvailable of task <%s>, perform %v action",
+			req.PodName, job.Namespace, job.Name, req.TaskName, v1alpha1.SyncJobAction)
+		return
+	}
+
 	// Overwrite Job level policies
 	if len(req.TaskName) != 0 {
 		// Parse task level policies
@@ -403,6 +464,29 @@ func (p TasksPriority) CalcPGMinResources(jobMinAvailable int32) v1.ResourceList
 	return minReq
 }
 
+// isElasticShrink checks whether the request is the eviction of a pod of an elastic job whose rank is not below the
+// minAvailable of its task.
+func isElasticShrink(job *batch.Job, req *apis.Request) bool {
+	if req.Event != v1alpha1.PodEvictedEvent || !jobhelpers.IsElasticJob(job) {
+		return false
+	}
+	ts, found := jobhelpers.GetTaskSpec(job, req.TaskName)
+	if !found || ts.MinAvailable == nil {
+		return false
+	}
+	index, err := strconv.Atoi(strings.TrimPrefix(req.PodName, fmt.Sprintf("%s-%s-", job.Name, req.TaskName)))
+	if err != nil {
+		return false
+	}
+	return int32(index) >= *ts.MinAvailable
+}
+
+// isPlanned checks whether the request is planned, so that the pods of the job are alive to take a checkpoint before
+// the action kills them: the commands, e.g. suspending the job by vcctl, and the evictions of the scheduler.
+func isPlanned(req *apis.Request) bool {
+	return req.Event == v1alpha1.CommandIssuedEvent || req.Event == v1alpha1.PodEvictedEvent
+}
+
 // isInternalEvent checks if the event is an internal event
 func isInternalEvent(event v1alpha1.Event) bool {
 	switch event {
@@ -430,7 +514,7 @@ func isInternalAction(action v1alpha1.Action) bool {
 }
 
 func GetStateAction(delayAct *delayAction) state.Action {
-	action := state.Action{Action: delayAct.action}
+	action := state.Action{Action: delayAct.action, Checkpoint: delayAct.checkpoint, Failure: delayAct.failure, FailedNode: delayAct.failedNode}
 
 	if delayAct.action == v1alpha1.RestartTaskAction {
 		action.Target = state.Target{TaskName: delayAct.taskName, Type: state.TargetTypeTask}
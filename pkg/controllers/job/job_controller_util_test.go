@@ -30,6 +30,8 @@ import (
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
 	"volcano.sh/volcano/pkg/controllers/apis"
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
 )
 
 func TestMakePodName(t *testing.T) {
@@ -151,6 +153,66 @@ func TestCreateJobPod_SchedulerNameOverride(t *testing.T) {
 	}
 }
 
+// Test case: Verify the pods are kept off the nodes they failed on too many times
+func TestCreateJobPod_NodeBlocklist(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-job",
+			Namespace:   "test-ns",
+			Annotations: map[string]string{jobhelpers.NodeFailureThresholdKey: "2"},
+		},
+		Status: v1alpha1.JobStatus{
+			FailedNodes: map[string]int32{"n1": 2, "n2": 1, "n3": 3},
+		},
+	}
+	zoneRequirement := v1.NodeSelectorRequirement{Key: "zone", Operator: v1.NodeSelectorOpIn, Values: []string{"a"}}
+	template := &v1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-task"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "test", Image: "busybox"}},
+			Affinity: &v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+						NodeSelectorTerms: []v1.NodeSelectorTerm{
+							{MatchExpressions: []v1.NodeSelectorRequirement{zoneRequirement}},
+							{MatchFields: []v1.NodeSelectorRequirement{{Key: metav1.ObjectNameField, Operator: v1.NodeSelectorOpIn, Values: []string{"n1", "n4"}}}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod := createJobPod(job, template, 0, false, nil, &v1alpha1.TaskSpec{})
+	blocklist := v1.NodeSelectorRequirement{Key: metav1.ObjectNameField, Operator: v1.NodeSelectorOpNotIn, Values: []string{"n1", "n3"}}
+	expected := []v1.NodeSelectorTerm{
+		{
+			MatchExpressions: []v1.NodeSelectorRequirement{zoneRequirement},
+			MatchFields:      []v1.NodeSelectorRequirement{blocklist},
+		},
+		{
+			MatchFields: []v1.NodeSelectorRequirement{
+				{Key: metav1.ObjectNameField, Operator: v1.NodeSelectorOpIn, Values: []string{"n1", "n4"}},
+				blocklist,
+			},
+		},
+	}
+	terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if !reflect.DeepEqual(terms, expected) {
+		t.Errorf("expected node selector terms %v, got %v", expected, terms)
+	}
+	if len(template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchFields) != 0 {
+		t.Errorf("the pod template of the job is modified")
+	}
+
+	// Without the threshold, the pod has no node affinity.
+	job.Annotations = nil
+	template.Spec.Affinity = nil
+	if pod := createJobPod(job, template, 0, false, nil, &v1alpha1.TaskSpec{}); pod.Spec.Affinity != nil {
+		t.Errorf("expected no affinity, got %v", pod.Spec.Affinity)
+	}
+}
+
 // Test case: Verify priority class inheritance logic
 func TestCreateJobPod_PriorityClass(t *testing.T) {
 	// Scenario 1: No priority class in template, but exists in job
@@ -478,6 +540,32 @@ func contains(slice []string, item string) bool {
 	return false
 }
 
+func buildElasticJob(namespace string) *v1alpha1.Job {
+	minAvailable := int32(2)
+	return &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "job1",
+			Namespace:   namespace,
+			Annotations: map[string]string{schedulingapi.JobElastic: "true"},
+		},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{
+				{
+					Name:         "worker",
+					Replicas:     4,
+					MinAvailable: &minAvailable,
+				},
+			},
+			Policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action: busv1alpha1.RestartJobAction,
+					Event:  busv1alpha1.PodEvictedEvent,
+				},
+			},
+		},
+	}
+}
+
 func TestApplyPolicies(t *testing.T) {
 	namespace := "test"
 	errorCode0 := int32(0)
@@ -958,6 +1046,18 @@ func TestApplyPolicies(t *testing.T) {
 			Request:   &apis.Request{},
 			ReturnVal: busv1alpha1.SyncJobAction,
 		},
+		{
+			Name:      "Test Apply policies where a pod of an elastic job is evicted above minAvailable",
+			Job:       buildElasticJob(namespace),
+			Request:   &apis.Request{TaskName: "worker", PodName: "job1-worker-3", Event: busv1alpha1.PodEvictedEvent},
+			ReturnVal: busv1alpha1.SyncJobAction,
+		},
+		{
+			Name:      "Test Apply policies where a pod of an elastic job is evicted below minAvailable",
+			Job:       buildElasticJob(namespace),
+			Request:   &apis.Request{TaskName: "worker", PodName: "job1-worker-1", Event: busv1alpha1.PodEvictedEvent},
+			ReturnVal: busv1alpha1.RestartJobAction,
+		},
 	}
 
 	for i, testcase := range testcases {
@@ -972,6 +1072,48 @@ func TestApplyPolicies(t *testing.T) {
 	}
 }
 
+func TestApplyPoliciesCheckpoint(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "test"},
+		Spec: v1alpha1.JobSpec{
+			Policies: []v1alpha1.LifecyclePolicy{
+				{Events: []busv1alpha1.Event{busv1alpha1.PodEvictedEvent, busv1alpha1.PodFailedEvent}, Action: busv1alpha1.RestartJobAction},
+			},
+		},
+	}
+
+	testcases := []struct {
+		Name               string
+		Request            *apis.Request
+		ExpectedCheckpoint bool
+	}{
+		{
+			Name:               "command is planned",
+			Request:            &apis.Request{Namespace: "test", JobName: "job1", Event: busv1alpha1.CommandIssuedEvent, Action: busv1alpha1.AbortJobAction},
+			ExpectedCheckpoint: true,
+		},
+		{
+			Name:               "eviction is planned",
+			Request:            &apis.Request{Namespace: "test", JobName: "job1", TaskName: "task1", Event: busv1alpha1.PodEvictedEvent},
+			ExpectedCheckpoint: true,
+		},
+		{
+			Name:               "failure is not planned",
+			Request:            &apis.Request{Namespace: "test", JobName: "job1", TaskName: "task1", Event: busv1alpha1.PodFailedEvent},
+			ExpectedCheckpoint: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			action := GetStateAction(applyPolicies(job, testcase.Request))
+			if action.Checkpoint != testcase.ExpectedCheckpoint {
+				t.Errorf("Expected checkpoint to be %v but got %v", testcase.ExpectedCheckpoint, action.Checkpoint)
+			}
+		})
+	}
+}
+
 func TestTasksPriority_Less(t *testing.T) {
 	testcases := []struct {
 		Name          string
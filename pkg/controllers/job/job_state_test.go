@@ -25,6 +25,9 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
@@ -873,6 +876,87 @@ func TestRestartingState_Execute(t *testing.T) {
 	}
 }
 
+func TestRestartingState_RetryBudgets(t *testing.T) {
+	namespace := "test"
+
+	testcases := []struct {
+		Name                  string
+		Annotations           map[string]string
+		RetryCount            int32
+		InfrastructureRetries int32
+		ExpectedPhase         v1alpha1.JobPhase
+	}{
+		{
+			Name:                  "infrastructure failures don't use up the budget of user failures",
+			RetryCount:            3,
+			InfrastructureRetries: 2,
+			ExpectedPhase:         v1alpha1.Pending,
+		},
+		{
+			Name:          "user failures run out of their budget",
+			RetryCount:    3,
+			ExpectedPhase: v1alpha1.Failed,
+		},
+		{
+			Name:                  "infrastructure failures run out of their own budget",
+			Annotations:           map[string]string{"volcano.sh/max-infrastructure-retry": "1"},
+			RetryCount:            1,
+			InfrastructureRetries: 1,
+			ExpectedPhase:         v1alpha1.Failed,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "job1",
+					Namespace:       namespace,
+					ResourceVersion: "100",
+					Annotations:     testcase.Annotations,
+				},
+				Spec: v1alpha1.JobSpec{
+					MaxRetry: 3,
+					Tasks:    []v1alpha1.TaskSpec{{Name: "task1", Replicas: 1}},
+				},
+				Status: v1alpha1.JobStatus{
+					State:                    v1alpha1.JobState{Phase: v1alpha1.Restarting},
+					MinAvailable:             1,
+					RetryCount:               testcase.RetryCount,
+					InfrastructureRetryCount: testcase.InfrastructureRetries,
+				},
+			}
+			jobInfo := &apis.JobInfo{
+				Namespace: namespace,
+				Name:      job.Name,
+				Job:       job,
+			}
+
+			fakecontroller := newFakeController()
+			state.KillJob = fakecontroller.killJob
+
+			if _, err := fakecontroller.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Error while creating Job: %v", err)
+			}
+			if err := fakecontroller.cache.Add(job); err != nil {
+				t.Fatalf("Error while adding Job in cache: %v", err)
+			}
+
+			if err := state.NewState(jobInfo).Execute(state.Action{Action: busv1alpha1.RestartJobAction}); err != nil {
+				t.Fatalf("Expected Error not to occur but got: %s", err)
+			}
+
+			newJob, err := fakecontroller.cache.Get(fmt.Sprintf("%s/%s", namespace, job.Name))
+			if err != nil {
+				t.Fatal("Error while retrieving value from Cache")
+			}
+			if newJob.Job.Status.State.Phase != testcase.ExpectedPhase {
+				t.Errorf("Expected Job phase to %s, but got %s", testcase.ExpectedPhase, newJob.Job.Status.State.Phase)
+			}
+		})
+	}
+}
+
 func TestRunningState_Execute(t *testing.T) {
 	namespace := "test"
 
@@ -1385,6 +1469,258 @@ func TestRunningState_Execute(t *testing.T) {
 	}
 }
 
+func TestRunningState_CheckpointJob(t *testing.T) {
+	namespace := "test"
+
+	testcases := []struct {
+		Name                string
+		Plugins             map[string][]string
+		Latest              int32
+		Action              state.Action
+		ExpectedPending     *v1alpha1.JobCheckpoint
+		ExpectedGracePeriod *int64
+	}{
+		{
+			Name:                "planned RestartJobAction takes the first checkpoint",
+			Plugins:             map[string][]string{"checkpoint": {"--command=kill -USR1 1"}},
+			Action:              state.Action{Action: busv1alpha1.RestartJobAction, Checkpoint: true},
+			ExpectedPending:     &v1alpha1.JobCheckpoint{Sequence: 1, Version: 1},
+			ExpectedGracePeriod: ptr.To[int64](600),
+		},
+		{
+			Name:                "planned AbortJobAction takes the next checkpoint",
+			Plugins:             map[string][]string{"checkpoint": {"--command=kill -USR1 1"}},
+			Latest:              2,
+			Action:              state.Action{Action: busv1alpha1.AbortJobAction, Checkpoint: true},
+			ExpectedPending:     &v1alpha1.JobCheckpoint{Sequence: 3, Version: 1},
+			ExpectedGracePeriod: ptr.To[int64](600),
+		},
+		{
+			Name:    "unplanned RestartJobAction takes no checkpoint",
+			Plugins: map[string][]string{"checkpoint": {"--command=kill -USR1 1"}},
+			Latest:  2,
+			Action:  state.Action{Action: busv1alpha1.RestartJobAction},
+		},
+		{
+			Name:   "job without checkpoint plugin takes no checkpoint",
+			Action: state.Action{Action: busv1alpha1.AbortJobAction, Checkpoint: true},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "job1",
+					Namespace:       namespace,
+					ResourceVersion: "100",
+				},
+				Spec: v1alpha1.JobSpec{
+					Plugins: testcase.Plugins,
+				},
+				Status: v1alpha1.JobStatus{
+					State: v1alpha1.JobState{
+						Phase: v1alpha1.Running,
+					},
+					Version:          1,
+					LatestCheckpoint: testcase.Latest,
+				},
+			}
+			pod := buildPod(namespace, "job1-task1-0", v1.PodRunning, nil)
+			pod.Annotations[apis.CheckpointTimeoutKey] = "600"
+			jobInfo := &apis.JobInfo{
+				Namespace: namespace,
+				Name:      "job1",
+				Job:       job,
+				Pods: map[string]map[string]*v1.Pod{
+					"task1": {pod.Name: pod},
+				},
+			}
+
+			fakecontroller := newFakeController()
+			state.KillJob = fakecontroller.killJob
+			state.CheckpointJob = fakecontroller.checkpointJob
+
+			if _, err := fakecontroller.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Error while creating Job: %v", err)
+			}
+			if err := fakecontroller.cache.Add(job); err != nil {
+				t.Fatalf("Error while adding Job in cache: %v", err)
+			}
+			if _, err := fakecontroller.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Error while creating Pod: %v", err)
+			}
+
+			if err := state.NewState(jobInfo).Execute(testcase.Action); err != nil {
+				t.Fatalf("Expected Error not to occur but got: %s", err)
+			}
+
+			newJob, err := fakecontroller.cache.Get(fmt.Sprintf("%s/%s", namespace, job.Name))
+			if err != nil {
+				t.Fatal("Error while retrieving value from Cache")
+			}
+			if latest := newJob.Job.Status.LatestCheckpoint; latest != testcase.Latest {
+				t.Errorf("Expected latest checkpoint %d, but got %d", testcase.Latest, latest)
+			}
+			if pending := newJob.Job.Status.PendingCheckpoint; !reflect.DeepEqual(pending, testcase.ExpectedPending) {
+				t.Errorf("Expected pending checkpoint %v, but got %v", testcase.ExpectedPending, pending)
+			}
+			for _, action := range fakecontroller.kubeClient.(*kubeclient.Clientset).Actions() {
+				if deleteAction, ok := action.(k8stesting.DeleteAction); ok {
+					if gracePeriod := deleteAction.GetDeleteOptions().GracePeriodSeconds; !reflect.DeepEqual(gracePeriod, testcase.ExpectedGracePeriod) {
+						t.Errorf("Expected grace period %v, but got %v", testcase.ExpectedGracePeriod, gracePeriod)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResolveCheckpoint(t *testing.T) {
+	pending := v1alpha1.JobCheckpoint{Sequence: 3, Version: 1}
+
+	testcases := []struct {
+		Name           string
+		Checkpoints    map[string]v1alpha1.JobCheckpoint
+		ExpectedLatest int32
+	}{
+		{
+			Name: "checkpoint confirmed by all pods is committed",
+			Checkpoints: map[string]v1alpha1.JobCheckpoint{
+				"job1-master-0": pending,
+				"job1-worker-0": pending,
+				"job1-worker-1": pending,
+			},
+			ExpectedLatest: 3,
+		},
+		{
+			Name: "checkpoint not confirmed by a pod is dropped",
+			Checkpoints: map[string]v1alpha1.JobCheckpoint{
+				"job1-master-0": pending,
+				"job1-worker-0": pending,
+			},
+			ExpectedLatest: 2,
+		},
+		{
+			Name: "checkpoint confirmed by a pod of an older version is dropped",
+			Checkpoints: map[string]v1alpha1.JobCheckpoint{
+				"job1-master-0": pending,
+				"job1-worker-0": pending,
+				"job1-worker-1": {Sequence: 3, Version: 0},
+			},
+			ExpectedLatest: 2,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			jobInfo := &apis.JobInfo{
+				Namespace: "test",
+				Name:      "job1",
+				Job: &v1alpha1.Job{
+					ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "test"},
+					Spec: v1alpha1.JobSpec{
+						Tasks: []v1alpha1.TaskSpec{{Name: "master", Replicas: 1}, {Name: "worker", Replicas: 2}},
+					},
+				},
+				Checkpoints: testcase.Checkpoints,
+			}
+			status := &v1alpha1.JobStatus{LatestCheckpoint: 2, PendingCheckpoint: pending.DeepCopy()}
+
+			state.ResolveCheckpoint(jobInfo, status)
+			if status.LatestCheckpoint != testcase.ExpectedLatest {
+				t.Errorf("Expected latest checkpoint %d, but got %d", testcase.ExpectedLatest, status.LatestCheckpoint)
+			}
+			if status.PendingCheckpoint != nil {
+				t.Errorf("Expected pending checkpoint to be resolved, but got %v", status.PendingCheckpoint)
+			}
+		})
+	}
+}
+
+func TestRestartingState_PendingCheckpoint(t *testing.T) {
+	namespace := "test"
+
+	testcases := []struct {
+		Name            string
+		Pods            []*v1.Pod
+		ExpectedLatest  int32
+		ExpectedPending bool
+	}{
+		{
+			Name:            "restart waits for the killed pods to take the checkpoint",
+			Pods:            []*v1.Pod{buildPod(namespace, "job1-task1-0", v1.PodRunning, nil)},
+			ExpectedLatest:  2,
+			ExpectedPending: true,
+		},
+		{
+			Name:           "restart commits the checkpoint once the killed pods are gone",
+			ExpectedLatest: 3,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			pending := v1alpha1.JobCheckpoint{Sequence: 3, Version: 1}
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "job1",
+					Namespace:       namespace,
+					ResourceVersion: "100",
+				},
+				Spec: v1alpha1.JobSpec{
+					Tasks: []v1alpha1.TaskSpec{{Name: "task1", Replicas: 1}},
+				},
+				Status: v1alpha1.JobStatus{
+					State:             v1alpha1.JobState{Phase: v1alpha1.Restarting},
+					Version:           2,
+					LatestCheckpoint:  2,
+					PendingCheckpoint: pending.DeepCopy(),
+				},
+			}
+			jobInfo := &apis.JobInfo{
+				Namespace:   namespace,
+				Name:        job.Name,
+				Job:         job,
+				Pods:        map[string]map[string]*v1.Pod{"task1": {}},
+				Checkpoints: map[string]v1alpha1.JobCheckpoint{"job1-task1-0": pending},
+			}
+			for _, pod := range testcase.Pods {
+				pod.Annotations[v1alpha1.JobVersion] = "1"
+				jobInfo.Pods["task1"][pod.Name] = pod
+			}
+
+			fakecontroller := newFakeController()
+			state.SyncJob = fakecontroller.syncJob
+
+			if _, err := fakecontroller.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Error while creating Job: %v", err)
+			}
+			if err := fakecontroller.cache.Add(job); err != nil {
+				t.Fatalf("Error while adding Job in cache: %v", err)
+			}
+
+			if err := state.NewState(jobInfo).Execute(state.Action{Action: busv1alpha1.SyncJobAction}); err != nil {
+				t.Fatalf("Expected Error not to occur but got: %s", err)
+			}
+
+			newJob, err := fakecontroller.cache.Get(fmt.Sprintf("%s/%s", namespace, job.Name))
+			if err != nil {
+				t.Fatal("Error while retrieving value from Cache")
+			}
+			if latest := newJob.Job.Status.LatestCheckpoint; latest != testcase.ExpectedLatest {
+				t.Errorf("Expected latest checkpoint %d, but got %d", testcase.ExpectedLatest, latest)
+			}
+			if pending := newJob.Job.Status.PendingCheckpoint != nil; pending != testcase.ExpectedPending {
+				t.Errorf("Expected pending checkpoint %v, but got %v", testcase.ExpectedPending, newJob.Job.Status.PendingCheckpoint)
+			}
+			if newJob.Job.Status.State.Phase != v1alpha1.Restarting {
+				t.Errorf("Expected Job phase %s, but got %s", v1alpha1.Restarting, newJob.Job.Status.State.Phase)
+			}
+		})
+	}
+}
+
 func TestTerminatingState_Execute(t *testing.T) {
 	namespace := "test"
 
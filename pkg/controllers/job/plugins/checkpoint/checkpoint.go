@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"flag"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/apis"
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+)
+
+type checkpointPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments []string
+
+	client pluginsinterface.PluginClientset
+
+	// the persistent volume claim the checkpoints are stored on
+	volumeClaim string
+
+	// the path the checkpoints are stored under
+	path string
+
+	// the command to take a checkpoint
+	command string
+
+	// the signal to send to the main process to take a checkpoint
+	signal string
+
+	// the number of checkpoints to keep below the latest committed one, 0 keeps all of them
+	retention int
+
+	// the time in seconds a pod is given to take a checkpoint
+	timeout int64
+}
+
+// New creates checkpoint plugin.
+func New(client pluginsinterface.PluginClientset, arguments []string) pluginsinterface.PluginInterface {
+	cp := checkpointPlugin{pluginArguments: arguments, client: client}
+	cp.addFlags()
+	return &cp
+}
+
+func (cp *checkpointPlugin) addFlags() {
+	flagSet := flag.NewFlagSet(cp.Name(), flag.ContinueOnError)
+	flagSet.StringVar(&cp.volumeClaim, "volume-claim", "", "persistent volume claim to store the checkpoints on, the path must be mounted by the job if it is empty")
+	flagSet.StringVar(&cp.path, "path", DefaultPath, "path the checkpoints are stored under")
+	flagSet.StringVar(&cp.command, "command", "", "shell command run in the first container of a pod to take a checkpoint before it is killed")
+	flagSet.StringVar(&cp.signal, "signal", "", "signal sent to the main process of the first container of a pod to take a checkpoint before it is killed")
+	flagSet.IntVar(&cp.retention, "retention", DefaultRetention, "number of checkpoints to keep below the latest committed one, 0 keeps all of them")
+	flagSet.Int64Var(&cp.timeout, "timeout", DefaultTimeout, "time in seconds a pod is given to take a checkpoint before it is killed")
+	if err := flagSet.Parse(cp.pluginArguments); err != nil {
+		klog.Errorf("plugin %s flagset parse failed, err: %v", cp.Name(), err)
+	}
+}
+
+func (cp *checkpointPlugin) Name() string {
+	return jobhelpers.CheckpointPluginName
+}
+
+// OnPodCreate tells the pod where to write its checkpoint and where to restore from. The pods write to the directory
+// of the next checkpoint until the job commits it, see state.ResolveCheckpoint, so all pods killed together write the
+// same checkpoint.
+func (cp *checkpointPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
+	latest := int(job.Status.LatestCheckpoint)
+	checkpointPath := cp.checkpointDir(latest + 1)
+	envVars := []v1.EnvVar{{Name: EnvCheckpointPath, Value: checkpointPath}}
+	if latest > 0 {
+		envVars = append(envVars, v1.EnvVar{Name: EnvRestorePath, Value: cp.checkpointDir(latest)})
+	}
+	doneFile := path.Join(checkpointPath, pod.Name+".done")
+	if len(cp.signal) > 0 {
+		envVars = append(envVars, v1.EnvVar{Name: EnvCheckpointDone, Value: doneFile})
+	}
+
+	if len(cp.volumeClaim) > 0 {
+		cp.mountVolume(pod, job)
+	}
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, envVars...)
+	}
+
+	if len(cp.command) == 0 && len(cp.signal) == 0 {
+		return nil
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return nil
+	}
+	container := &pod.Spec.Containers[0]
+	if container.Lifecycle != nil && container.Lifecycle.PreStop != nil {
+		klog.Warningf("Container <%s> of pod <%s/%s> has a preStop hook, it does not take a checkpoint before it is killed",
+			container.Name, pod.Namespace, pod.Name)
+		return nil
+	}
+	if container.Lifecycle == nil {
+		container.Lifecycle = &v1.Lifecycle{}
+	}
+	messagePath := container.TerminationMessagePath
+	if len(messagePath) == 0 {
+		messagePath = v1.TerminationMessagePathDefault
+	}
+	container.Lifecycle.PreStop = &v1.LifecycleHandler{
+		Exec: &v1.ExecAction{Command: []string{"sh", "-c", cp.generateCheckpointScript(latest+1, doneFile, messagePath)}},
+	}
+
+	// The grace period of the pod is left as is, the job controller gives the pod the timeout to take the checkpoint
+	// only when it kills the pod for a planned action, see state.CheckpointJob.
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[apis.CheckpointKey] = strconv.Itoa(latest + 1)
+	pod.Annotations[apis.CheckpointTimeoutKey] = strconv.FormatInt(cp.timeout, 10)
+
+	return nil
+}
+
+func (cp *checkpointPlugin) checkpointDir(seq int) string {
+	return path.Join(cp.path, fmt.Sprintf(CheckpointDirFmt, seq))
+}
+
+func (cp *checkpointPlugin) mountVolume(pod *v1.Pod, job *batch.Job) {
+	volumeName := cp.volumeName(job)
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == volumeName {
+			return
+		}
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: volumeName,
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: cp.volumeClaim},
+		},
+	})
+
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, v1.VolumeMount{
+			Name:      volumeName,
+			MountPath: cp.path,
+		})
+	}
+}
+
+func (cp *checkpointPlugin) volumeName(job *batch.Job) string {
+	return fmt.Sprintf("%s-%s", job.Name, cp.Name())
+}
+
+// generateCheckpointScript generates the script of the preStop hook, which takes the checkpoint of the sequence
+// number, confirms it to the job controller by the termination message once the done file exists, and then removes
+// the checkpoints out of retention. The checkpoint of the sequence number is not committed until all pods confirm it,
+// so the latest committed one, the sequence number minus one, is never removed: the restarted pods restore from it
+// if any pod fails to confirm.
+func (cp *checkpointPlugin) generateCheckpointScript(seq int, doneFile, messagePath string) string {
+	checkpointPath := cp.checkpointDir(seq)
+	steps := []string{fmt.Sprintf("mkdir -p %s", checkpointPath)}
+	if len(cp.command) > 0 {
+		steps = append(steps, fmt.Sprintf("(%s)", cp.command), fmt.Sprintf("touch %s", doneFile))
+	} else {
+		steps = append(steps,
+			fmt.Sprintf("kill -s %s 1", cp.signal),
+			fmt.Sprintf("until [ -e %s ]; do sleep 1; done", doneFile))
+	}
+	steps = append(steps, fmt.Sprintf("echo '%s' > %s", fmt.Sprintf(apis.CheckpointConfirmationFmt, seq), messagePath))
+	if prune := seq - 1 - cp.retention; cp.retention > 0 && prune > 1 {
+		steps = append(steps, fmt.Sprintf(`for d in %s; do n=${d##*-}; [ "$n" -lt %d ] && rm -rf "$d"; done; true`,
+			path.Join(cp.path, "checkpoint-*"), prune))
+	}
+	return strings.Join(steps, " && ")
+}
+
+func (cp *checkpointPlugin) OnJobAdd(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+cp.Name()] == cp.Name() {
+		return nil
+	}
+
+	job.Status.ControlledResources["plugin-"+cp.Name()] = cp.Name()
+
+	return nil
+}
+
+func (cp *checkpointPlugin) OnJobDelete(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+cp.Name()] != cp.Name() {
+		return nil
+	}
+	delete(job.Status.ControlledResources, "plugin-"+cp.Name())
+	return nil
+}
+
+func (cp *checkpointPlugin) OnJobUpdate(job *batch.Job) error {
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/apis"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+)
+
+func TestCheckpointOnPodCreate(t *testing.T) {
+	testcases := []struct {
+		name                string
+		arguments           []string
+		latest              int32
+		messagePath         string
+		expectedEnvs        []v1.EnvVar
+		expectedVolumes     []v1.Volume
+		expectedPreStop     []string
+		expectedAnnotations map[string]string
+	}{
+		{
+			name:      "first pods have nothing to restore",
+			arguments: []string{"--command=python save.py"},
+			expectedEnvs: []v1.EnvVar{
+				{Name: EnvCheckpointPath, Value: "/checkpoints/checkpoint-1"},
+			},
+			expectedPreStop: []string{"sh", "-c", "mkdir -p /checkpoints/checkpoint-1 && (python save.py) && " +
+				"touch /checkpoints/checkpoint-1/job1-worker-0.done && echo 'checkpoint-1 done' > /dev/termination-log"},
+			expectedAnnotations: map[string]string{apis.CheckpointKey: "1", apis.CheckpointTimeoutKey: "600"},
+		},
+		{
+			name:      "restarted pods restore from the latest checkpoint and remove the ones out of retention",
+			arguments: []string{"--command=python save.py", "--retention=2", "--timeout=60", "--volume-claim=ckpt", "--path=/data"},
+			latest:    4,
+			expectedEnvs: []v1.EnvVar{
+				{Name: EnvCheckpointPath, Value: "/data/checkpoint-5"},
+				{Name: EnvRestorePath, Value: "/data/checkpoint-4"},
+			},
+			expectedVolumes: []v1.Volume{{
+				Name:         "job1-checkpoint",
+				VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "ckpt"}},
+			}},
+			expectedPreStop: []string{"sh", "-c", `mkdir -p /data/checkpoint-5 && (python save.py) && ` +
+				`touch /data/checkpoint-5/job1-worker-0.done && echo 'checkpoint-5 done' > /dev/termination-log && ` +
+				`for d in /data/checkpoint-*; do n=${d##*-}; [ "$n" -lt 2 ] && rm -rf "$d"; done; true`},
+			expectedAnnotations: map[string]string{apis.CheckpointKey: "5", apis.CheckpointTimeoutKey: "60"},
+		},
+		{
+			name:        "signal waits for the checkpoint to be done",
+			arguments:   []string{"--signal=USR1"},
+			latest:      1,
+			messagePath: "/var/log/termination",
+			expectedEnvs: []v1.EnvVar{
+				{Name: EnvCheckpointPath, Value: "/checkpoints/checkpoint-2"},
+				{Name: EnvRestorePath, Value: "/checkpoints/checkpoint-1"},
+				{Name: EnvCheckpointDone, Value: "/checkpoints/checkpoint-2/job1-worker-0.done"},
+			},
+			expectedPreStop: []string{"sh", "-c", "mkdir -p /checkpoints/checkpoint-2 && kill -s USR1 1 && " +
+				"until [ -e /checkpoints/checkpoint-2/job1-worker-0.done ]; do sleep 1; done && " +
+				"echo 'checkpoint-2 done' > /var/log/termination"},
+			expectedAnnotations: map[string]string{apis.CheckpointKey: "2", apis.CheckpointTimeoutKey: "600"},
+		},
+		{
+			name: "no trigger only tells where the checkpoints are",
+			expectedEnvs: []v1.EnvVar{
+				{Name: EnvCheckpointPath, Value: "/checkpoints/checkpoint-1"},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "ns1"},
+				Status:     v1alpha1.JobStatus{LatestCheckpoint: testcase.latest},
+			}
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "job1-worker-0", Namespace: "ns1"},
+				Spec: v1.PodSpec{
+					Containers:                    []v1.Container{{Name: "main", TerminationMessagePath: testcase.messagePath}, {Name: "sidecar"}},
+					TerminationGracePeriodSeconds: ptr.To[int64](30),
+				},
+			}
+
+			cp := New(pluginsinterface.PluginClientset{}, testcase.arguments)
+			if err := cp.OnPodCreate(pod, job); err != nil {
+				t.Fatalf("OnPodCreate failed: %v", err)
+			}
+
+			for _, c := range pod.Spec.Containers {
+				if !equality.Semantic.DeepEqual(c.Env, testcase.expectedEnvs) {
+					t.Errorf("wrong envs of container %s, got %v, expected %v", c.Name, c.Env, testcase.expectedEnvs)
+				}
+			}
+			if !equality.Semantic.DeepEqual(pod.Spec.Volumes, testcase.expectedVolumes) {
+				t.Errorf("wrong volumes, got %v, expected %v", pod.Spec.Volumes, testcase.expectedVolumes)
+			}
+			var preStop []string
+			if lifecycle := pod.Spec.Containers[0].Lifecycle; lifecycle != nil && lifecycle.PreStop != nil {
+				preStop = lifecycle.PreStop.Exec.Command
+			}
+			if !equality.Semantic.DeepEqual(preStop, testcase.expectedPreStop) {
+				t.Errorf("wrong preStop hook, got %q, expected %q", preStop, testcase.expectedPreStop)
+			}
+			if pod.Spec.Containers[1].Lifecycle != nil {
+				t.Errorf("sidecar should not take a checkpoint")
+			}
+			if !equality.Semantic.DeepEqual(pod.Annotations, testcase.expectedAnnotations) {
+				t.Errorf("wrong annotations, got %v, expected %v", pod.Annotations, testcase.expectedAnnotations)
+			}
+			if *pod.Spec.TerminationGracePeriodSeconds != 30 {
+				t.Errorf("grace period should not be changed, got %d", *pod.Spec.TerminationGracePeriodSeconds)
+			}
+		})
+	}
+}
+
+func TestCheckpointRetentionKeepsLatest(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"checkpoint-1", "checkpoint-2", "checkpoint-3"} {
+		if err := os.Mkdir(filepath.Join(dir, name), 0o755); err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+	}
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "ns1"},
+		Status:     v1alpha1.JobStatus{LatestCheckpoint: 3},
+	}
+	cp := New(pluginsinterface.PluginClientset{}, []string{"--command=true", "--retention=1", "--path=" + dir})
+
+	// Only the first pod takes the checkpoint 4 and confirms it, the second one does not, so the checkpoint 4 is
+	// never committed and the latest one stays 3.
+	for _, name := range []string{"job1-worker-0", "job1-worker-1"} {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns1"},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:                   "main",
+				TerminationMessagePath: filepath.Join(dir, name+".log"),
+			}}},
+		}
+		if err := cp.OnPodCreate(pod, job); err != nil {
+			t.Fatalf("OnPodCreate failed: %v", err)
+		}
+		if name != "job1-worker-0" {
+			continue
+		}
+		preStop := pod.Spec.Containers[0].Lifecycle.PreStop.Exec.Command
+		if out, err := exec.Command(preStop[0], preStop[1:]...).CombinedOutput(); err != nil {
+			t.Fatalf("preStop hook failed: %v, %s", err, out)
+		}
+	}
+
+	// The restarted pods restore from the latest committed checkpoint, which must still exist.
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "job1-worker-0", Namespace: "ns1"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "main"}}},
+	}
+	if err := cp.OnPodCreate(pod, job); err != nil {
+		t.Fatalf("OnPodCreate failed: %v", err)
+	}
+	var restorePath string
+	for _, env := range pod.Spec.Containers[0].Env {
+		if env.Name == EnvRestorePath {
+			restorePath = env.Value
+		}
+	}
+	if restorePath != filepath.Join(dir, "checkpoint-3") {
+		t.Fatalf("wrong restore path %q", restorePath)
+	}
+	for name, expected := range map[string]bool{"checkpoint-1": false, "checkpoint-2": true, "checkpoint-3": true, "checkpoint-4": true} {
+		if _, err := os.Stat(filepath.Join(dir, name)); (err == nil) != expected {
+			t.Errorf("expected %s to exist %v, got %v", name, expected, err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+const (
+	// DefaultPath is the default path the checkpoints are stored under
+	DefaultPath = "/checkpoints"
+
+	// DefaultRetention is the default number of checkpoints to keep below the latest committed one
+	DefaultRetention = 3
+
+	// DefaultTimeout is the default time in seconds a pod is given to take a checkpoint before it is killed
+	DefaultTimeout = 600
+
+	// CheckpointDirFmt is the format of the directory of a checkpoint under the path
+	CheckpointDirFmt = "checkpoint-%d"
+
+	// EnvCheckpointPath is the env name of the directory the pod writes its checkpoint to
+	EnvCheckpointPath = "VC_CHECKPOINT_PATH"
+
+	// EnvRestorePath is the env name of the directory of the latest checkpoint of the job
+	EnvRestorePath = "VC_RESTORE_PATH"
+
+	// EnvCheckpointDone is the env name of the file the pod creates when it finished the checkpoint triggered by a signal
+	EnvCheckpointDone = "VC_CHECKPOINT_DONE"
+)
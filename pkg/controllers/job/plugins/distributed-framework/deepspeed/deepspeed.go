@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deepspeed
+
+import (
+	"flag"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	apishelpers "volcano.sh/apis/pkg/apis/helpers"
+	"volcano.sh/volcano/pkg/controllers/job/helpers"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+)
+
+const (
+	// DeepSpeedPluginName is the name of the plugin
+	DeepSpeedPluginName = "deepspeed"
+	// DefaultLauncher is the default task name of the launcher host
+	DefaultLauncher = "launcher"
+	// DefaultWorker is the default task name of worker hosts
+	DefaultWorker = "worker"
+	// DefaultPort is the default port of the rendezvous of the processes
+	DefaultPort = 29500
+	// DefaultSSHPort is the default port for ssh
+	DefaultSSHPort = 22
+	// DefaultHostfile is the default path of the hostfile, which is where the deepspeed launcher looks for it
+	DefaultHostfile = "/job/hostfile"
+	// DefaultTimeout is the default timeout for waiting workers (in seconds)
+	DefaultTimeout = 300
+	// DefaultWaitWorkersImage is the default image for wait-for-workers init container
+	DefaultWaitWorkersImage = "busybox:1.36.1"
+
+	// HostfileKey is the key of the hostfile in the ConfigMap
+	HostfileKey = "hostfile"
+	// GPUResourceName is the resource the slots of a worker are counted from
+	GPUResourceName v1.ResourceName = "nvidia.com/gpu"
+
+	// EnvMasterAddr is the env name of master addr
+	EnvMasterAddr = "MASTER_ADDR"
+	// EnvMasterPort is the env name of master port
+	EnvMasterPort = "MASTER_PORT"
+	// EnvWorldSize is the env name of world size
+	EnvWorldSize = "WORLD_SIZE"
+	// EnvNodeRank is the env name of the rank of a worker host
+	EnvNodeRank = "NODE_RANK"
+	// EnvNumNodes is the env name of the number of worker hosts
+	EnvNumNodes = "NNODES"
+)
+
+type deepSpeedPlugin struct {
+	deepSpeedArguments []string
+	clientset          pluginsinterface.PluginClientset
+	launcherName       string
+	workerName         string
+	port               int
+	sshPort            int
+	slots              int
+	hostfile           string
+	waitWorkersEnabled bool
+	waitWorkersTimeout int
+	waitWorkersImage   string
+}
+
+// New creates deepspeed plugin.
+func New(client pluginsinterface.PluginClientset, arguments []string) pluginsinterface.PluginInterface {
+	dp := deepSpeedPlugin{deepSpeedArguments: arguments, clientset: client}
+	dp.addFlags()
+	return &dp
+}
+
+func (dp *deepSpeedPlugin) addFlags() {
+	flagSet := flag.NewFlagSet(dp.Name(), flag.ContinueOnError)
+	flagSet.StringVar(&dp.launcherName, "launcher", DefaultLauncher, "name of launcher role task")
+	flagSet.StringVar(&dp.workerName, "worker", DefaultWorker, "name of worker role task")
+	flagSet.IntVar(&dp.port, "port", DefaultPort, "port of the rendezvous of the processes")
+	flagSet.IntVar(&dp.sshPort, "ssh-port", DefaultSSHPort, "ssh port to open for worker containers")
+	flagSet.IntVar(&dp.slots, "slots", 0, "number of processes per worker, the nvidia.com/gpu limit of the worker container by default")
+	flagSet.StringVar(&dp.hostfile, "hostfile", DefaultHostfile, "path of the hostfile in the launcher container")
+	flagSet.BoolVar(&dp.waitWorkersEnabled, "wait-workers-enabled", true, "enable init container of the launcher to wait for workers")
+	flagSet.IntVar(&dp.waitWorkersTimeout, "wait-workers-timeout", DefaultTimeout, "timeout in seconds for waiting workers to be ready (only effective when wait-workers-enabled=true)")
+	flagSet.StringVar(&dp.waitWorkersImage, "wait-workers-image", DefaultWaitWorkersImage, "image for wait-for-workers init container (only effective when wait-workers-enabled=true)")
+	if err := flagSet.Parse(dp.deepSpeedArguments); err != nil {
+		klog.Errorf("plugin %s flagset parse failed, err: %v", dp.Name(), err)
+	}
+}
+
+func (dp *deepSpeedPlugin) Name() string {
+	return DeepSpeedPluginName
+}
+
+func (dp *deepSpeedPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
+	workerIndex := helpers.GetTaskIndexUnderJob(dp.workerName, job)
+	if workerIndex == -1 {
+		return fmt.Errorf("job %v doesn't have worker task %v", job.Name, dp.workerName)
+	}
+	workerTask := job.Spec.Tasks[workerIndex]
+	workerHosts := dp.generateWorkerHosts(workerTask, job)
+	if len(workerHosts) == 0 {
+		return nil
+	}
+
+	envVars := []v1.EnvVar{
+		{Name: EnvMasterAddr, Value: workerHosts[0]},
+		{Name: EnvMasterPort, Value: strconv.Itoa(dp.port)},
+		{Name: EnvWorldSize, Value: strconv.Itoa(len(workerHosts) * dp.getSlots(workerTask))},
+		{Name: EnvNumNodes, Value: strconv.Itoa(len(workerHosts))},
+	}
+
+	switch helpers.GetTaskKey(pod) {
+	case dp.workerName:
+		index, err := strconv.Atoi(helpers.GetPodIndexUnderTask(pod))
+		if err != nil {
+			return err
+		}
+		envVars = append(envVars, v1.EnvVar{Name: EnvNodeRank, Value: strconv.Itoa(index)})
+		for i, c := range pod.Spec.Containers {
+			dp.openContainerPort(&c, i, pod)
+		}
+	case dp.launcherName:
+		// The launcher reaches the workers over ssh as soon as it starts, so it waits for them to be ready.
+		dp.addWaitForWorkersInitContainer(pod, workerHosts)
+		dp.mountHostfile(pod, job)
+	}
+
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, envVars...)
+	}
+
+	return nil
+}
+
+// getSlots returns the number of processes per worker.
+func (dp *deepSpeedPlugin) getSlots(task batch.TaskSpec) int {
+	if dp.slots > 0 {
+		return dp.slots
+	}
+	for _, c := range task.Template.Spec.Containers {
+		if gpus, found := c.Resources.Limits[GPUResourceName]; found && gpus.Value() > 0 {
+			return int(gpus.Value())
+		}
+	}
+	return 1
+}
+
+func (dp *deepSpeedPlugin) generateWorkerHosts(task batch.TaskSpec, job *batch.Job) []string {
+	hosts := make([]string, 0, task.Replicas)
+	for i := 0; i < int(task.Replicas); i++ {
+		hosts = append(hosts, helpers.MakeDomainName(task, job, i))
+	}
+	return hosts
+}
+
+// generateHostfile generates the hostfile of the deepspeed launcher, one worker per line with its slots.
+func (dp *deepSpeedPlugin) generateHostfile(job *batch.Job) map[string]string {
+	var builder strings.Builder
+	if workerIndex := helpers.GetTaskIndexUnderJob(dp.workerName, job); workerIndex != -1 {
+		workerTask := job.Spec.Tasks[workerIndex]
+		slots := dp.getSlots(workerTask)
+		for _, host := range dp.generateWorkerHosts(workerTask, job) {
+			fmt.Fprintf(&builder, "%s slots=%d\n", host, slots)
+		}
+	}
+	return map[string]string{HostfileKey: builder.String()}
+}
+
+func (dp *deepSpeedPlugin) cmName(job *batch.Job) string {
+	return fmt.Sprintf("%s-%s", job.Name, dp.Name())
+}
+
+func (dp *deepSpeedPlugin) mountHostfile(pod *v1.Pod, job *batch.Job) {
+	cmName := dp.cmName(job)
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: cmName,
+		VolumeSource: v1.VolumeSource{
+			ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: cmName},
+				Items:                []v1.KeyToPath{{Key: HostfileKey, Path: path.Base(dp.hostfile)}},
+			},
+		},
+	})
+
+	// The ConfigMap is mounted as the directory of the hostfile rather than by subPath, so that the launcher
+	// sees the hostfile updated as the workers are scaled.
+	vm := v1.VolumeMount{
+		MountPath: path.Dir(dp.hostfile),
+		Name:      cmName,
+	}
+	for i, c := range pod.Spec.Containers {
+		pod.Spec.Containers[i].VolumeMounts = append(c.VolumeMounts, vm)
+	}
+}
+
+func (dp *deepSpeedPlugin) generateWaitForWorkersScript(workerHosts []string) string {
+	return fmt.Sprintf(`TIMEOUT=%d
+ELAPSED=0
+for HOST in %s; do
+  echo "Waiting for worker $HOST:%d to be ready..."
+  until { nc -z $HOST %d || echo > /dev/tcp/$HOST/%d; } 2>/dev/null; do
+    if [ $ELAPSED -ge $TIMEOUT ]; then
+      echo "Timeout waiting for workers after ${TIMEOUT} seconds"
+      exit 1
+    fi
+    echo "Worker $HOST not ready yet, retrying in 2 seconds... (elapsed: ${ELAPSED}s)"
+    sleep 2
+    ELAPSED=$((ELAPSED + 2))
+  done
+done
+echo "All workers are ready!"`, dp.waitWorkersTimeout, strings.Join(workerHosts, " "), dp.sshPort, dp.sshPort, dp.sshPort)
+}
+
+func (dp *deepSpeedPlugin) addWaitForWorkersInitContainer(pod *v1.Pod, workerHosts []string) {
+	if !dp.waitWorkersEnabled {
+		return
+	}
+
+	for _, initContainer := range pod.Spec.InitContainers {
+		if initContainer.Name == "wait-for-workers" {
+			return
+		}
+	}
+
+	initContainer := v1.Container{
+		Name:  "wait-for-workers",
+		Image: dp.waitWorkersImage,
+		Command: []string{
+			"sh",
+			"-c",
+			dp.generateWaitForWorkersScript(workerHosts),
+		},
+	}
+
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, initContainer)
+	klog.V(4).Infof("Added wait-for-workers init container to launcher pod %s/%s", pod.Namespace, pod.Name)
+}
+
+func (dp *deepSpeedPlugin) openContainerPort(c *v1.Container, index int, pod *v1.Pod) {
+	for _, p := range c.Ports {
+		if p.ContainerPort == int32(dp.sshPort) {
+			return
+		}
+	}
+
+	port := v1.ContainerPort{
+		Name:          "deepspeed-ssh",
+		ContainerPort: int32(dp.sshPort),
+	}
+	pod.Spec.Containers[index].Ports = append(pod.Spec.Containers[index].Ports, port)
+}
+
+func (dp *deepSpeedPlugin) OnJobAdd(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+dp.Name()] == dp.Name() {
+		return nil
+	}
+
+	// Create ConfigMap of the hostfile for the launcher to mount.
+	if err := apishelpers.CreateOrUpdateConfigMap(job, dp.clientset.KubeClients, dp.generateHostfile(job), dp.cmName(job)); err != nil {
+		return err
+	}
+
+	job.Status.ControlledResources["plugin-"+dp.Name()] = dp.Name()
+	return nil
+}
+
+func (dp *deepSpeedPlugin) OnJobDelete(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+dp.Name()] != dp.Name() {
+		return nil
+	}
+
+	if err := apishelpers.DeleteConfigmap(job, dp.clientset.KubeClients, dp.cmName(job)); err != nil {
+		return err
+	}
+
+	delete(job.Status.ControlledResources, "plugin-"+dp.Name())
+	return nil
+}
+
+func (dp *deepSpeedPlugin) OnJobUpdate(job *batch.Job) error {
+	// updates ConfigMap of the hostfile as the workers are scaled.
+	return apishelpers.CreateOrUpdateConfigMap(job, dp.clientset.KubeClients, dp.generateHostfile(job), dp.cmName(job))
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deepspeed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+)
+
+func buildJob() *v1alpha1.Job {
+	return &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "ds", Namespace: "ns1"},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{
+				{Name: "launcher", Replicas: 1},
+				{
+					Name:     "worker",
+					Replicas: 2,
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{{
+								Name: "worker",
+								Resources: v1.ResourceRequirements{
+									Limits: v1.ResourceList{GPUResourceName: resource.MustParse("4")},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.JobStatus{ControlledResources: map[string]string{}},
+	}
+}
+
+func buildPod(name, task string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{v1alpha1.TaskSpecKey: task},
+		},
+		Spec: v1.PodSpec{Containers: []v1.Container{{Name: task}}},
+	}
+}
+
+// hostfileVolume returns the volume of the ConfigMap of the hostfile, which projects the hostfile to file.
+func hostfileVolume(file string) v1.Volume {
+	return v1.Volume{
+		Name: "ds-deepspeed",
+		VolumeSource: v1.VolumeSource{
+			ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: "ds-deepspeed"},
+				Items:                []v1.KeyToPath{{Key: HostfileKey, Path: file}},
+			},
+		},
+	}
+}
+
+func TestDeepSpeedOnPodCreate(t *testing.T) {
+	testcases := []struct {
+		name              string
+		arguments         []string
+		pod               *v1.Pod
+		expectedEnvs      []v1.EnvVar
+		expectedPorts     []v1.ContainerPort
+		expectedMounts    []v1.VolumeMount
+		expectedVolumes   []v1.Volume
+		expectedInitNames []string
+	}{
+		{
+			name: "worker gets its node rank and opens ssh port",
+			pod:  buildPod("ds-worker-1", "worker"),
+			expectedEnvs: []v1.EnvVar{
+				{Name: EnvMasterAddr, Value: "ds-worker-0.ds"},
+				{Name: EnvMasterPort, Value: "29500"},
+				{Name: EnvWorldSize, Value: "8"},
+				{Name: EnvNumNodes, Value: "2"},
+				{Name: EnvNodeRank, Value: "1"},
+			},
+			expectedPorts: []v1.ContainerPort{{Name: "deepspeed-ssh", ContainerPort: DefaultSSHPort}},
+		},
+		{
+			name:      "launcher mounts the hostfile and waits for workers",
+			arguments: []string{"--slots=2", "--hostfile=/etc/deepspeed/hostfile"},
+			pod:       buildPod("ds-launcher-0", "launcher"),
+			expectedEnvs: []v1.EnvVar{
+				{Name: EnvMasterAddr, Value: "ds-worker-0.ds"},
+				{Name: EnvMasterPort, Value: "29500"},
+				{Name: EnvWorldSize, Value: "4"},
+				{Name: EnvNumNodes, Value: "2"},
+			},
+			expectedMounts:    []v1.VolumeMount{{Name: "ds-deepspeed", MountPath: "/etc/deepspeed"}},
+			expectedVolumes:   []v1.Volume{hostfileVolume("hostfile")},
+			expectedInitNames: []string{"wait-for-workers"},
+		},
+		{
+			name:      "launcher does not wait for workers when disabled",
+			arguments: []string{"--wait-workers-enabled=false"},
+			pod:       buildPod("ds-launcher-0", "launcher"),
+			expectedEnvs: []v1.EnvVar{
+				{Name: EnvMasterAddr, Value: "ds-worker-0.ds"},
+				{Name: EnvMasterPort, Value: "29500"},
+				{Name: EnvWorldSize, Value: "8"},
+				{Name: EnvNumNodes, Value: "2"},
+			},
+			expectedMounts:  []v1.VolumeMount{{Name: "ds-deepspeed", MountPath: "/job"}},
+			expectedVolumes: []v1.Volume{hostfileVolume("hostfile")},
+		},
+		{
+			name:      "launcher mounts the hostfile under its own name",
+			arguments: []string{"--hostfile=/etc/deepspeed/hosts", "--wait-workers-enabled=false"},
+			pod:       buildPod("ds-launcher-0", "launcher"),
+			expectedEnvs: []v1.EnvVar{
+				{Name: EnvMasterAddr, Value: "ds-worker-0.ds"},
+				{Name: EnvMasterPort, Value: "29500"},
+				{Name: EnvWorldSize, Value: "8"},
+				{Name: EnvNumNodes, Value: "2"},
+			},
+			expectedMounts:  []v1.VolumeMount{{Name: "ds-deepspeed", MountPath: "/etc/deepspeed"}},
+			expectedVolumes: []v1.Volume{hostfileVolume("hosts")},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			dp := New(pluginsinterface.PluginClientset{}, testcase.arguments)
+			if err := dp.OnPodCreate(testcase.pod, buildJob()); err != nil {
+				t.Fatalf("OnPodCreate failed: %v", err)
+			}
+			container := testcase.pod.Spec.Containers[0]
+			if !equality.Semantic.DeepEqual(container.Env, testcase.expectedEnvs) {
+				t.Errorf("wrong envs, got %v, expected %v", container.Env, testcase.expectedEnvs)
+			}
+			if !equality.Semantic.DeepEqual(container.Ports, testcase.expectedPorts) {
+				t.Errorf("wrong ports, got %v, expected %v", container.Ports, testcase.expectedPorts)
+			}
+			if !equality.Semantic.DeepEqual(container.VolumeMounts, testcase.expectedMounts) {
+				t.Errorf("wrong volume mounts, got %v, expected %v", container.VolumeMounts, testcase.expectedMounts)
+			}
+			if !equality.Semantic.DeepEqual(testcase.pod.Spec.Volumes, testcase.expectedVolumes) {
+				t.Errorf("wrong volumes, got %v, expected %v", testcase.pod.Spec.Volumes, testcase.expectedVolumes)
+			}
+			var initNames []string
+			for _, c := range testcase.pod.Spec.InitContainers {
+				initNames = append(initNames, c.Name)
+			}
+			if !equality.Semantic.DeepEqual(initNames, testcase.expectedInitNames) {
+				t.Errorf("wrong init containers, got %v, expected %v", initNames, testcase.expectedInitNames)
+			}
+		})
+	}
+}
+
+func TestDeepSpeedHostfile(t *testing.T) {
+	job := buildJob()
+	fakeClient := fake.NewSimpleClientset()
+	dp := New(pluginsinterface.PluginClientset{KubeClients: fakeClient}, nil)
+
+	if err := dp.OnJobAdd(job); err != nil {
+		t.Fatalf("OnJobAdd failed: %v", err)
+	}
+	cm, err := fakeClient.CoreV1().ConfigMaps(job.Namespace).Get(context.TODO(), "ds-deepspeed", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get ConfigMap of the hostfile: %v", err)
+	}
+	expected := map[string]string{HostfileKey: "ds-worker-0.ds slots=4\nds-worker-1.ds slots=4\n"}
+	if diff := cmp.Diff(expected, cm.Data); diff != "" {
+		t.Errorf("wrong hostfile (-want +got):\n%s", diff)
+	}
+
+	// The workers are scaled up.
+	job.Spec.Tasks[1].Replicas = 3
+	if err := dp.OnJobUpdate(job); err != nil {
+		t.Fatalf("OnJobUpdate failed: %v", err)
+	}
+	cm, err = fakeClient.CoreV1().ConfigMaps(job.Namespace).Get(context.TODO(), "ds-deepspeed", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get ConfigMap of the hostfile: %v", err)
+	}
+	expected = map[string]string{HostfileKey: "ds-worker-0.ds slots=4\nds-worker-1.ds slots=4\nds-worker-2.ds slots=4\n"}
+	if diff := cmp.Diff(expected, cm.Data); diff != "" {
+		t.Errorf("wrong hostfile (-want +got):\n%s", diff)
+	}
+
+	if err := dp.OnJobDelete(job); err != nil {
+		t.Fatalf("OnJobDelete failed: %v", err)
+	}
+	if _, err := fakeClient.CoreV1().ConfigMaps(job.Namespace).Get(context.TODO(), "ds-deepspeed", metav1.GetOptions{}); err == nil {
+		t.Errorf("ConfigMap of the hostfile is not deleted")
+	}
+}
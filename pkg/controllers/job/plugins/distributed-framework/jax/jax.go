@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jax
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	apishelpers "volcano.sh/apis/pkg/apis/helpers"
+	"volcano.sh/volcano/pkg/controllers/job/helpers"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+)
+
+const (
+	// JAXPluginName is the name of the plugin
+	JAXPluginName = "jax"
+	// DefaultPort is the default port of the coordinator
+	DefaultPort = 1234
+	// DefaultTimeout is the default timeout for waiting the coordinator (in seconds)
+	DefaultTimeout = 300
+	// DefaultWaitCoordinatorImage is the default image for wait-for-coordinator init container
+	DefaultWaitCoordinatorImage = "busybox:1.36.1"
+	// CoordinatorPortName is the port name of the coordinator
+	CoordinatorPortName = "jax-coordinator"
+
+	// EnvCoordinatorAddress is the env name of the address of the coordinator
+	EnvCoordinatorAddress = "JAX_COORDINATOR_ADDRESS"
+	// EnvNumProcesses is the env name of the number of processes
+	EnvNumProcesses = "JAX_NUM_PROCESSES"
+	// EnvProcessID is the env name of the id of the process
+	EnvProcessID = "JAX_PROCESS_ID"
+)
+
+type jaxPlugin struct {
+	jaxArguments           []string
+	clientset              pluginsinterface.PluginClientset
+	port                   int
+	waitCoordinatorEnabled bool
+	waitCoordinatorTimeout int
+	waitCoordinatorImage   string
+}
+
+// New creates jax plugin.
+func New(client pluginsinterface.PluginClientset, arguments []string) pluginsinterface.PluginInterface {
+	jp := jaxPlugin{jaxArguments: arguments, clientset: client}
+	jp.addFlags()
+	return &jp
+}
+
+func (jp *jaxPlugin) addFlags() {
+	flagSet := flag.NewFlagSet(jp.Name(), flag.ContinueOnError)
+	flagSet.IntVar(&jp.port, "port", DefaultPort, "port of the coordinator")
+	flagSet.BoolVar(&jp.waitCoordinatorEnabled, "wait-coordinator-enabled", false, "enable init container to wait for the coordinator")
+	flagSet.IntVar(&jp.waitCoordinatorTimeout, "wait-coordinator-timeout", DefaultTimeout, "timeout in seconds for waiting the coordinator to be ready (only effective when wait-coordinator-enabled=true)")
+	flagSet.StringVar(&jp.waitCoordinatorImage, "wait-coordinator-image", DefaultWaitCoordinatorImage, "image for wait-for-coordinator init container (only effective when wait-coordinator-enabled=true)")
+	if err := flagSet.Parse(jp.jaxArguments); err != nil {
+		klog.Errorf("plugin %s flagset parse failed, err: %v", jp.Name(), err)
+	}
+}
+
+func (jp *jaxPlugin) Name() string {
+	return JAXPluginName
+}
+
+// OnPodCreate makes every pod of the job a JAX process. The processes are numbered in the order of the tasks and of
+// the pods in each task, and the first one is the coordinator.
+func (jp *jaxPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
+	if len(job.Spec.Tasks) == 0 {
+		return nil
+	}
+
+	index, err := strconv.Atoi(helpers.GetPodIndexUnderTask(pod))
+	if err != nil {
+		return err
+	}
+	taskName := helpers.GetTaskKey(pod)
+	processID := -1
+	offset := 0
+	for _, task := range job.Spec.Tasks {
+		if task.Name == taskName {
+			processID = offset + index
+			break
+		}
+		offset += int(task.Replicas)
+	}
+	if processID == -1 {
+		return fmt.Errorf("job %v doesn't have task %v", job.Name, taskName)
+	}
+
+	coordinatorAddr := fmt.Sprintf("%s:%d", jp.serviceName(job), jp.port)
+	envVars := []v1.EnvVar{
+		{Name: EnvCoordinatorAddress, Value: coordinatorAddr},
+		{Name: EnvNumProcesses, Value: strconv.Itoa(int(jp.getTotalReplicas(job)))},
+		{Name: EnvProcessID, Value: strconv.Itoa(processID)},
+	}
+
+	if processID == 0 {
+		for i, c := range pod.Spec.Containers {
+			jp.openContainerPort(&c, i, pod)
+		}
+	} else {
+		// The other processes fail to initialize until the coordinator is up.
+		jp.addWaitForCoordinatorInitContainer(pod, jp.serviceName(job))
+	}
+
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, envVars...)
+	}
+
+	return nil
+}
+
+func (jp *jaxPlugin) getTotalReplicas(job *batch.Job) int32 {
+	jobReplicas := int32(0)
+	for _, task := range job.Spec.Tasks {
+		jobReplicas += task.Replicas
+	}
+
+	return jobReplicas
+}
+
+func (jp *jaxPlugin) serviceName(job *batch.Job) string {
+	return fmt.Sprintf("%s-%s-coordinator", job.Name, jp.Name())
+}
+
+func (jp *jaxPlugin) openContainerPort(c *v1.Container, index int, pod *v1.Pod) {
+	for _, p := range c.Ports {
+		if p.ContainerPort == int32(jp.port) {
+			return
+		}
+	}
+
+	port := v1.ContainerPort{
+		Name:          CoordinatorPortName,
+		ContainerPort: int32(jp.port),
+	}
+	pod.Spec.Containers[index].Ports = append(pod.Spec.Containers[index].Ports, port)
+}
+
+func (jp *jaxPlugin) generateWaitForCoordinatorScript(coordinatorAddr string) string {
+	return fmt.Sprintf(`echo "Waiting for coordinator %s:%d to be ready..."
+TIMEOUT=%d
+ELAPSED=0
+until { nc -z %s %d || echo > /dev/tcp/%s/%d; } 2>/dev/null; do
+  if [ $ELAPSED -ge $TIMEOUT ]; then
+    echo "Timeout waiting for coordinator after ${TIMEOUT} seconds"
+    exit 1
+  fi
+  echo "Coordinator not ready yet, retrying in 2 seconds... (elapsed: ${ELAPSED}s)"
+  sleep 2
+  ELAPSED=$((ELAPSED + 2))
+done
+echo "Coordinator is ready!"`, coordinatorAddr, jp.port, jp.waitCoordinatorTimeout, coordinatorAddr, jp.port, coordinatorAddr, jp.port)
+}
+
+func (jp *jaxPlugin) addWaitForCoordinatorInitContainer(pod *v1.Pod, coordinatorAddr string) {
+	if !jp.waitCoordinatorEnabled {
+		return
+	}
+
+	for _, initContainer := range pod.Spec.InitContainers {
+		if initContainer.Name == "wait-for-coordinator" {
+			return
+		}
+	}
+
+	initContainer := v1.Container{
+		Name:  "wait-for-coordinator",
+		Image: jp.waitCoordinatorImage,
+		Command: []string{
+			"sh",
+			"-c",
+			jp.generateWaitForCoordinatorScript(coordinatorAddr),
+		},
+	}
+
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, initContainer)
+	klog.V(4).Infof("Added wait-for-coordinator init container to pod %s/%s", pod.Namespace, pod.Name)
+}
+
+func (jp *jaxPlugin) OnJobAdd(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+jp.Name()] == jp.Name() {
+		return nil
+	}
+
+	// When the Volcano Job is created, also create a Service for the coordinator
+	if err := jp.createServiceIfNotExist(job); err != nil {
+		return err
+	}
+
+	job.Status.ControlledResources["plugin-"+jp.Name()] = jp.Name()
+	return nil
+}
+
+func (jp *jaxPlugin) OnJobDelete(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+jp.Name()] != jp.Name() {
+		return nil
+	}
+
+	if err := jp.clientset.KubeClients.CoreV1().Services(job.Namespace).Delete(context.TODO(), jp.serviceName(job), metav1.DeleteOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to delete Service of Job %v/%v: %v", job.Namespace, jp.serviceName(job), err)
+			return err
+		}
+	}
+	delete(job.Status.ControlledResources, "plugin-"+jp.Name())
+	return nil
+}
+
+func (jp *jaxPlugin) OnJobUpdate(job *batch.Job) error {
+	return nil
+}
+
+func (jp *jaxPlugin) createServiceIfNotExist(job *batch.Job) error {
+	// If Service does not exist, create one for the coordinator, which is the first pod of the first task.
+	serviceName := jp.serviceName(job)
+	if _, err := jp.clientset.KubeClients.CoreV1().Services(job.Namespace).Get(context.TODO(), serviceName, metav1.GetOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.V(3).Infof("Failed to get Service for Job <%s/%s>: %v",
+				job.Namespace, job.Name, err)
+			return err
+		}
+		if len(job.Spec.Tasks) == 0 {
+			return nil
+		}
+
+		svc := &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: job.Namespace,
+				Name:      serviceName,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(job, apishelpers.JobKind),
+				},
+			},
+			Spec: v1.ServiceSpec{
+				Selector: map[string]string{
+					batch.JobNameKey:      job.Name,
+					batch.JobNamespaceKey: job.Namespace,
+					batch.TaskSpecKey:     job.Spec.Tasks[0].Name,
+					batch.TaskIndex:       "0",
+				},
+				Ports: []v1.ServicePort{
+					{
+						Name:       CoordinatorPortName,
+						Port:       int32(jp.port),
+						TargetPort: intstr.FromInt32(int32(jp.port)),
+						Protocol:   v1.ProtocolTCP,
+					},
+				},
+			},
+		}
+
+		if _, e := jp.clientset.KubeClients.CoreV1().Services(job.Namespace).Create(context.TODO(), svc, metav1.CreateOptions{}); e != nil {
+			klog.V(3).Infof("Failed to create Service for Job <%s/%s>: %v", job.Namespace, serviceName, e)
+			return e
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jax
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+)
+
+func buildJob() *v1alpha1.Job {
+	return &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "jax", Namespace: "ns1"},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{
+				{Name: "tpu-a", Replicas: 2},
+				{Name: "tpu-b", Replicas: 2},
+			},
+		},
+		Status: v1alpha1.JobStatus{ControlledResources: map[string]string{}},
+	}
+}
+
+func buildPod(name, task string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{v1alpha1.TaskSpecKey: task},
+		},
+		Spec: v1.PodSpec{Containers: []v1.Container{{Name: "main"}}},
+	}
+}
+
+func TestJAXOnPodCreate(t *testing.T) {
+	testcases := []struct {
+		name              string
+		arguments         []string
+		pod               *v1.Pod
+		expectedProcessID string
+		expectedPorts     []v1.ContainerPort
+		expectedInitNames []string
+	}{
+		{
+			name:              "the first pod is the coordinator",
+			arguments:         []string{"--wait-coordinator-enabled=true"},
+			pod:               buildPod("jax-tpu-a-0", "tpu-a"),
+			expectedProcessID: "0",
+			expectedPorts:     []v1.ContainerPort{{Name: CoordinatorPortName, ContainerPort: DefaultPort}},
+		},
+		{
+			name:              "process ids follow the order of the tasks",
+			arguments:         []string{"--wait-coordinator-enabled=true"},
+			pod:               buildPod("jax-tpu-b-1", "tpu-b"),
+			expectedProcessID: "3",
+			expectedInitNames: []string{"wait-for-coordinator"},
+		},
+		{
+			name:              "the other processes do not wait for the coordinator by default",
+			pod:               buildPod("jax-tpu-a-1", "tpu-a"),
+			expectedProcessID: "1",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			jp := New(pluginsinterface.PluginClientset{}, testcase.arguments)
+			if err := jp.OnPodCreate(testcase.pod, buildJob()); err != nil {
+				t.Fatalf("OnPodCreate failed: %v", err)
+			}
+			container := testcase.pod.Spec.Containers[0]
+			expectedEnvs := []v1.EnvVar{
+				{Name: EnvCoordinatorAddress, Value: "jax-jax-coordinator:1234"},
+				{Name: EnvNumProcesses, Value: "4"},
+				{Name: EnvProcessID, Value: testcase.expectedProcessID},
+			}
+			if !equality.Semantic.DeepEqual(container.Env, expectedEnvs) {
+				t.Errorf("wrong envs, got %v, expected %v", container.Env, expectedEnvs)
+			}
+			if !equality.Semantic.DeepEqual(container.Ports, testcase.expectedPorts) {
+				t.Errorf("wrong ports, got %v, expected %v", container.Ports, testcase.expectedPorts)
+			}
+			var initNames []string
+			for _, c := range testcase.pod.Spec.InitContainers {
+				initNames = append(initNames, c.Name)
+			}
+			if !equality.Semantic.DeepEqual(initNames, testcase.expectedInitNames) {
+				t.Errorf("wrong init containers, got %v, expected %v", initNames, testcase.expectedInitNames)
+			}
+		})
+	}
+}
+
+func TestJAXCoordinatorService(t *testing.T) {
+	job := buildJob()
+	fakeClient := fake.NewSimpleClientset()
+	jp := New(pluginsinterface.PluginClientset{KubeClients: fakeClient}, []string{"--port=8476"})
+
+	if err := jp.OnJobAdd(job); err != nil {
+		t.Fatalf("OnJobAdd failed: %v", err)
+	}
+	svc, err := fakeClient.CoreV1().Services(job.Namespace).Get(context.TODO(), "jax-jax-coordinator", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get coordinator Service: %v", err)
+	}
+	expectedSelector := map[string]string{
+		v1alpha1.JobNameKey:      "jax",
+		v1alpha1.JobNamespaceKey: "ns1",
+		v1alpha1.TaskSpecKey:     "tpu-a",
+		v1alpha1.TaskIndex:       "0",
+	}
+	if !equality.Semantic.DeepEqual(svc.Spec.Selector, expectedSelector) {
+		t.Errorf("wrong selector, got %v, expected %v", svc.Spec.Selector, expectedSelector)
+	}
+	if len(svc.Spec.Ports) != 1 || svc.Spec.Ports[0].Port != 8476 {
+		t.Errorf("wrong ports, got %v", svc.Spec.Ports)
+	}
+
+	if err := jp.OnJobDelete(job); err != nil {
+		t.Fatalf("OnJobDelete failed: %v", err)
+	}
+	if _, err := fakeClient.CoreV1().Services(job.Namespace).Get(context.TODO(), "jax-jax-coordinator", metav1.GetOptions{}); err == nil {
+		t.Errorf("coordinator Service is not deleted")
+	}
+}
@@ -20,11 +20,13 @@ import (
 	"flag"
 	"fmt"
 	"strconv"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	apishelpers "volcano.sh/apis/pkg/apis/helpers"
 	"volcano.sh/volcano/pkg/controllers/job/helpers"
 	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
 )
@@ -51,6 +53,24 @@ const (
 	EnvWorldSize = "WORLD_SIZE"
 	// EnvRank is the env name of rank
 	EnvRank = "RANK"
+
+	// EnvElasticNodes is the env name of the range of the number of nodes of an elastic job, e.g. `1:4`
+	EnvElasticNodes = "PET_NNODES"
+	// EnvRdzvBackend is the env name of the rendezvous backend of an elastic job
+	EnvRdzvBackend = "PET_RDZV_BACKEND"
+	// EnvRdzvEndpoint is the env name of the rendezvous endpoint of an elastic job
+	EnvRdzvEndpoint = "PET_RDZV_ENDPOINT"
+	// EnvRdzvID is the env name of the rendezvous id of an elastic job
+	EnvRdzvID = "PET_RDZV_ID"
+	// DefaultRdzvBackend is the rendezvous backend of an elastic job
+	DefaultRdzvBackend = "c10d"
+
+	// ConfigMapMountPath is the path the members of an elastic job are mounted at
+	ConfigMapMountPath = "/etc/volcano-pytorch"
+	// ConfigMapMembersKey is the key of the addresses of the running members of an elastic job, one per line in rank order
+	ConfigMapMembersKey = "members"
+	// ConfigMapNodesKey is the key of the number of the running members of an elastic job
+	ConfigMapNodesKey = "nnodes"
 )
 
 type pytorchPlugin struct {
@@ -120,6 +140,25 @@ func (pp *pytorchPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
 	}
 
 	totalReplicas := pp.getTotalReplicas(job)
+	if helpers.IsElasticJob(job) {
+		// The world size and the ranks of an elastic job change as it grows and shrinks, torchrun gets them from
+		// the rendezvous, and the members are mounted for the framework to follow the changes.
+		elasticEnvVars := []v1.EnvVar{
+			{Name: EnvElasticNodes, Value: fmt.Sprintf("%d:%d", pp.getMinReplicas(job), totalReplicas)},
+			{Name: EnvRdzvBackend, Value: DefaultRdzvBackend},
+			{Name: EnvRdzvEndpoint, Value: fmt.Sprintf("%s:%d", masterAddr, pp.port)},
+			{Name: EnvRdzvID, Value: string(job.UID)},
+		}
+		for i, c := range pod.Spec.Containers {
+			pp.openContainerPort(&c, i, pod)
+
+			pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, masterEnvVars...)
+			pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, elasticEnvVars...)
+		}
+		pp.mountConfigMap(pod, job)
+		return nil
+	}
+
 	for i, c := range pod.Spec.Containers {
 		pp.openContainerPort(&c, i, pod)
 
@@ -156,6 +195,61 @@ func (pp *pytorchPlugin) getTotalReplicas(job *batch.Job) int32 {
 	return jobReplicas
 }
 
+func (pp *pytorchPlugin) getMinReplicas(job *batch.Job) int32 {
+	minReplicas := int32(0)
+	for _, task := range job.Spec.Tasks {
+		if task.Name != pp.masterName && task.Name != pp.workerName {
+			continue
+		}
+		if task.MinAvailable != nil {
+			minReplicas += *task.MinAvailable
+		} else {
+			minReplicas += task.Replicas
+		}
+	}
+
+	return minReplicas
+}
+
+// generateMembers generates the addresses of the running master and workers of an elastic job.
+func (pp *pytorchPlugin) generateMembers(job *batch.Job) (map[string]string, error) {
+	pods, err := helpers.ListJobPods(pp.clientset.PodLister, job)
+	if err != nil {
+		return nil, err
+	}
+	members := helpers.GetRunningMembersUnderTask(pp.masterName, job, pods)
+	members = append(members, helpers.GetRunningMembersUnderTask(pp.workerName, job, pods)...)
+
+	return map[string]string{
+		ConfigMapMembersKey: strings.Join(members, "\n"),
+		ConfigMapNodesKey:   strconv.Itoa(len(members)),
+	}, nil
+}
+
+func (pp *pytorchPlugin) cmName(job *batch.Job) string {
+	return fmt.Sprintf("%s-%s", job.Name, pp.Name())
+}
+
+func (pp *pytorchPlugin) mountConfigMap(pod *v1.Pod, job *batch.Job) {
+	cmName := pp.cmName(job)
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: cmName,
+		VolumeSource: v1.VolumeSource{
+			ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: cmName},
+			},
+		},
+	})
+
+	vm := v1.VolumeMount{
+		MountPath: ConfigMapMountPath,
+		Name:      cmName,
+	}
+	for i, c := range pod.Spec.Containers {
+		pod.Spec.Containers[i].VolumeMounts = append(c.VolumeMounts, vm)
+	}
+}
+
 func (pp *pytorchPlugin) generateMasterAddr(task batch.TaskSpec, jobName string) string {
 	hostName := task.Template.Spec.Hostname
 	subdomain := task.Template.Spec.Subdomain
@@ -234,6 +328,16 @@ func (pp *pytorchPlugin) OnJobAdd(job *batch.Job) error {
 	if job.Status.ControlledResources["plugin-"+pp.Name()] == pp.Name() {
 		return nil
 	}
+	if helpers.IsElasticJob(job) {
+		// Create ConfigMap of members for Pods to mount.
+		members, err := pp.generateMembers(job)
+		if err != nil {
+			return err
+		}
+		if err := apishelpers.CreateOrUpdateConfigMap(job, pp.clientset.KubeClients, members, pp.cmName(job)); err != nil {
+			return err
+		}
+	}
 	job.Status.ControlledResources["plugin-"+pp.Name()] = pp.Name()
 	return nil
 }
@@ -242,10 +346,23 @@ func (pp *pytorchPlugin) OnJobDelete(job *batch.Job) error {
 	if job.Status.ControlledResources["plugin-"+pp.Name()] != pp.Name() {
 		return nil
 	}
+	if helpers.IsElasticJob(job) {
+		if err := apishelpers.DeleteConfigmap(job, pp.clientset.KubeClients, pp.cmName(job)); err != nil {
+			return err
+		}
+	}
 	delete(job.Status.ControlledResources, "plugin-"+pp.Name())
 	return nil
 }
 
 func (pp *pytorchPlugin) OnJobUpdate(job *batch.Job) error {
-	return nil
+	if !helpers.IsElasticJob(job) {
+		return nil
+	}
+	// updates ConfigMap of members as the elastic job grows and shrinks.
+	members, err := pp.generateMembers(job)
+	if err != nil {
+		return err
+	}
+	return apishelpers.CreateOrUpdateConfigMap(job, pp.clientset.KubeClients, members, pp.cmName(job))
 }
@@ -17,16 +17,22 @@ limitations under the License.
 package pytorch
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
 )
 
 func TestPytorchPodEnvAndPort(t *testing.T) {
@@ -679,3 +685,109 @@ func TestPytorchInitContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestPytorchElastic(t *testing.T) {
+	minAvailable := int32(1)
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-pytorch",
+			Namespace:   "ns1",
+			UID:         "job-uid",
+			Annotations: map[string]string{schedulingapi.JobElastic: "true"},
+		},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{
+				{Name: "master", Replicas: 1},
+				{Name: "worker", Replicas: 3, MinAvailable: &minAvailable},
+			},
+		},
+		Status: v1alpha1.JobStatus{
+			ControlledResources: map[string]string{},
+			TaskStatusCount: map[string]v1alpha1.TaskState{
+				"master": {Phase: map[v1.PodPhase]int32{v1.PodRunning: 1}},
+				"worker": {Phase: map[v1.PodPhase]int32{v1.PodRunning: 1, v1.PodPending: 2}},
+			},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-pytorch-worker-2",
+			Annotations: map[string]string{v1alpha1.TaskSpecKey: "worker"},
+		},
+		Spec: v1.PodSpec{Containers: []v1.Container{{Name: "worker"}}},
+	}
+
+	pods := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, member := range []struct {
+		task  string
+		index int
+	}{{"master", 0}, {"worker", 0}} {
+		if err := pods.Add(buildRunningPod(job, member.task, member.index)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fakeClient := fake.NewSimpleClientset()
+	pp := New(pluginsinterface.PluginClientset{KubeClients: fakeClient, PodLister: corelisters.NewPodLister(pods)}, nil)
+	if err := pp.OnJobAdd(job); err != nil {
+		t.Fatalf("OnJobAdd failed: %v", err)
+	}
+	if err := pp.OnPodCreate(pod, job); err != nil {
+		t.Fatalf("OnPodCreate failed: %v", err)
+	}
+
+	expectedEnvs := []v1.EnvVar{
+		{Name: EnvMasterAddr, Value: "test-pytorch-master-0.test-pytorch"},
+		{Name: EnvMasterPort, Value: fmt.Sprintf("%v", DefaultPort)},
+		{Name: EnvElasticNodes, Value: "2:4"},
+		{Name: EnvRdzvBackend, Value: DefaultRdzvBackend},
+		{Name: EnvRdzvEndpoint, Value: fmt.Sprintf("test-pytorch-master-0.test-pytorch:%v", DefaultPort)},
+		{Name: EnvRdzvID, Value: "job-uid"},
+	}
+	if !equality.Semantic.DeepEqual(pod.Spec.Containers[0].Env, expectedEnvs) {
+		t.Errorf("wrong envs, got %v, expected %v", pod.Spec.Containers[0].Env, expectedEnvs)
+	}
+	if len(pod.Spec.Containers[0].VolumeMounts) != 1 || pod.Spec.Containers[0].VolumeMounts[0].MountPath != ConfigMapMountPath {
+		t.Errorf("members are not mounted, got %v", pod.Spec.Containers[0].VolumeMounts)
+	}
+
+	// The job grows to a third member, which is the worker of index 2 while the one of index 1 is still pending.
+	job.Status.TaskStatusCount["worker"] = v1alpha1.TaskState{Phase: map[v1.PodPhase]int32{v1.PodRunning: 2, v1.PodPending: 1}}
+	if err := pods.Add(buildRunningPod(job, "worker", 2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := pp.OnJobUpdate(job); err != nil {
+		t.Fatalf("OnJobUpdate failed: %v", err)
+	}
+	cm, err := fakeClient.CoreV1().ConfigMaps(job.Namespace).Get(context.TODO(), "test-pytorch-pytorch", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get ConfigMap of members: %v", err)
+	}
+	expectedData := map[string]string{
+		ConfigMapMembersKey: "test-pytorch-master-0.test-pytorch\ntest-pytorch-worker-0.test-pytorch\ntest-pytorch-worker-2.test-pytorch",
+		ConfigMapNodesKey:   "3",
+	}
+	if diff := cmp.Diff(expectedData, cm.Data); diff != "" {
+		t.Errorf("wrong members (-want +got):\n%s", diff)
+	}
+
+	if err := pp.OnJobDelete(job); err != nil {
+		t.Fatalf("OnJobDelete failed: %v", err)
+	}
+	if _, err := fakeClient.CoreV1().ConfigMaps(job.Namespace).Get(context.TODO(), "test-pytorch-pytorch", metav1.GetOptions{}); err == nil {
+		t.Errorf("ConfigMap of members is not deleted")
+	}
+}
+
+func buildRunningPod(job *v1alpha1.Job, task string, index int) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%s-%d", job.Name, task, index),
+			Namespace:       job.Namespace,
+			Labels:          map[string]string{v1alpha1.JobNameKey: job.Name, v1alpha1.TaskIndex: strconv.Itoa(index)},
+			Annotations:     map[string]string{v1alpha1.TaskSpecKey: task},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(job, v1alpha1.SchemeGroupVersion.WithKind("Job"))},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"strconv"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -56,6 +58,13 @@ const (
 	DashboardPortName = "dashboard"
 	// ClientServerPortName is the port name for a ray client api
 	ClientServerPortName = "client-server"
+
+	// ConfigMapMountPath is the path the workers of an elastic job are mounted at
+	ConfigMapMountPath = "/etc/volcano-ray"
+	// ConfigMapWorkersKey is the key of the addresses of the running workers of an elastic job, one per line in rank order
+	ConfigMapWorkersKey = "workers"
+	// ConfigMapNumWorkersKey is the key of the number of the running workers of an elastic job
+	ConfigMapNumWorkersKey = "num-workers"
 )
 
 type rayPlugin struct {
@@ -126,9 +135,52 @@ func (rp *rayPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
 		}
 	}
 
+	// The workers of an elastic job join and leave the cluster as it grows and shrinks, they are mounted for the
+	// head and the workers to follow the changes.
+	if jobhelpers.IsElasticJob(job) {
+		rp.mountConfigMap(pod, job)
+	}
+
 	return nil
 }
 
+// generateWorkers generates the addresses of the running workers of an elastic job.
+func (rp *rayPlugin) generateWorkers(job *batch.Job) (map[string]string, error) {
+	pods, err := jobhelpers.ListJobPods(rp.clientset.PodLister, job)
+	if err != nil {
+		return nil, err
+	}
+	workers := jobhelpers.GetRunningMembersUnderTask(rp.workerName, job, pods)
+	return map[string]string{
+		ConfigMapWorkersKey:    strings.Join(workers, "\n"),
+		ConfigMapNumWorkersKey: strconv.Itoa(len(workers)),
+	}, nil
+}
+
+func (rp *rayPlugin) cmName(job *batch.Job) string {
+	return fmt.Sprintf("%s-%s", job.Name, rp.Name())
+}
+
+func (rp *rayPlugin) mountConfigMap(pod *v1.Pod, job *batch.Job) {
+	cmName := rp.cmName(job)
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: cmName,
+		VolumeSource: v1.VolumeSource{
+			ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: cmName},
+			},
+		},
+	})
+
+	vm := v1.VolumeMount{
+		MountPath: ConfigMapMountPath,
+		Name:      cmName,
+	}
+	for i, c := range pod.Spec.Containers {
+		pod.Spec.Containers[i].VolumeMounts = append(c.VolumeMounts, vm)
+	}
+}
+
 func (rp *rayPlugin) generateHeadAddr(task batch.TaskSpec, jobName string) string {
 	hostName := task.Template.Spec.Hostname
 	subdomain := task.Template.Spec.Subdomain
@@ -202,6 +254,17 @@ func (rp *rayPlugin) OnJobAdd(job *batch.Job) error {
 		return err
 	}
 
+	if jobhelpers.IsElasticJob(job) {
+		// Create ConfigMap of workers for Pods to mount.
+		workers, err := rp.generateWorkers(job)
+		if err != nil {
+			return err
+		}
+		if err := helpers.CreateOrUpdateConfigMap(job, rp.clientset.KubeClients, workers, rp.cmName(job)); err != nil {
+			return err
+		}
+	}
+
 	job.Status.ControlledResources["plugin-"+rp.Name()] = rp.Name()
 	return nil
 }
@@ -219,12 +282,25 @@ func (rp *rayPlugin) OnJobDelete(job *batch.Job) error {
 			return err
 		}
 	}
+	if jobhelpers.IsElasticJob(job) {
+		if err := helpers.DeleteConfigmap(job, rp.clientset.KubeClients, rp.cmName(job)); err != nil {
+			return err
+		}
+	}
 	delete(job.Status.ControlledResources, "plugin-"+rp.Name())
 	return nil
 }
 
 func (rp *rayPlugin) OnJobUpdate(job *batch.Job) error {
-	return nil
+	if !jobhelpers.IsElasticJob(job) {
+		return nil
+	}
+	// updates ConfigMap of workers as the elastic job grows and shrinks.
+	workers, err := rp.generateWorkers(job)
+	if err != nil {
+		return err
+	}
+	return helpers.CreateOrUpdateConfigMap(job, rp.clientset.KubeClients, workers, rp.cmName(job))
 }
 
 func (rp *rayPlugin) createServiceIfNotExist(job *batch.Job) error {
@@ -20,14 +20,18 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
 )
 
 func TestRayPlugin(t *testing.T) {
@@ -199,3 +203,88 @@ func TestRayPlugin(t *testing.T) {
 		})
 	}
 }
+
+func TestRayElastic(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "ray-cluster-test",
+			Namespace:   "ns1",
+			UID:         "job-uid",
+			Annotations: map[string]string{schedulingapi.JobElastic: "true"},
+		},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{
+				{Name: DefaultHead, Replicas: 1},
+				{Name: DefaultWorker, Replicas: 4},
+			},
+		},
+		Status: v1alpha1.JobStatus{
+			ControlledResources: map[string]string{},
+			TaskStatusCount: map[string]v1alpha1.TaskState{
+				DefaultWorker: {Phase: map[v1.PodPhase]int32{v1.PodRunning: 2, v1.PodPending: 2}},
+			},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "ray-cluster-test-worker-3",
+			Annotations: map[string]string{v1alpha1.TaskSpecKey: DefaultWorker},
+		},
+		Spec: v1.PodSpec{Containers: []v1.Container{{Name: DefaultWorkerContainer}}},
+	}
+
+	pods := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, index := range []int{0, 1} {
+		if err := pods.Add(buildRunningWorker(job, index)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fakeClient := fake.NewSimpleClientset()
+	rp := New(pluginsinterface.PluginClientset{KubeClients: fakeClient, PodLister: corelisters.NewPodLister(pods)}, nil)
+	if err := rp.OnJobAdd(job); err != nil {
+		t.Fatalf("OnJobAdd failed: %v", err)
+	}
+	if err := rp.OnPodCreate(pod, job); err != nil {
+		t.Fatalf("OnPodCreate failed: %v", err)
+	}
+	if len(pod.Spec.Containers[0].VolumeMounts) != 1 || pod.Spec.Containers[0].VolumeMounts[0].MountPath != ConfigMapMountPath {
+		t.Errorf("workers are not mounted, got %v", pod.Spec.Containers[0].VolumeMounts)
+	}
+
+	// The job shrinks to one worker, as the worker of index 0 is evicted.
+	job.Status.TaskStatusCount[DefaultWorker] = v1alpha1.TaskState{Phase: map[v1.PodPhase]int32{v1.PodRunning: 1, v1.PodPending: 3}}
+	if err := pods.Delete(buildRunningWorker(job, 0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := rp.OnJobUpdate(job); err != nil {
+		t.Fatalf("OnJobUpdate failed: %v", err)
+	}
+	cm, err := fakeClient.CoreV1().ConfigMaps(job.Namespace).Get(context.TODO(), "ray-cluster-test-ray", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get ConfigMap of workers: %v", err)
+	}
+	if cm.Data[ConfigMapWorkersKey] != "ray-cluster-test-worker-1.ray-cluster-test" || cm.Data[ConfigMapNumWorkersKey] != "1" {
+		t.Errorf("wrong workers, got %v", cm.Data)
+	}
+
+	if err := rp.OnJobDelete(job); err != nil {
+		t.Fatalf("OnJobDelete failed: %v", err)
+	}
+	if _, err := fakeClient.CoreV1().ConfigMaps(job.Namespace).Get(context.TODO(), "ray-cluster-test-ray", metav1.GetOptions{}); err == nil {
+		t.Errorf("ConfigMap of workers is not deleted")
+	}
+}
+
+func buildRunningWorker(job *v1alpha1.Job, index int) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%s-%d", job.Name, DefaultWorker, index),
+			Namespace:       job.Namespace,
+			Labels:          map[string]string{v1alpha1.JobNameKey: job.Name, v1alpha1.TaskIndex: strconv.Itoa(index)},
+			Annotations:     map[string]string{v1alpha1.TaskSpecKey: DefaultWorker},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(job, v1alpha1.SchemeGroupVersion.WithKind("Job"))},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
@@ -19,6 +19,7 @@ package pluginsinterface
 import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 
 	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 )
@@ -26,6 +27,8 @@ import (
 // PluginClientset clientset.
 type PluginClientset struct {
 	KubeClients kubernetes.Interface
+	// PodLister lists the pods of the jobs from the informer of the controller.
+	PodLister corelisters.PodLister
 }
 
 // PluginInterface interface.
@@ -29,12 +29,12 @@ type abortedState struct {
 func (as *abortedState) Execute(action Action) error {
 	switch action.Action {
 	case v1alpha1.ResumeJobAction:
-		return KillJob(as.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+		return KillJob(as.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Restarting
 			status.RetryCount++
 			return true
 		})
 	default:
-		return KillJob(as.job, PodRetainPhaseSoft, nil)
+		return KillJob(as.job, PodRetainPhaseSoft, action, nil)
 	}
 }
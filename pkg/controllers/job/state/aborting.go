@@ -29,17 +29,18 @@ type abortingState struct {
 func (ps *abortingState) Execute(action Action) error {
 	switch action.Action {
 	case v1alpha1.ResumeJobAction:
-		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+		return KillJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Restarting
 			status.RetryCount++
 			return true
 		})
 	default:
-		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+		return KillJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 			// If any "alive" pods, still in Aborting phase
 			if status.Terminating != 0 || status.Pending != 0 || status.Running != 0 {
 				return false
 			}
+			ResolveCheckpoint(ps.job, status)
 			status.State.Phase = vcbatch.Aborted
 			return true
 		})
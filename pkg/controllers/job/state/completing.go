@@ -28,7 +28,7 @@ type completingState struct {
 }
 
 func (ps *completingState) Execute(action Action) error {
-	return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+	return KillJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 		// If any "alive" pods, still in Completing phase
 		if status.Terminating != 0 || status.Pending != 0 || status.Running != 0 {
 			return false
@@ -33,11 +33,11 @@ type UpdateStatusFn func(status *vcbatch.JobStatus) (jobPhaseChanged bool)
 // ActionFn will create or delete Pods according to Job's spec.
 type ActionFn func(job *apis.JobInfo, fn UpdateStatusFn) error
 
-// KillActionFn kill all Pods of Job with phase not in podRetainPhase.
-type KillActionFn func(job *apis.JobInfo, podRetainPhase PhaseMap, fn UpdateStatusFn) error
+// KillActionFn kill all Pods of Job with phase not in podRetainPhase for the action.
+type KillActionFn func(job *apis.JobInfo, podRetainPhase PhaseMap, action Action, fn UpdateStatusFn) error
 
-// KillPodFn kill the Task with given name.
-type KillTargetFn func(job *apis.JobInfo, target Target, fn UpdateStatusFn) error
+// KillTargetFn kill the target of the action.
+type KillTargetFn func(job *apis.JobInfo, action Action, fn UpdateStatusFn) error
 
 // PodRetainPhaseNone stores no phase.
 var PodRetainPhaseNone = PhaseMap{}
@@ -53,6 +53,9 @@ var (
 	SyncJob ActionFn
 	// KillJob kill all Pods of Job with phase not in podRetainPhase.
 	KillJob KillActionFn
+	// CheckpointJob kill all Pods of Job with phase not in podRetainPhase like KillJob, and gives them the time to take
+	// the checkpoint of the Job before they exit. The checkpoint becomes pending until ResolveCheckpoint.
+	CheckpointJob KillActionFn
 	// KillTarget kill the target with given name.
 	KillTarget KillTargetFn
 )
@@ -75,6 +78,20 @@ type Target struct {
 type Action struct {
 	Action v1alpha1.Action
 	Target Target
+	// Checkpoint is true if the action is planned, e.g. issued by a command, so the pods killed by it are alive to
+	// take a checkpoint before they exit.
+	Checkpoint bool
+	// Failure is the class of the failure which triggers the action.
+	Failure apis.FailureClass
+	// FailedNode is the node the pod which triggers the action failed or was evicted on. The pod may be gone
+	// before the action kills the pods of the job, e.g. when it was lost with its node.
+	FailedNode string
+}
+
+// Failed checks whether the action is triggered by a failure, rather than planned without one, e.g. issued by a
+// command.
+func (a Action) Failed() bool {
+	return !a.Checkpoint || a.Failure != apis.UserFailure
 }
 
 // State interface.
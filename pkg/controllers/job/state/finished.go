@@ -26,5 +26,5 @@ type finishedState struct {
 
 func (ps *finishedState) Execute(action Action) error {
 	// In finished state, e.g. Completed, always kill the whole job.
-	return KillJob(ps.job, PodRetainPhaseSoft, nil)
+	return KillJob(ps.job, PodRetainPhaseSoft, action, nil)
 }
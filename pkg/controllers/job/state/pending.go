@@ -29,29 +29,29 @@ type pendingState struct {
 func (ps *pendingState) Execute(action Action) error {
 	switch action.Action {
 	case v1alpha1.RestartJobAction:
-		return KillJob(ps.job, PodRetainPhaseNone, func(status *vcbatch.JobStatus) bool {
-			status.RetryCount++
+		return KillJob(ps.job, PodRetainPhaseNone, action, func(status *vcbatch.JobStatus) bool {
+			CountRetry(status, action.Failure)
 			status.State.Phase = vcbatch.Restarting
 			return true
 		})
 	case v1alpha1.RestartTaskAction, v1alpha1.RestartPodAction, v1alpha1.RestartPartitionAction:
-		return KillTarget(ps.job, action.Target, func(status *vcbatch.JobStatus) bool {
-			status.RetryCount++
+		return KillTarget(ps.job, action, func(status *vcbatch.JobStatus) bool {
+			CountRetry(status, action.Failure)
 			status.State.Phase = vcbatch.Restarting
 			return true
 		})
 	case v1alpha1.AbortJobAction:
-		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+		return KillJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Aborting
 			return true
 		})
 	case v1alpha1.CompleteJobAction:
-		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+		return KillJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Completing
 			return true
 		})
 	case v1alpha1.TerminateJobAction:
-		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+		return KillJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Terminating
 			return true
 		})
@@ -22,6 +22,7 @@ import (
 	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	"volcano.sh/apis/pkg/apis/bus/v1alpha1"
 	"volcano.sh/volcano/pkg/controllers/apis"
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
 )
 
 type restartingState struct {
@@ -29,15 +30,20 @@ type restartingState struct {
 }
 
 func (ps *restartingState) restartingUpdateStatus(status *vcbatch.JobStatus) bool {
-	// Get the maximum number of retries.
-	maxRetry := ps.job.Job.Spec.MaxRetry
+	// Failures of the workload and of the infrastructure have their own retry budgets.
+	userBudget, infrastructureBudget := jobhelpers.GetRetryBudgets(ps.job.Job, status)
 
-	if status.RetryCount >= maxRetry {
+	if userBudget <= 0 || infrastructureBudget <= 0 {
 		// Failed is the phase that the job is restarted failed reached the maximum number of retries.
 		status.State.Phase = vcbatch.Failed
 		UpdateJobFailed(fmt.Sprintf("%s/%s", ps.job.Job.Namespace, ps.job.Job.Name), ps.job.Job.Spec.Queue)
 		return true
 	}
+	// The pods are recreated only after the pending checkpoint is resolved by SyncJob, so they restore from it.
+	if status.PendingCheckpoint != nil {
+		return false
+	}
+
 	total := int32(0)
 	for _, task := range ps.job.Job.Spec.Tasks {
 		total += task.Replicas
@@ -56,8 +62,8 @@ func (ps *restartingState) Execute(action Action) error {
 	case v1alpha1.SyncJobAction:
 		return SyncJob(ps.job, ps.restartingUpdateStatus)
 	case v1alpha1.RestartTaskAction, v1alpha1.RestartPodAction, v1alpha1.RestartPartitionAction:
-		return KillTarget(ps.job, action.Target, ps.restartingUpdateStatus)
+		return KillTarget(ps.job, action, ps.restartingUpdateStatus)
 	default:
-		return KillJob(ps.job, PodRetainPhaseNone, ps.restartingUpdateStatus)
+		return KillJob(ps.job, PodRetainPhaseNone, action, ps.restartingUpdateStatus)
 	}
 }
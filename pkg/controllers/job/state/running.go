@@ -33,29 +33,37 @@ type runningState struct {
 func (ps *runningState) Execute(action Action) error {
 	switch action.Action {
 	case v1alpha1.RestartJobAction:
-		return KillJob(ps.job, PodRetainPhaseNone, func(status *vcbatch.JobStatus) bool {
+		killJob := KillJob
+		if action.Checkpoint {
+			killJob = CheckpointJob
+		}
+		return killJob(ps.job, PodRetainPhaseNone, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Restarting
-			status.RetryCount++
+			CountRetry(status, action.Failure)
 			return true
 		})
 	case v1alpha1.RestartTaskAction, v1alpha1.RestartPodAction, v1alpha1.RestartPartitionAction:
-		return KillTarget(ps.job, action.Target, func(status *vcbatch.JobStatus) bool {
+		return KillTarget(ps.job, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Restarting
-			status.RetryCount++
+			CountRetry(status, action.Failure)
 			return true
 		})
 	case v1alpha1.AbortJobAction:
-		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+		killJob := KillJob
+		if action.Checkpoint {
+			killJob = CheckpointJob
+		}
+		return killJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Aborting
 			return true
 		})
 	case v1alpha1.TerminateJobAction:
-		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+		return KillJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Terminating
 			return true
 		})
 	case v1alpha1.CompleteJobAction:
-		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+		return KillJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Completing
 			return true
 		})
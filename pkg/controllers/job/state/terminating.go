@@ -26,7 +26,7 @@ type terminatingState struct {
 }
 
 func (ps *terminatingState) Execute(action Action) error {
-	return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+	return KillJob(ps.job, PodRetainPhaseSoft, action, func(status *vcbatch.JobStatus) bool {
 		// If any "alive" pods, still in Terminating phase
 		if status.Terminating != 0 || status.Pending != 0 || status.Running != 0 {
 			return false
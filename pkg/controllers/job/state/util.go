@@ -17,7 +17,13 @@ limitations under the License.
 package state
 
 import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+
 	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/apis"
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
 )
 
 // TotalTasks returns number of tasks in a given volcano job.
@@ -45,3 +51,34 @@ func TotalTaskMinAvailable(job *vcbatch.Job) int32 {
 
 	return rep
 }
+
+// ResolveCheckpoint resolves the pending checkpoint of the job once the pods which take it are gone. The checkpoint
+// becomes the latest one of the job, so the pods created afterwards restore from it, only if every pod of the job has
+// confirmed it. Otherwise it's dropped, and the pods keep restoring from the previous one.
+func ResolveCheckpoint(job *apis.JobInfo, status *vcbatch.JobStatus) {
+	pending := status.PendingCheckpoint
+	if pending == nil {
+		return
+	}
+	status.PendingCheckpoint = nil
+	for _, task := range job.Job.Spec.Tasks {
+		for i := 0; i < int(task.Replicas); i++ {
+			podName := fmt.Sprintf(jobhelpers.PodNameFmt, job.Job.Name, task.Name, i)
+			if checkpoint, found := job.Checkpoints[podName]; !found || checkpoint != *pending {
+				klog.Warningf("Pod <%s/%s> has not confirmed checkpoint %d of Job, drop the checkpoint",
+					job.Namespace, podName, pending.Sequence)
+				return
+			}
+		}
+	}
+	klog.V(3).Infof("All pods of Job <%s/%s> have confirmed checkpoint %d", job.Namespace, job.Name, pending.Sequence)
+	status.LatestCheckpoint = pending.Sequence
+}
+
+// CountRetry counts a restart of the job against the retry budget of the class of the failure which causes it.
+func CountRetry(status *vcbatch.JobStatus, failure apis.FailureClass) {
+	status.RetryCount++
+	if failure == apis.InfrastructureFailure {
+		status.InfrastructureRetryCount++
+	}
+}
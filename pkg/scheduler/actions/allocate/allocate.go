@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"volcano.sh/apis/pkg/apis/scheduling"
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
@@ -43,6 +44,8 @@ type allocateContext struct {
 	jobsByQueue         map[api.QueueID]*util.PriorityQueue // queue of *api.JobInfo
 	jobWorksheet        map[api.JobID]*JobWorksheet
 	tasksNoHardTopology map[api.JobID]*util.PriorityQueue // queue of *api.TaskInfo, job without any hard network topology policy use this queue
+	visited             sets.Set[api.JobID]               // jobs visited by the pass
+	freshJobs           map[api.QueueID]int               // number of the jobs of each queue which are not deferred
 }
 
 type JobWorksheet struct {
@@ -98,6 +101,14 @@ type Action struct {
 	session *framework.Session
 	// configured flag for error cache
 	enablePredicateErrorCache bool
+	// sessionBudget is the time allocate may run until, counted from the start of the session, 0 if unlimited
+	sessionBudget time.Duration
+	// deferred are the jobs visited by the passes which ran out of time, see resume.go
+	deferred sets.Set[api.JobID]
+	// parallelJobs is the number of jobs of different queues planned concurrently, see parallel.go
+	parallelJobs int
+	// clock tells how long the session has run against sessionBudget
+	clock clock.PassiveClock
 
 	recorder *Recorder
 }
@@ -105,6 +116,8 @@ type Action struct {
 func New() *Action {
 	return &Action{
 		enablePredicateErrorCache: true, // default to enable it
+		deferred:                  sets.New[api.JobID](),
+		clock:                     clock.RealClock{},
 	}
 }
 
@@ -117,6 +130,26 @@ func (alloc *Action) Initialize() {}
 func (alloc *Action) parseArguments(ssn *framework.Session) {
 	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, alloc.Name())
 	arguments.GetBool(&alloc.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+
+	alloc.sessionBudget = 0
+	var sessionBudget string
+	arguments.GetString(&sessionBudget, SessionBudgetKey)
+	if sessionBudget != "" {
+		budget, err := time.ParseDuration(sessionBudget)
+		if err != nil || budget < 0 {
+			klog.Warningf("Invalid %s %q of allocate, it is ignored", SessionBudgetKey, sessionBudget)
+		} else {
+			alloc.sessionBudget = budget
+		}
+	}
+
+	alloc.parallelJobs = 0
+	arguments.GetInt(&alloc.parallelJobs, ParallelJobsKey)
+	// The allocatable check of the queues records the tasks to release from the queue allocation gate.
+	if alloc.parallelJobs > 1 && utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesQueueAdmission) {
+		klog.Warningf("The %s of allocate is ignored, because the %s feature is enabled", ParallelJobsKey, features.SchedulingGatesQueueAdmission)
+		alloc.parallelJobs = 0
+	}
 }
 
 func (alloc *Action) Execute(ssn *framework.Session) {
@@ -147,8 +180,11 @@ func (alloc *Action) buildAllocateContext() *allocateContext {
 		jobsByQueue:         make(map[api.QueueID]*util.PriorityQueue),
 		jobWorksheet:        make(map[api.JobID]*JobWorksheet),
 		tasksNoHardTopology: make(map[api.JobID]*util.PriorityQueue),
+		visited:             sets.New[api.JobID](),
+		freshJobs:           make(map[api.QueueID]int),
 	}
 
+	candidates := map[api.JobID]*api.JobInfo{}
 	for _, job := range ssn.Jobs {
 		// If not config enqueue action, change Pending pg into Inqueue state to avoid blocking job scheduling.
 		if job.IsPending() {
@@ -185,13 +221,7 @@ func (alloc *Action) buildAllocateContext() *allocateContext {
 			continue
 		}
 
-		if _, found := actx.jobsByQueue[job.Queue]; !found {
-			actx.jobsByQueue[job.Queue] = util.NewPriorityQueue(ssn.JobOrderFn)
-			actx.queues.Push(ssn.Queues[job.Queue])
-		}
-
-		klog.V(4).Infof("Added Job <%s/%s> into Queue <%s>", job.Namespace, job.Name, job.Queue)
-		actx.jobsByQueue[job.Queue].Push(job)
+		candidates[job.UID] = job
 		actx.jobWorksheet[job.UID] = worksheet
 
 		// job without any hard network topology policy use actx.tasksNoHardTopology
@@ -202,6 +232,22 @@ func (alloc *Action) buildAllocateContext() *allocateContext {
 		}
 	}
 
+	// The jobs are pushed once the deferred jobs are known, which decide their order.
+	alloc.pruneDeferred(sets.KeySet(candidates))
+	for _, job := range candidates {
+		if _, found := actx.jobsByQueue[job.Queue]; !found {
+			actx.jobsByQueue[job.Queue] = util.NewPriorityQueue(alloc.jobOrderFn)
+			actx.queues.Push(ssn.Queues[job.Queue])
+		}
+
+		klog.V(4).Infof("Added Job <%s/%s> into Queue <%s>", job.Namespace, job.Name, job.Queue)
+		actx.jobsByQueue[job.Queue].Push(job)
+		if !alloc.deferred.Has(job.UID) {
+			actx.freshJobs[job.Queue]++
+		}
+	}
+	alloc.resumeQueues(actx)
+
 	return actx
 }
 
@@ -289,6 +335,11 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 			break
 		}
 
+		if alloc.outOfTime() {
+			alloc.deferJobs(actx)
+			return
+		}
+
 		queue := queues.Pop().(*api.QueueInfo)
 
 		if ssn.Overused(queue) {
@@ -302,68 +353,82 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 			continue
 		}
 
-		job := jobs.Pop().(*api.JobInfo)
-		// Currently, both hard-mode network topology scheduling and subjob level scheduling use allocateForJob.
-		// TODO: In the future, we may need to unify the logic of network topology-aware scheduling and normal scheduling.
-		if job.ContainsHardTopology() || job.ContainsSubJobPolicy() {
-			jobWorksheet := actx.jobWorksheet[job.UID]
+		job := jobs.Peek().(*api.JobInfo)
+		if alloc.parallelJobs > 1 && alloc.parallelizable(actx, job) {
+			alloc.allocateBatch(actx, queue)
+			continue
+		}
 
-			klog.V(3).InfoS("Try to allocate resource for job contains hard topology or subjob policy", "queue", queue.Name, "job", job.UID,
-				"allocatedHyperNode", job.AllocatedHyperNode, "subJobNum", jobWorksheet.subJobs.Len())
-			stmt := alloc.allocateForJob(job, jobWorksheet, ssn.HyperNodes[framework.ClusterTopHyperNode])
-			if stmt != nil && ssn.JobReady(job) { // do not commit stmt when job is pipelined
-				stmt.Commit()
-				ssn.MarkJobDirty(job.UID)
-				alloc.recorder.UpdateDecisionToJob(job, ssn.HyperNodes)
+		alloc.allocateJob(actx, queue, alloc.popJob(actx, jobs))
 
-				// There are still left tasks that need to be allocated when min available < replicas, put the job back
-				if !jobWorksheet.Empty() {
-					jobs.Push(job)
-				}
+		// Put back the queue to priority queue after job's resource allocating finished,
+		// To ensure that the priority of the queue is calculated based on the latest resource allocation situation.
+		queues.Push(queue)
+	}
+	alloc.completePass()
+}
+
+// allocateJob allocates resources to the job of the queue, and puts the job back if it has tasks left.
+func (alloc *Action) allocateJob(actx *allocateContext, queue *api.QueueInfo, job *api.JobInfo) {
+	ssn := alloc.session
+	jobs := actx.jobsByQueue[queue.UID]
+
+	// Currently, both hard-mode network topology scheduling and subjob level scheduling use allocateForJob.
+	// TODO: In the future, we may need to unify the logic of network topology-aware scheduling and normal scheduling.
+	if job.ContainsHardTopology() || job.ContainsSubJobPolicy() {
+		jobWorksheet := actx.jobWorksheet[job.UID]
+
+		klog.V(3).InfoS("Try to allocate resource for job contains hard topology or subjob policy", "queue", queue.Name, "job", job.UID,
+			"allocatedHyperNode", job.AllocatedHyperNode, "subJobNum", jobWorksheet.subJobs.Len())
+		stmt := alloc.allocateForJob(job, jobWorksheet, ssn.HyperNodes[framework.ClusterTopHyperNode])
+		if stmt != nil && ssn.JobReady(job) { // do not commit stmt when job is pipelined
+			stmt.Commit()
+			ssn.MarkJobDirty(job.UID)
+			alloc.recorder.UpdateDecisionToJob(job, ssn.HyperNodes)
+
+			// There are still left tasks that need to be allocated when min available < replicas, put the job back
+			if !jobWorksheet.Empty() {
+				alloc.pushJob(actx, jobs, job)
 			}
-		} else {
-			subJob, sjExist := job.SubJobs[job.DefaultSubJobID()]
-			tasks, tasksExist := actx.tasksNoHardTopology[job.UID]
-			if sjExist && tasksExist {
-				klog.V(3).InfoS("Try to allocate resource", "queue", queue.Name, "job", job.UID,
-					"nominatedHyperNode", subJob.NominatedHyperNode, "taskNum", tasks.Len())
-
-				// Honor gangpreempt/gangreclaim's pin via the nomination fast path; fall back on miss.
-				var stmt *framework.Statement
-				if subJob.NominatedHyperNode != "" {
-					sjWorksheet := actx.jobWorksheet[job.UID].subJobWorksheets[job.DefaultSubJobID()]
-					if s, _, ok := alloc.allocateFromNomination(subJob, sjWorksheet, ssn.HyperNodes[framework.ClusterTopHyperNode]); ok {
-						stmt = s
-					}
-				}
-				if stmt == nil {
-					stmt = alloc.allocateResourcesForTasks(subJob, tasks, framework.ClusterTopHyperNode)
+		}
+	} else {
+		subJob, sjExist := job.SubJobs[job.DefaultSubJobID()]
+		tasks, tasksExist := actx.tasksNoHardTopology[job.UID]
+		if sjExist && tasksExist {
+			klog.V(3).InfoS("Try to allocate resource", "queue", queue.Name, "job", job.UID,
+				"nominatedHyperNode", subJob.NominatedHyperNode, "taskNum", tasks.Len())
+
+			// Honor gangpreempt/gangreclaim's pin via the nomination fast path; fall back on miss.
+			var stmt *framework.Statement
+			if subJob.NominatedHyperNode != "" {
+				sjWorksheet := actx.jobWorksheet[job.UID].subJobWorksheets[job.DefaultSubJobID()]
+				if s, _, ok := alloc.allocateFromNomination(subJob, sjWorksheet, ssn.HyperNodes[framework.ClusterTopHyperNode]); ok {
+					stmt = s
 				}
+			}
+			if stmt == nil {
+				stmt = alloc.allocateResourcesForTasks(subJob, tasks, framework.ClusterTopHyperNode)
+			}
 
-				if stmt != nil && ssn.JobReady(job) { // do not commit stmt when job is pipelined
-					stmt.Commit()
+			if stmt != nil && ssn.JobReady(job) { // do not commit stmt when job is pipelined
+				stmt.Commit()
 
-					// Mirror recorder.UpdateDecisionToJob: clear the redeemed nomination.
-					if subJob.NominatedHyperNode != "" {
-						klog.V(3).InfoS("clear nominated hyperNode for committed subJob",
-							"subJob", subJob.UID, "old", subJob.NominatedHyperNode)
-						subJob.NominatedHyperNode = ""
-					}
+				// Mirror recorder.UpdateDecisionToJob: clear the redeemed nomination.
+				if subJob.NominatedHyperNode != "" {
+					klog.V(3).InfoS("clear nominated hyperNode for committed subJob",
+						"subJob", subJob.UID, "old", subJob.NominatedHyperNode)
+					subJob.NominatedHyperNode = ""
+				}
 
-					// There are still left tasks that need to be allocated when min available < replicas, put the job back
-					if tasks.Len() > 0 {
-						jobs.Push(job)
-					}
+				// There are still left tasks that need to be allocated when min available < replicas, put the job back
+				if tasks.Len() > 0 {
+					alloc.pushJob(actx, jobs, job)
 				}
-			} else {
-				klog.ErrorS(nil, "Can not find default subJob or tasks for job", "job", job.UID,
-					"subJobExist", sjExist, "tasksExist", tasksExist)
 			}
+		} else {
+			klog.ErrorS(nil, "Can not find default subJob or tasks for job", "job", job.UID,
+				"subJobExist", sjExist, "tasksExist", tasksExist)
 		}
-
-		// Put back the queue to priority queue after job's resource allocating finished,
-		// To ensure that the priority of the queue is calculated based on the latest resource allocation situation.
-		queues.Push(queue)
 	}
 }
 
@@ -659,7 +724,7 @@ func (alloc *Action) allocateFromNomination(subJob *api.SubJobInfo, subJobWorksh
 func (alloc *Action) validateNomination(subJob *api.SubJobInfo, subJobWorksheet *SubJobWorksheet, queue *api.QueueInfo, leafNodeNames sets.Set[string]) ([]nominationPlanEntry, bool) {
 	ssn := alloc.session
 	pinned := subJob.NominatedHyperNode
-	ph := util.NewPredicateHelper()
+	ph := util.NewPredicateHelper(ssn.DryRun())
 	plan := make([]nominationPlanEntry, 0, subJobWorksheet.tasks.Len())
 	preview := subJobWorksheet.tasks.Clone()
 	for !preview.Empty() {
@@ -720,7 +785,6 @@ func (alloc *Action) allocateResourcesForTasks(subJob *api.SubJobInfo, tasks *ut
 	ssn := alloc.session
 
 	job := ssn.Jobs[subJob.Job]
-	queue := ssn.Queues[job.Queue]
 	nodes, exist := ssn.RealNodesList[hyperNode]
 	if !exist || len(nodes) == 0 {
 		klog.V(4).InfoS("There is no node in hyperNode", "job", job.UID, "hyperNode", hyperNode)
@@ -735,97 +799,18 @@ func (alloc *Action) allocateResourcesForTasks(subJob *api.SubJobInfo, tasks *ut
 	}
 
 	stmt := framework.NewStatement(ssn)
-	ph := util.NewPredicateHelper()
+	ph := util.NewPredicateHelper(ssn.DryRun())
 
 	allocatedHyperNode := subJob.AllocatedHyperNode
 
 	for !tasks.Empty() {
 		task := tasks.Pop().(*api.TaskInfo)
-		if !ssn.Allocatable(queue, task) {
-			klog.V(3).Infof("Queue <%s> is overused when considering task <%s>, ignore it.", queue.Name, task.Name)
-			continue
-		}
-
-		// If task passed allocation check and has the QueueAllocationGate, initiate async gate removal.
-		// Gate will be removed by the background worker (best effort).
-		if utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesQueueAdmission) &&
-			task.SchGated && api.HasQueueAllocationGateAnnotation(task.Pod) {
-			klog.V(3).Infof("Task %s/%s has the QueueAllocationGate, queue async gate removal", task.Namespace, task.Name)
-			ssn.SchGateManager().Enqueue(task)
-		}
-
-		// Skip gated tasks. If someone added the Volcano gate without the opt-in annotation,
-		// warn them since the gate will never be removed automatically.
-		if task.SchGated {
-			if api.HasOnlyVolcanoSchedulingGate(task.Pod) && !api.HasQueueAllocationGateAnnotation(task.Pod) {
-				klog.Warningf("Task %s/%s has Volcano scheduling gate but missing the opt-in annotation %q; gate will not be removed automatically",
-					task.Namespace, task.Name, schedulingv1beta1.QueueAllocationGateKey)
-			}
-			continue
-		}
-
-		// check if the task with its spec has already predicates failed
-		if job.TaskHasFitErrors(subJob.UID, task) {
-			msg := fmt.Sprintf("Task %s with role spec %s has already predicated failed, skip", task.Name, task.TaskRole)
-			klog.V(5).Info(msg)
-			fitErrors := api.NewFitErrors()
-			fitErrors.SetError(msg)
-			job.NodesFitErrors[task.UID] = fitErrors
-			continue
-		}
-
-		klog.V(3).Infof("There are <%d> nodes for Job <%v/%v>", len(nodes), job.Namespace, job.Name)
-
-		if err := ssn.PrePredicateFn(task); err != nil {
-			klog.V(3).Infof("PrePredicate for task %s/%s failed for: %v", task.Namespace, task.Name, err)
-			fitErrors := api.NewFitErrors()
-			for _, ni := range nodes {
-				fitErrors.SetNodeError(ni.Name, err)
-			}
-			job.NodesFitErrors[task.UID] = fitErrors
-			if job.NeedContinueAllocating(subJob.UID) {
-				continue
-			}
+		predicateNodes, next := alloc.filterNodes(ph, subJob, task, nodes, nodeNameSet, hyperNode, alloc.predicate)
+		if !next {
 			break
 		}
-
-		var predicateNodes []*api.NodeInfo
-		var fitErrors *api.FitErrors
-
-		// "NominatedNodeName" can potentially be set in a previous scheduling cycle as a result of preemption.
-		// This node is likely the only candidate that will fit the pod, and hence we try it first before iterating over all nodes.
-		// Only honor it when the nominated node belongs to this iteration's leaf set (defense in depth against cross-domain leaks).
-		if nominated := task.Pod.Status.NominatedNodeName; len(nominated) > 0 {
-			if _, inLeafSet := nodeNameSet[nominated]; inLeafSet {
-				if nominatedNodeInfo, ok := ssn.Nodes[nominated]; ok && task.InitResreq.LessEqual(nominatedNodeInfo.FutureIdle(), api.Zero) {
-					predicateNodes, fitErrors = ph.PredicateNodes(task, []*api.NodeInfo{nominatedNodeInfo}, alloc.predicate, alloc.enablePredicateErrorCache, ssn.NodesInShard)
-				}
-			}
-		}
-
-		// If the nominated node is not found or the nominated node is not suitable for the task, we need to find a suitable node for the task from all nodes.
 		if len(predicateNodes) == 0 {
-			predicateNodes, fitErrors = ph.PredicateNodes(task, nodes, alloc.predicate, alloc.enablePredicateErrorCache, ssn.NodesInShard)
-		}
-
-		if len(predicateNodes) == 0 {
-			// TODO: Need to add PostFilter extension point implementation here. For example, the DRA plugin includes the PostFilter extension point,
-			// but the DRA's PostFilter only occurs in extreme error conditions: Suppose a pod uses two claims. In the first scheduling attempt,
-			// a node is picked and PreBind manages to update the first claim so that it is allocated and reserved for the pod.
-			// But then updating the second claim fails (e.g., apiserver down) and the scheduler has to retry. During the next pod scheduling attempt,
-			// the original node is no longer usable for other reasons. Other nodes are not usable either because of the allocated claim.
-			// The DRA scheduler plugin detects that and then when scheduling fails (= no node passed filtering), it recovers by de-allocating the allocated claim in PostFilter.
-			if fitErrors != nil && hyperNode != framework.ClusterTopHyperNode {
-				fitErrors.SetHyperNode(hyperNode)
-			}
-			job.NodesFitErrors[task.UID] = fitErrors
-			// Assume that all left tasks are allocatable, but can not meet gang-scheduling min member,
-			// so we should break from continuously allocating.
-			// otherwise, should continue to find other allocatable task
-			if job.NeedContinueAllocating(subJob.UID) {
-				continue
-			}
-			break
+			continue
 		}
 
 		if subJob.WithNetworkTopology() {
@@ -866,6 +851,104 @@ func (alloc *Action) allocateResourcesForTasks(subJob *api.SubJobInfo, tasks *ut
 	return nil
 }
 
+// filterNodes runs the checks of allocate on the task, and returns the nodes which pass the predicate. It returns
+// false if the tasks left of the subJob should not be tried either.
+func (alloc *Action) filterNodes(ph util.PredicateHelper, subJob *api.SubJobInfo, task *api.TaskInfo, nodes []*api.NodeInfo,
+	nodeNameSet map[string]struct{}, hyperNode string, predicate api.PredicateFn) ([]*api.NodeInfo, bool) {
+	ssn := alloc.session
+	job := ssn.Jobs[subJob.Job]
+	queue := ssn.Queues[job.Queue]
+
+	if !ssn.Allocatable(queue, task) {
+		klog.V(3).Infof("Queue <%s> is overused when considering task <%s>, ignore it.", queue.Name, task.Name)
+		return nil, true
+	}
+
+	// If task passed allocation check and has the QueueAllocationGate, initiate async gate removal.
+	// Gate will be removed by the background worker (best effort).
+	if utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesQueueAdmission) &&
+		task.SchGated && api.HasQueueAllocationGateAnnotation(task.Pod) {
+		klog.V(3).Infof("Task %s/%s has the QueueAllocationGate, queue async gate removal", task.Namespace, task.Name)
+		ssn.SchGateManager().Enqueue(task)
+	}
+
+	// Skip gated tasks. If someone added the Volcano gate without the opt-in annotation,
+	// warn them since the gate will never be removed automatically.
+	if task.SchGated {
+		if api.HasOnlyVolcanoSchedulingGate(task.Pod) && !api.HasQueueAllocationGateAnnotation(task.Pod) {
+			klog.Warningf("Task %s/%s has Volcano scheduling gate but missing the opt-in annotation %q; gate will not be removed automatically",
+				task.Namespace, task.Name, schedulingv1beta1.QueueAllocationGateKey)
+		}
+		return nil, true
+	}
+
+	// check if the task with its spec has already predicates failed
+	if job.TaskHasFitErrors(subJob.UID, task) {
+		msg := fmt.Sprintf("Task %s with role spec %s has already predicated failed, skip", task.Name, task.TaskRole)
+		klog.V(5).Info(msg)
+		fitErrors := api.NewFitErrors()
+		fitErrors.SetError(msg)
+		job.NodesFitErrors[task.UID] = fitErrors
+		return nil, true
+	}
+
+	klog.V(3).Infof("There are <%d> nodes for Job <%v/%v>", len(nodes), job.Namespace, job.Name)
+
+	if err := ssn.PrePredicateFn(task); err != nil {
+		klog.V(3).Infof("PrePredicate for task %s/%s failed for: %v", task.Namespace, task.Name, err)
+		fitErrors := api.NewFitErrors()
+		for _, ni := range nodes {
+			fitErrors.SetNodeError(ni.Name, err)
+		}
+		job.NodesFitErrors[task.UID] = fitErrors
+		if job.NeedContinueAllocating(subJob.UID) {
+			return nil, true
+		}
+		return nil, false
+	}
+
+	var predicateNodes []*api.NodeInfo
+	var fitErrors *api.FitErrors
+
+	// "NominatedNodeName" can potentially be set in a previous scheduling cycle as a result of preemption.
+	// This node is likely the only candidate that will fit the pod, and hence we try it first before iterating over all nodes.
+	// Only honor it when the nominated node belongs to this iteration's leaf set (defense in depth against cross-domain leaks).
+	if nominated := task.Pod.Status.NominatedNodeName; len(nominated) > 0 {
+		if _, inLeafSet := nodeNameSet[nominated]; inLeafSet {
+			if nominatedNodeInfo, ok := ssn.Nodes[nominated]; ok && task.InitResreq.LessEqual(nominatedNodeInfo.FutureIdle(), api.Zero) {
+				predicateNodes, fitErrors = ph.PredicateNodes(task, []*api.NodeInfo{nominatedNodeInfo}, predicate, alloc.enablePredicateErrorCache, ssn.NodesInShard)
+			}
+		}
+	}
+
+	// If the nominated node is not found or the nominated node is not suitable for the task, we need to find a suitable node for the task from all nodes.
+	if len(predicateNodes) == 0 {
+		predicateNodes, fitErrors = ph.PredicateNodes(task, nodes, predicate, alloc.enablePredicateErrorCache, ssn.NodesInShard)
+	}
+
+	if len(predicateNodes) == 0 {
+		// TODO: Need to add PostFilter extension point implementation here. For example, the DRA plugin includes the PostFilter extension point,
+		// but the DRA's PostFilter only occurs in extreme error conditions: Suppose a pod uses two claims. In the first scheduling attempt,
+		// a node is picked and PreBind manages to update the first claim so that it is allocated and reserved for the pod.
+		// But then updating the second claim fails (e.g., apiserver down) and the scheduler has to retry. During the next pod scheduling attempt,
+		// the original node is no longer usable for other reasons. Other nodes are not usable either because of the allocated claim.
+		// The DRA scheduler plugin detects that and then when scheduling fails (= no node passed filtering), it recovers by de-allocating the allocated claim in PostFilter.
+		if fitErrors != nil && hyperNode != framework.ClusterTopHyperNode {
+			fitErrors.SetHyperNode(hyperNode)
+		}
+		job.NodesFitErrors[task.UID] = fitErrors
+		// Assume that all left tasks are allocatable, but can not meet gang-scheduling min member,
+		// so we should break from continuously allocating.
+		// otherwise, should continue to find other allocatable task
+		if job.NeedContinueAllocating(subJob.UID) {
+			return nil, true
+		}
+		return nil, false
+	}
+
+	return predicateNodes, true
+}
+
 // getNewAllocatedHyperNode Obtain the newly allocated hyperNode for the job in soft topology mode
 func getNewAllocatedHyperNode(ssn *framework.Session, bestNode string, jobAllocatedHyperNode string) string {
 	hyperNode := util.FindHyperNodeForNode(bestNode, ssn.RealNodesList, ssn.HyperNodesTiers, ssn.HyperNodesSetByTier)
@@ -934,7 +1017,8 @@ func (alloc *Action) prioritizeNodes(ssn *framework.Session, task *api.TaskInfo,
 		case len(nodes) == 1: // If only one node after predicate, just use it.
 			bestNode = nodes[0]
 		case len(nodes) > 1: // If more than one node after predicate, using "the best" one
-			nodeScores := util.PrioritizeNodes(task, nodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn)
+			nodeScores := util.PrioritizeNodes(task, nodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn, ssn.DryRun())
+			ssn.RecordNodeScores(task, nodeScores)
 
 			bestNode = ssn.BestNodeFn(task, nodeScores)
 			if bestNode == nil {
@@ -957,7 +1041,7 @@ func (alloc *Action) allocateResourcesForTask(stmt *framework.Statement, task *a
 		if err = stmt.Allocate(task, node); err != nil {
 			klog.Errorf("Failed to bind Task %v on %v in Session %v, err: %v",
 				task.UID, node.Name, alloc.session.UID, err)
-		} else {
+		} else if !alloc.session.DryRun() {
 			metrics.UpdateE2eSchedulingDurationByJob(job.Name, string(job.Queue), job.Namespace, metrics.Duration(job.CreationTimestamp.Time))
 			metrics.UpdateE2eSchedulingLastTimeByJob(job.Name, string(job.Queue), job.Namespace, time.Now())
 		}
@@ -974,7 +1058,7 @@ func (alloc *Action) allocateResourcesForTask(stmt *framework.Statement, task *a
 		if err = stmt.Pipeline(task, node.Name, false); err != nil {
 			klog.Errorf("Failed to pipeline Task %v on %v in Session %v for %v.",
 				task.UID, node.Name, alloc.session.UID, err)
-		} else {
+		} else if !alloc.session.DryRun() {
 			metrics.UpdateE2eSchedulingDurationByJob(job.Name, string(job.Queue), job.Namespace, metrics.Duration(job.CreationTimestamp.Time))
 			metrics.UpdateE2eSchedulingLastTimeByJob(job.Name, string(job.Queue), job.Namespace, time.Now())
 		}
@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// ParallelJobsKey is the argument key of the number of jobs allocate plans concurrently, each of a different
+	// queue. Allocate handles one job at a time if it is not above 1.
+	ParallelJobsKey = "parallelJobs"
+)
+
+/*
+In the parallel mode, allocate takes the jobs at the head of up to parallelJobs queues, and plans the placement of
+their tasks concurrently against the nodes of the session, which nobody changes meanwhile. The plan of a job keeps
+the resources of the tasks it placed on a node for the next tasks of the job, but it does not see the other jobs
+of the batch. The plans are then committed one at a time, in the order of their queues: every task is checked
+again against the nodes as they are now, and allocated by the statement of the job. A plan loses if it placed a
+task on a node used by a plan committed before it, or if one of its tasks no longer fits; its statement is
+discarded, and its job is allocated again the usual way once the batch is committed. The jobs of queues using
+disjoint nodes, e.g. of a cluster partitioned by node groups, never lose.
+
+The prePredicate, predicate and node order functions of the plugins are called concurrently for the tasks of the
+jobs of a batch, so the mode is only for plugins which do not change shared state in them.
+*/
+
+// placement is a node planned for a task.
+type placement struct {
+	task *api.TaskInfo
+	node *api.NodeInfo
+}
+
+// jobPlan is the placement planned for the tasks of a job of a batch.
+type jobPlan struct {
+	queue *api.QueueInfo
+	job   *api.JobInfo
+	// tasks are the tasks of the job left after the plan, which become the tasks of its worksheet once it commits.
+	tasks      *util.PriorityQueue
+	placements []placement
+}
+
+// parallelizable returns whether the job can be planned together with the jobs of other queues. The jobs with
+// network topologies, subJob policies or nominated hyperNodes are allocated one at a time.
+func (alloc *Action) parallelizable(actx *allocateContext, job *api.JobInfo) bool {
+	if job.ContainsHardTopology() || job.ContainsSubJobPolicy() {
+		return false
+	}
+	if _, found := actx.tasksNoHardTopology[job.UID]; !found {
+		return false
+	}
+	subJob, found := job.SubJobs[job.DefaultSubJobID()]
+	return found && subJob.NominatedHyperNode == "" && !subJob.WithNetworkTopology()
+}
+
+// allocateBatch plans the jobs at the head of the queue and of the queues next to it concurrently, commits the
+// plans, and allocates the jobs whose plans lost one at a time. The queues are put back afterwards.
+func (alloc *Action) allocateBatch(actx *allocateContext, queue *api.QueueInfo) {
+	ssn := alloc.session
+
+	batch := []*api.QueueInfo{queue}
+	for len(batch) < alloc.parallelJobs && !actx.queues.Empty() {
+		next := actx.queues.Pop().(*api.QueueInfo)
+		if ssn.Overused(next) {
+			klog.V(3).Infof("Queue <%s> is overused, ignore it.", next.Name)
+			continue
+		}
+		jobs, found := actx.jobsByQueue[next.UID]
+		if !found || jobs.Empty() {
+			klog.V(4).Infof("Can not find jobs for queue %s.", next.Name)
+			continue
+		}
+		// The job of a higher queue is not left behind the jobs of the lower ones.
+		if !alloc.parallelizable(actx, jobs.Peek().(*api.JobInfo)) {
+			actx.queues.Push(next)
+			break
+		}
+		batch = append(batch, next)
+	}
+
+	plans := make([]*jobPlan, len(batch))
+	for i, q := range batch {
+		plans[i] = &jobPlan{queue: q, job: alloc.popJob(actx, actx.jobsByQueue[q.UID])}
+	}
+	klog.V(3).InfoS("Try to allocate resource to jobs in parallel", "jobNum", len(plans))
+	workqueue.ParallelizeUntil(context.TODO(), len(plans), len(plans), func(i int) {
+		alloc.planJob(actx, plans[i])
+	})
+
+	var losers []*jobPlan
+	claimed := sets.New[string]()
+	for _, plan := range plans {
+		if !alloc.commitPlan(actx, plan, claimed) {
+			losers = append(losers, plan)
+		}
+	}
+	if !alloc.session.DryRun() {
+		metrics.RegisterParallelAllocateConflicts(len(losers))
+	}
+	for _, plan := range losers {
+		klog.V(3).InfoS("Retry job whose plan lost to the jobs committed before it", "queue", plan.queue.Name, "job", plan.job.UID)
+		plan.job.ResetFitErr()
+		alloc.allocateJob(actx, plan.queue, plan.job)
+	}
+
+	for _, q := range batch {
+		actx.queues.Push(q)
+	}
+}
+
+// planJob plans the nodes of the tasks of the job against the nodes of the session, without changing them.
+func (alloc *Action) planJob(actx *allocateContext, plan *jobPlan) {
+	ssn := alloc.session
+	job := plan.job
+	subJob := job.SubJobs[job.DefaultSubJobID()]
+	plan.tasks = actx.tasksNoHardTopology[job.UID].Clone()
+
+	nodes := ssn.RealNodesList[framework.ClusterTopHyperNode]
+	if len(nodes) == 0 {
+		klog.V(4).InfoS("There is no node in hyperNode", "job", job.UID, "hyperNode", framework.ClusterTopHyperNode)
+		return
+	}
+	nodeNameSet := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		if n != nil {
+			nodeNameSet[n.Name] = struct{}{}
+		}
+	}
+
+	// reserved are the resources of the tasks planned on the nodes, which are only written between the predicates.
+	reserved := make(map[string]*api.Resource)
+	predicate := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		if r, found := reserved[node.Name]; found {
+			if ok, resources := task.InitResreq.Clone().Add(r).LessEqualWithResourcesName(node.FutureIdle(), api.Zero); !ok {
+				return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Unschedulable, Reason: api.WrapInsufficientResourceReason(resources)})
+			}
+		}
+		return alloc.predicate(task, node)
+	}
+
+	ph := util.NewPredicateHelper(alloc.session.DryRun())
+	for !plan.tasks.Empty() {
+		task := plan.tasks.Pop().(*api.TaskInfo)
+		predicateNodes, next := alloc.filterNodes(ph, subJob, task, nodes, nodeNameSet, framework.ClusterTopHyperNode, predicate)
+		if !next {
+			break
+		}
+		if len(predicateNodes) == 0 {
+			continue
+		}
+
+		bestNode, _ := alloc.prioritizeNodes(ssn, task, predicateNodes)
+		if bestNode == nil {
+			continue
+		}
+		plan.placements = append(plan.placements, placement{task: task, node: bestNode})
+		if _, found := reserved[bestNode.Name]; !found {
+			reserved[bestNode.Name] = api.EmptyResource()
+		}
+		reserved[bestNode.Name].Add(task.InitResreq)
+	}
+}
+
+// commitPlan allocates the tasks of the plan by a statement, and commits it if the job is ready. The nodes used by
+// the statement are added to claimed unless it is discarded. It returns false if the plan lost to the plans
+// committed before it.
+func (alloc *Action) commitPlan(actx *allocateContext, plan *jobPlan, claimed sets.Set[string]) bool {
+	ssn := alloc.session
+	job := plan.job
+	subJob := job.SubJobs[job.DefaultSubJobID()]
+
+	// No node fits the tasks, and the plans committed before only took resources away.
+	if len(plan.placements) == 0 {
+		return true
+	}
+	for _, p := range plan.placements {
+		if claimed.Has(p.node.Name) {
+			klog.V(3).InfoS("Plan of job conflicts with the jobs committed before it", "job", job.UID, "node", p.node.Name)
+			return false
+		}
+	}
+
+	stmt := framework.NewStatement(ssn)
+	used := sets.New[string]()
+	committed := 0
+	for _, p := range plan.placements {
+		if !ssn.Allocatable(plan.queue, p.task) {
+			klog.V(3).InfoS("Queue is overused when committing the plan of job", "queue", plan.queue.Name, "job", job.UID, "task", p.task.Name)
+			stmt.Discard()
+			return false
+		}
+		if err := alloc.predicate(p.task, p.node); err != nil {
+			klog.V(3).InfoS("Planned node no longer fits the task", "job", job.UID, "task", p.task.Name, "node", p.node.Name, "err", err)
+			stmt.Discard()
+			return false
+		}
+		if err := alloc.allocateResourcesForTask(stmt, p.task, p.node, job); err != nil {
+			stmt.Discard()
+			return false
+		}
+		used.Insert(p.node.Name)
+		committed++
+
+		if ssn.SubJobReady(job, subJob) {
+			break
+		}
+	}
+	// The tasks planned after the job became ready are left to the next turn of the job.
+	for _, p := range plan.placements[committed:] {
+		plan.tasks.Push(p.task)
+	}
+
+	if !ssn.SubJobReady(job, subJob) && !ssn.SubJobPipelined(job, subJob) {
+		stmt.Discard()
+		return true
+	}
+
+	claimed.Insert(used.UnsortedList()...)
+	actx.tasksNoHardTopology[job.UID] = plan.tasks
+	if worksheet, found := actx.jobWorksheet[job.UID]; found {
+		if sjWorksheet, found := worksheet.subJobWorksheets[job.DefaultSubJobID()]; found {
+			sjWorksheet.tasks = plan.tasks
+		}
+	}
+	if ssn.JobReady(job) { // do not commit stmt when job is pipelined
+		stmt.Commit()
+		if plan.tasks.Len() > 0 {
+			alloc.pushJob(actx, actx.jobsByQueue[plan.queue.UID], job)
+		}
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/nodeorder"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestAllocateParallel(t *testing.T) {
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{Name: gang.PluginName, EnabledJobReady: &trueValue, EnabledJobPipelined: &trueValue},
+				{Name: predicates.PluginName, EnabledPredicate: &trueValue},
+				{
+					Name:             nodeorder.PluginName,
+					EnabledNodeOrder: &trueValue,
+					Arguments: framework.Arguments{
+						nodeorder.LeastRequestedWeight:   1,
+						nodeorder.BalancedResourceWeight: 0,
+						nodeorder.ImageLocalityWeight:    0,
+						nodeorder.NodeAffinityWeight:     0,
+						nodeorder.PodAffinityWeight:      0,
+						nodeorder.TaintTolerationWeight:  0,
+					},
+				},
+			},
+		},
+	}
+	configurations := []conf.Configuration{{Name: "allocate", Arguments: map[string]interface{}{ParallelJobsKey: 2}}}
+	groupA, groupB := map[string]string{"nodegroup": "a"}, map[string]string{"nodegroup": "b"}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name: "jobs of queues using disjoint node groups",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q1", 2, nil, schedulingv1.PodGroupInqueue),
+				util.BuildPodGroup("pg2", "c1", "q2", 2, nil, schedulingv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg1", make(map[string]string), groupA),
+				util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg1", make(map[string]string), groupA),
+				util.BuildPod("c1", "p3", "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg2", make(map[string]string), groupB),
+				util.BuildPod("c1", "p4", "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg2", make(map[string]string), groupB),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), groupA),
+				util.BuildNode("n2", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), groupB),
+			},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			},
+			ExpectBindMap:  map[string]string{"c1/p1": "n1", "c1/p2": "n1", "c1/p3": "n2", "c1/p4": "n2"},
+			ExpectBindsNum: 4,
+		},
+		{
+			// Both jobs plan the emptier n1, the job of q2 loses and is allocated again to n2.
+			Name: "the job whose plan conflicts is retried",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1.PodGroupInqueue),
+				util.BuildPodGroup("pg2", "c1", "q2", 1, nil, schedulingv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg2", make(map[string]string), make(map[string]string)),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+				util.BuildNode("n2", api.BuildResourceList("6", "6Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			},
+			ExpectBindMap:  map[string]string{"c1/p1": "n1", "c1/p2": "n2"},
+			ExpectBindsNum: 2,
+		},
+		{
+			// pg2 loses n1 to pg1, and no node is left for it when it is retried.
+			Name: "the job which lost its node is not allocated",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1.PodGroupInqueue),
+				util.BuildPodGroup("pg2", "c1", "q2", 1, nil, schedulingv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("3", "3G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("3", "3G"), "pg2", make(map[string]string), make(map[string]string)),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			},
+			ExpectBindMap:  map[string]string{"c1/p1": "n1"},
+			ExpectBindsNum: 1,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Plugins = map[string]framework.PluginBuilder{
+				gang.PluginName:       gang.New,
+				predicates.PluginName: predicates.New,
+				nodeorder.PluginName:  nodeorder.New,
+			}
+			test.RegisterSession(tiers, configurations)
+			defer test.Close()
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
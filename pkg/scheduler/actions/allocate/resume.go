@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// SessionBudgetKey is the argument key of the time allocate may run until, counted from the start of the
+	// session, e.g. 800ms. Allocate stops between jobs once it is spent, and the next session resumes from there.
+	SessionBudgetKey = "sessionBudget"
+)
+
+/*
+A pass of allocate visits the jobs in the order of their queues and priorities. If the pass runs out of time,
+the jobs it visited are deferred: the next sessions order them after the jobs which were not visited, and the
+queues holding the jobs which were not visited before the others, so that the pass resumes where it stopped
+instead of starting over. The jobs are no longer deferred once a pass completes, or every job was visited.
+*/
+
+// outOfTime returns whether allocate has spent the budget of the session or its own.
+func (alloc *Action) outOfTime() bool {
+	ssn := alloc.session
+	if ssn.ActionTimedOut() {
+		return true
+	}
+	return alloc.sessionBudget > 0 && alloc.clock.Since(ssn.StartTime) >= alloc.sessionBudget
+}
+
+// deferJobs defers the jobs visited in the session, so that the next session starts from the others.
+func (alloc *Action) deferJobs(actx *allocateContext) {
+	left := 0
+	for _, jobs := range actx.jobsByQueue {
+		left += jobs.Len()
+	}
+	klog.V(3).Infof("Allocate ran out of time after %v, %d jobs are left to the next session.",
+		alloc.clock.Since(alloc.session.StartTime), left)
+	if !alloc.session.DryRun() {
+		metrics.RegisterSessionBudgetExceeded(alloc.Name())
+		metrics.UpdateDeferredJobs(alloc.Name(), left)
+	}
+
+	alloc.deferred = alloc.deferred.Union(actx.visited)
+}
+
+// completePass resets the deferred jobs once allocate visited all the jobs.
+func (alloc *Action) completePass() {
+	if !alloc.session.DryRun() {
+		metrics.UpdateDeferredJobs(alloc.Name(), 0)
+	}
+	alloc.deferred = sets.New[api.JobID]()
+}
+
+// pruneDeferred drops the jobs which are gone from the deferred jobs, and starts a new round if all the
+// candidates of the session are deferred.
+func (alloc *Action) pruneDeferred(candidates sets.Set[api.JobID]) {
+	alloc.deferred = alloc.deferred.Intersection(candidates)
+	if alloc.deferred.Len() == candidates.Len() {
+		alloc.deferred = sets.New[api.JobID]()
+	}
+}
+
+// jobOrderFn orders the deferred jobs after the others.
+func (alloc *Action) jobOrderFn(l, r interface{}) bool {
+	ld, rd := alloc.deferred.Has(l.(*api.JobInfo).UID), alloc.deferred.Has(r.(*api.JobInfo).UID)
+	if ld != rd {
+		return !ld
+	}
+	return alloc.session.JobOrderFn(l, r)
+}
+
+// queueOrderFn orders the queues with jobs which are not deferred before the others.
+func (alloc *Action) queueOrderFn(actx *allocateContext) func(l, r interface{}) bool {
+	return func(l, r interface{}) bool {
+		lf, rf := actx.freshJobs[l.(*api.QueueInfo).UID] > 0, actx.freshJobs[r.(*api.QueueInfo).UID] > 0
+		if lf != rf {
+			return lf
+		}
+		return alloc.session.QueueOrderFn(l, r)
+	}
+}
+
+// resumeQueues orders the queues of the context by queueOrderFn if jobs are deferred.
+func (alloc *Action) resumeQueues(actx *allocateContext) {
+	if alloc.deferred.Len() == 0 {
+		return
+	}
+	queues := util.NewPriorityQueue(alloc.queueOrderFn(actx))
+	for !actx.queues.Empty() {
+		queues.Push(actx.queues.Pop())
+	}
+	actx.queues = queues
+}
+
+// popJob pops the next job of the queue, and marks it visited.
+func (alloc *Action) popJob(actx *allocateContext, jobs *util.PriorityQueue) *api.JobInfo {
+	job := jobs.Pop().(*api.JobInfo)
+	if !alloc.deferred.Has(job.UID) {
+		actx.freshJobs[job.Queue]--
+	}
+	actx.visited.Insert(job.UID)
+	return job
+}
+
+// pushJob puts back the job which has tasks left.
+func (alloc *Action) pushJob(actx *allocateContext, jobs *util.PriorityQueue, job *api.JobInfo) {
+	if !alloc.deferred.Has(job.UID) {
+		actx.freshJobs[job.Queue]++
+	}
+	jobs.Push(job)
+}
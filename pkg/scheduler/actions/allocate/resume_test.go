@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestAllocateResume(t *testing.T) {
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{Name: gang.PluginName, EnabledJobReady: &trueValue, EnabledJobPipelined: &trueValue},
+				{Name: predicates.PluginName, EnabledPredicate: &trueValue},
+			},
+		},
+	}
+	configurations := []conf.Configuration{{Name: "allocate", Arguments: map[string]interface{}{SessionBudgetKey: "1s"}}}
+
+	action := New()
+	tests := []struct {
+		name string
+		// jobs is the number of jobs allocate has time for, 0 if unlimited.
+		jobs           int
+		expectBindMap  map[string]string
+		expectDeferred []api.JobID
+	}{
+		{
+			name:           "the first pass runs out of time after pg1",
+			jobs:           1,
+			expectBindMap:  map[string]string{"c1/p1": "n1"},
+			expectDeferred: []api.JobID{"c1/pg1"},
+		},
+		{
+			name:           "the next session resumes from pg2",
+			jobs:           1,
+			expectBindMap:  map[string]string{"c1/p2": "n1"},
+			expectDeferred: []api.JobID{"c1/pg1", "c1/pg2"},
+		},
+		{
+			name:           "a complete pass resets the deferred jobs",
+			expectBindMap:  map[string]string{"c1/p1": "n1", "c1/p2": "n1", "c1/p3": "n1"},
+			expectDeferred: []api.JobID{},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			action.clock = &budgetClock{PassiveClock: clock.RealClock{}, jobs: test.jobs}
+
+			// The binds of the previous sessions are not observed yet, the jobs are pending in every session.
+			testStruct := uthelper.TestCommonStruct{
+				Name:    test.name,
+				Plugins: map[string]framework.PluginBuilder{gang.PluginName: gang.New, predicates.PluginName: predicates.New},
+				PodGroups: []*schedulingv1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue),
+					util.BuildPodGroup("pg2", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue),
+					util.BuildPodGroup("pg3", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+					util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg2", make(map[string]string), make(map[string]string)),
+					util.BuildPod("c1", "p3", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg3", make(map[string]string), make(map[string]string)),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+				},
+				Queues: []*schedulingv1.Queue{
+					util.BuildQueue("c1", 1, nil),
+				},
+				ExpectBindMap:  test.expectBindMap,
+				ExpectBindsNum: len(test.expectBindMap),
+			}
+			testStruct.RegisterSession(tiers, configurations)
+			defer testStruct.Close()
+			testStruct.Run([]framework.Action{action})
+			if err := testStruct.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+			if len(action.deferred) != len(test.expectDeferred) || !action.deferred.HasAll(test.expectDeferred...) {
+				t.Errorf("expected deferred jobs %v, got %v", test.expectDeferred, action.deferred.UnsortedList())
+			}
+		})
+	}
+}
+
+// budgetClock tells allocate that the session budget is spent once it has asked for more than jobs times, never if
+// jobs is 0.
+type budgetClock struct {
+	clock.PassiveClock
+	jobs  int
+	calls int
+}
+
+func (c *budgetClock) Since(time.Time) time.Duration {
+	c.calls++
+	if c.jobs > 0 && c.calls > c.jobs {
+		return time.Hour
+	}
+	return 0
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/conf"
@@ -35,12 +36,16 @@ import (
 )
 
 type Action struct {
-	enablePredicateErrorCache bool
+	enablePredicateErrorCache  bool
+	enableRuntimeAwareBackfill bool
+
+	clock clock.PassiveClock
 }
 
 func New() *Action {
 	return &Action{
 		enablePredicateErrorCache: true, // default to enable it
+		clock:                     clock.RealClock{},
 	}
 }
 
@@ -53,6 +58,7 @@ func (backfill *Action) Initialize() {}
 func (backfill *Action) parseArguments(ssn *framework.Session) {
 	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, backfill.Name())
 	arguments.GetBool(&backfill.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+	arguments.GetBool(&backfill.enableRuntimeAwareBackfill, EnableRuntimeAwareBackfillKey)
 }
 
 func (backfill *Action) Execute(ssn *framework.Session) {
@@ -66,8 +72,13 @@ func (backfill *Action) Execute(ssn *framework.Session) {
 	// TODO (k82cn): When backfill, it's also need to balance between Queues.
 	pendingTasks := backfill.pickUpPendingTasks(ssn)
 	for _, task := range pendingTasks {
+		if ssn.ActionTimedOut() {
+			klog.V(3).Infof("Backfill ran out of its time budget, leave the rest of the tasks to the next session.")
+			break
+		}
+
 		job := ssn.Jobs[task.Job]
-		ph := util.NewPredicateHelper()
+		ph := util.NewPredicateHelper(ssn.DryRun())
 		fe := api.NewFitErrors()
 
 		if err := ssn.PrePredicateFn(task); err != nil {
@@ -89,7 +100,7 @@ func (backfill *Action) Execute(ssn *framework.Session) {
 		if len(predicateNodes) > 1 {
 			candidateNodes := util.GetPredicatedNodeByShard(predicateNodes, ssn.NodesInShard)
 			for _, nodes := range candidateNodes {
-				nodeScores := util.PrioritizeNodes(task, nodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn)
+				nodeScores := util.PrioritizeNodes(task, nodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn, ssn.DryRun())
 				node = ssn.BestNodeFn(task, nodeScores)
 				if node == nil {
 					node, _ = util.SelectBestNodeAndScore(nodeScores)
@@ -108,11 +119,17 @@ func (backfill *Action) Execute(ssn *framework.Session) {
 			continue
 		}
 
-		metrics.UpdateE2eSchedulingDurationByJob(job.Name, string(job.Queue), job.Namespace, metrics.Duration(job.CreationTimestamp.Time))
-		metrics.UpdateE2eSchedulingLastTimeByJob(job.Name, string(job.Queue), job.Namespace, time.Now())
+		if !ssn.DryRun() {
+			metrics.UpdateE2eSchedulingDurationByJob(job.Name, string(job.Queue), job.Namespace, metrics.Duration(job.CreationTimestamp.Time))
+			metrics.UpdateE2eSchedulingLastTimeByJob(job.Name, string(job.Queue), job.Namespace, time.Now())
+		}
 
 		// TODO (k82cn): backfill for other case.
 	}
+
+	if backfill.enableRuntimeAwareBackfill {
+		backfill.runtimeAwareBackfill(ssn)
+	}
 }
 
 func (backfill *Action) UnInitialize() {}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backfill
+
+import (
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// EnableRuntimeAwareBackfillKey is the key whether jobs with a running estimate are backfilled ahead of
+// the first blocked gang job, as long as they finish before the gang job can start.
+const EnableRuntimeAwareBackfillKey = "runtimeAwareBackfillEnable"
+
+// runtimeAwareBackfill implements EASY backfilling: the earliest start time of the first blocked gang job,
+// the shadow time, is estimated from the end times of the running tasks, then jobs with a declared or
+// predicted running duration are started ahead of it if they fit in the idle resources and finish before
+// the shadow time. Jobs still Pending in enqueue are considered too, since the resources held for the blocked gang job
+// are the ones which would otherwise stay idle.
+func (backfill *Action) runtimeAwareBackfill(ssn *framework.Session) {
+	waiting := util.NewPriorityQueue(ssn.JobOrderFn)
+	for _, job := range ssn.Jobs {
+		if len(job.TaskStatusIndex[api.Pending]) == 0 || job.PodGroup == nil {
+			continue
+		}
+		if _, found := ssn.Queues[job.Queue]; !found {
+			continue
+		}
+		if vr := ssn.JobValid(job); vr != nil && !vr.Pass {
+			continue
+		}
+		waiting.Push(job)
+	}
+
+	var head *api.JobInfo
+	for !waiting.Empty() && head == nil {
+		if job := waiting.Pop().(*api.JobInfo); !ssn.JobReady(job) {
+			head = job
+		}
+	}
+	if head == nil {
+		return
+	}
+
+	current := backfill.clock.Now()
+	shadowTime, found := earliestStartTime(ssn, head, current)
+	if !found {
+		klog.V(4).Infof("Start time of blocked job <%s/%s> can not be estimated, skip runtime aware backfill",
+			head.Namespace, head.Name)
+		return
+	}
+	klog.V(3).Infof("Blocked job <%s/%s> is expected to start at %v", head.Namespace, head.Name, shadowTime)
+
+	for !waiting.Empty() {
+		job := waiting.Pop().(*api.JobInfo)
+		estimate := job.EstimatedRuntime()
+		if estimate == nil || current.Add(*estimate).After(shadowTime) {
+			continue
+		}
+		backfill.backfillJob(ssn, job)
+	}
+}
+
+// earliestStartTime returns when enough resources are released by the running tasks for the job to be ready.
+// Resources are summed up over the whole cluster, so the start time is a lower bound if nodes are fragmented.
+func earliestStartTime(ssn *framework.Session, job *api.JobInfo, current time.Time) (time.Time, bool) {
+	required := ssn.MissingResources(job)
+
+	available := api.EmptyResource()
+	for _, node := range ssn.Nodes {
+		available.Add(node.FutureIdle())
+	}
+	if required.LessEqual(available, api.Zero) {
+		return current, true
+	}
+
+	for _, r := range api.ExpectedReleases(ssn.Jobs, current) {
+		available.Add(r.Resource)
+		if required.LessEqual(available, api.Zero) {
+			return r.End, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// backfillJob allocates the pending tasks of the job onto idle resources, the allocation is only committed
+// if the job becomes ready. A pending job is only backfilled if it may be enqueued.
+func (backfill *Action) backfillJob(ssn *framework.Session, job *api.JobInfo) {
+	queue := ssn.Queues[job.Queue]
+	phase, pending := job.PodGroup.Status.Phase, job.IsPending()
+	if pending {
+		// The job skips the wait in the queue but not its admission, as enqueue does.
+		if job.PodGroup.Spec.MinResources != nil && !ssn.JobEnqueueable(job) {
+			klog.V(4).Infof("Job <%s/%s> is not enqueueable, skip backfilling it", job.Namespace, job.Name)
+			return
+		}
+		job.PodGroup.Status.Phase = scheduling.PodGroupInqueue
+	}
+
+	tasks := util.NewPriorityQueue(ssn.TaskOrderFn)
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if !task.SchGated {
+			tasks.Push(task)
+		}
+	}
+
+	stmt := framework.NewStatement(ssn)
+	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		if ok, resources := task.InitResreq.LessEqualWithResourcesName(node.Idle, api.Zero); !ok {
+			return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Unschedulable, Reason: api.WrapInsufficientResourceReason(resources)})
+		}
+		return ssn.PredicateForAllocateAction(task, node)
+	}
+	for !tasks.Empty() {
+		task := tasks.Pop().(*api.TaskInfo)
+		if !ssn.Allocatable(queue, task) {
+			break
+		}
+		if err := ssn.PrePredicateFn(task); err != nil {
+			break
+		}
+
+		ph := util.NewPredicateHelper(ssn.DryRun())
+		predicateNodes, fitErrors := ph.PredicateNodes(task, ssn.NodeList, predicateFn, backfill.enablePredicateErrorCache, ssn.NodesInShard)
+		if len(predicateNodes) == 0 {
+			job.NodesFitErrors[task.UID] = fitErrors
+			break
+		}
+		nodeScores := util.PrioritizeNodes(task, predicateNodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn, ssn.DryRun())
+		node := ssn.BestNodeFn(task, nodeScores)
+		if node == nil {
+			node, _ = util.SelectBestNodeAndScore(nodeScores)
+		}
+		if err := stmt.Allocate(task, node); err != nil {
+			klog.Errorf("Failed to backfill Task %v on %v in Session %v: %v", task.UID, node.Name, ssn.UID, err)
+			break
+		}
+	}
+
+	if !ssn.JobReady(job) {
+		stmt.Discard()
+		job.PodGroup.Status.Phase = phase
+		return
+	}
+	klog.V(3).Infof("Backfill job <%s/%s> with running estimate %v ahead of blocked jobs", job.Namespace, job.Name, *job.EstimatedRuntime())
+	if pending {
+		ssn.JobEnqueued(job)
+	}
+	stmt.Commit()
+	if !ssn.DryRun() {
+		metrics.UpdateE2eSchedulingDurationByJob(job.Name, string(job.Queue), job.Namespace, metrics.Duration(job.CreationTimestamp.Time))
+		metrics.UpdateE2eSchedulingLastTimeByJob(job.Name, string(job.Queue), job.Namespace, time.Now())
+	}
+}
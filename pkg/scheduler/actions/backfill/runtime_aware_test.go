@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backfill
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	testingclock "k8s.io/utils/clock/testing"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	pluginutil "volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+	commonutil "volcano.sh/volcano/pkg/util"
+)
+
+func TestRuntimeAwareBackfill(t *testing.T) {
+	current := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	buildPodGroup := func(name string, minMember int32, phase schedulingv1beta1.PodGroupPhase, runningEstimate string) *schedulingv1beta1.PodGroup {
+		pg := util.BuildPodGroupWithMinResources(name, "c1", "q1", minMember, nil, api.BuildResourceList("1", "1Gi"), phase)
+		if runningEstimate != "" {
+			pg.Annotations = map[string]string{commonutil.JobRunningEstimate: runningEstimate}
+		}
+		return pg
+	}
+	buildPods := func() []*v1.Pod {
+		var pods []*v1.Pod
+		// The running job takes 2 of the 3 cpus and is expected to end in 30 minutes.
+		for _, name := range []string{"pg0-0", "pg0-1"} {
+			pod := util.BuildPod("c1", name, "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg0", nil, nil)
+			pod.Status.StartTime = &metav1.Time{Time: current.Add(-30 * time.Minute)}
+			pods = append(pods, pod)
+		}
+		// The gang job needs 2 cpus, it is blocked until the running job ends.
+		pods = append(pods,
+			util.BuildPod("c1", "pg1-0", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil),
+			util.BuildPod("c1", "pg1-1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil),
+			util.BuildPod("c1", "pg2-0", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2", nil, nil),
+			util.BuildPod("c1", "pg3-0", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg3", nil, nil),
+		)
+		return pods
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		enable bool
+		// notEnqueueable are the jobs which may not be enqueued.
+		notEnqueueable []string
+		expectEnqueued []string
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "job finishing before the blocked job can start is backfilled",
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					buildPodGroup("pg0", 2, schedulingv1beta1.PodGroupRunning, "1h"),
+					buildPodGroup("pg1", 2, schedulingv1beta1.PodGroupPending, ""),
+					buildPodGroup("pg2", 1, schedulingv1beta1.PodGroupPending, "2h"),
+					buildPodGroup("pg3", 1, schedulingv1beta1.PodGroupPending, "20m"),
+				},
+				ExpectBindMap: map[string]string{"c1/pg3-0": "n1"},
+			},
+			enable:         true,
+			expectEnqueued: []string{"pg3"},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "job which may not be enqueued is not backfilled",
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					buildPodGroup("pg0", 2, schedulingv1beta1.PodGroupRunning, "1h"),
+					buildPodGroup("pg1", 2, schedulingv1beta1.PodGroupPending, ""),
+					buildPodGroup("pg2", 1, schedulingv1beta1.PodGroupPending, "2h"),
+					buildPodGroup("pg3", 1, schedulingv1beta1.PodGroupPending, "20m"),
+				},
+				ExpectBindMap: map[string]string{},
+			},
+			enable:         true,
+			notEnqueueable: []string{"pg3"},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "job without running estimate is not backfilled",
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					buildPodGroup("pg0", 2, schedulingv1beta1.PodGroupRunning, "1h"),
+					buildPodGroup("pg1", 2, schedulingv1beta1.PodGroupPending, ""),
+					buildPodGroup("pg2", 1, schedulingv1beta1.PodGroupPending, ""),
+					buildPodGroup("pg3", 1, schedulingv1beta1.PodGroupPending, ""),
+				},
+				ExpectBindMap: map[string]string{},
+			},
+			enable: true,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "start of blocked job is unknown if running jobs have no estimate",
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					buildPodGroup("pg0", 2, schedulingv1beta1.PodGroupRunning, ""),
+					buildPodGroup("pg1", 2, schedulingv1beta1.PodGroupPending, ""),
+					buildPodGroup("pg2", 1, schedulingv1beta1.PodGroupPending, "2h"),
+					buildPodGroup("pg3", 1, schedulingv1beta1.PodGroupPending, "20m"),
+				},
+				ExpectBindMap: map[string]string{},
+			},
+			enable: true,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "runtime aware backfill is disabled by default",
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					buildPodGroup("pg0", 2, schedulingv1beta1.PodGroupRunning, "1h"),
+					buildPodGroup("pg1", 2, schedulingv1beta1.PodGroupPending, ""),
+					buildPodGroup("pg2", 1, schedulingv1beta1.PodGroupPending, "2h"),
+					buildPodGroup("pg3", 1, schedulingv1beta1.PodGroupPending, "20m"),
+				},
+				ExpectBindMap: map[string]string{},
+			},
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:             gang.PluginName,
+					EnabledJobReady:  &trueValue,
+					EnabledJobOrder:  &trueValue,
+					EnabledPredicate: &trueValue,
+				},
+				{
+					Name:               admissionPluginName,
+					EnabledJobEnqueued: &trueValue,
+				},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			admission := &admissionPlugin{notEnqueueable: sets.New(test.notEnqueueable...)}
+			test.Plugins = map[string]framework.PluginBuilder{
+				gang.PluginName:     gang.New,
+				admissionPluginName: func(framework.Arguments) framework.Plugin { return admission },
+			}
+			test.Pods = buildPods()
+			test.Nodes = []*v1.Node{util.BuildNode("n1", api.BuildResourceList("3", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)}
+			test.Queues = []*schedulingv1beta1.Queue{util.BuildQueue("q1", 1, nil)}
+			test.ExpectBindsNum = len(test.ExpectBindMap)
+
+			action := New()
+			action.clock = testingclock.NewFakePassiveClock(current)
+			test.RegisterSession(tiers, []conf.Configuration{{Name: action.Name(),
+				Arguments: map[string]interface{}{EnableRuntimeAwareBackfillKey: test.enable}}})
+			defer test.Close()
+			test.Run([]framework.Action{action})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(admission.enqueued, test.expectEnqueued) {
+				t.Errorf("expected enqueued jobs %v, got %v", test.expectEnqueued, admission.enqueued)
+			}
+		})
+	}
+}
+
+const admissionPluginName = "admission"
+
+// admissionPlugin rejects enqueueing the jobs in notEnqueueable and records the enqueued jobs.
+type admissionPlugin struct {
+	notEnqueueable sets.Set[string]
+	enqueued       []string
+}
+
+func (p *admissionPlugin) Name() string { return admissionPluginName }
+
+func (p *admissionPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddJobEnqueueableFn(p.Name(), func(obj interface{}) int {
+		if p.notEnqueueable.Has(obj.(*api.JobInfo).Name) {
+			return pluginutil.Reject
+		}
+		return pluginutil.Permit
+	})
+	ssn.AddJobEnqueuedFn(p.Name(), func(obj interface{}) {
+		p.enqueued = append(p.enqueued, obj.(*api.JobInfo).Name)
+	})
+}
+
+func (p *admissionPlugin) OnSessionClose(*framework.Session) {}
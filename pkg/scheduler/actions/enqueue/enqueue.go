@@ -83,6 +83,11 @@ func (enqueue *Action) Execute(ssn *framework.Session) {
 			break
 		}
 
+		if ssn.ActionTimedOut() {
+			klog.V(3).Infof("Enqueue ran out of its time budget, leave the rest of the jobs to the next session.")
+			break
+		}
+
 		queue := queues.Pop().(*api.QueueInfo)
 
 		// skip the Queue that has no pending job
@@ -33,12 +33,12 @@ import (
 )
 
 func init() {
-	framework.RegisterAction(reclaim.New())
-	framework.RegisterAction(allocate.New())
-	framework.RegisterAction(backfill.New())
-	framework.RegisterAction(preempt.New())
-	framework.RegisterAction(gangpreempt.New())
-	framework.RegisterAction(gangreclaim.New())
-	framework.RegisterAction(enqueue.New())
-	framework.RegisterAction(shuffle.New())
+	framework.RegisterActionBuilder(reclaim.New)
+	framework.RegisterActionBuilder(allocate.New)
+	framework.RegisterActionBuilder(backfill.New)
+	framework.RegisterActionBuilder(preempt.New)
+	framework.RegisterActionBuilder(gangpreempt.New)
+	framework.RegisterActionBuilder(gangreclaim.New)
+	framework.RegisterActionBuilder(enqueue.New)
+	framework.RegisterActionBuilder(shuffle.New)
 }
@@ -153,6 +153,14 @@ func (gp *Action) preemptJobInDomains(ssn *framework.Session, stmt *framework.St
 			continue
 		}
 		domainIdle := utils.SumIdleAndReleasing(domainNodes)
+		evictCtx := &api.EvictionContext{
+			Kind:      api.EvictionKindGangPreempt,
+			Job:       preemptorJob,
+			HyperNode: domain,
+		}
+		if ssn.VictimCostEnabled() {
+			domainBundles = orderBundlesByCost(ssn, evictCtx, domainBundles, jobNeed, domainIdle)
+		}
 		selectedVictims := make([]*api.TaskInfo, 0)
 		for _, bundle := range domainBundles {
 			selectedVictims = append(selectedVictims, bundle.Tasks...)
@@ -168,7 +176,11 @@ func (gp *Action) preemptJobInDomains(ssn *framework.Session, stmt *framework.St
 			if jobHN == nil {
 				jobHN = ssn.HyperNodes[framework.ClusterTopHyperNode]
 			}
-			plan, subJobHyperNodes, ok := utils.BuildNominationPlanInDomain(ssn, queue, preemptorJob, jobHN, attemptVictims, utils.ReasonGangPreempt, gp.enablePredicateErrorCache)
+			reason := utils.ReasonGangPreempt
+			if ssn.VictimCostEnabled() {
+				reason = utils.VictimCostReason(reason, ssn.VictimCost(evictCtx, attemptVictims))
+			}
+			plan, subJobHyperNodes, ok := utils.BuildNominationPlanInDomain(ssn, queue, preemptorJob, jobHN, attemptVictims, reason, gp.enablePredicateErrorCache)
 			if !ok {
 				continue
 			}
@@ -252,3 +264,20 @@ func (gp *Action) selectDomainBundles(ssn *framework.Session, preemptorJob *api.
 	}
 	return selected
 }
+
+// orderBundlesByCost reorders the bundles so that the cheapest bundles which free enough resources for the job
+// in the domain come first, the other bundles follow in the original order.
+func orderBundlesByCost(ssn *framework.Session, evictCtx *api.EvictionContext, bundles []*utils.Bundle, jobNeed, domainIdle *api.Resource) []*utils.Bundle {
+	groups := make([][]*api.TaskInfo, 0, len(bundles))
+	for _, bundle := range bundles {
+		groups = append(groups, bundle.Tasks)
+	}
+	order, _ := utils.OrderVictimsByCost(ssn, evictCtx, groups, jobNeed, func(freed *api.Resource) bool {
+		return jobNeed.LessEqual(domainIdle.Clone().Add(freed), api.Zero)
+	})
+	ordered := make([]*utils.Bundle, 0, len(bundles))
+	for _, i := range order {
+		ordered = append(ordered, bundles[i])
+	}
+	return ordered
+}
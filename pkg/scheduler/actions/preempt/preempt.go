@@ -40,6 +40,7 @@ import (
 
 	fwk "k8s.io/kube-scheduler/framework"
 
+	"volcano.sh/volcano/pkg/scheduler/actions/utils"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
@@ -158,13 +159,18 @@ func (pmpt *Action) Execute(ssn *framework.Session) {
 		}
 	}
 
-	ph := util.NewPredicateHelper()
+	ph := util.NewPredicateHelper(ssn.DryRun())
 	// Preemption between Jobs within Queue.
 	for {
 		if queues.Empty() {
 			break
 		}
 
+		if ssn.ActionTimedOut() {
+			klog.V(3).Infof("Preempt ran out of its time budget, leave the rest of the queues to the next session.")
+			break
+		}
+
 		queue := queues.Pop().(*api.QueueInfo)
 		for {
 			preemptors := preemptorsMap[queue.UID]
@@ -333,7 +339,7 @@ func (pmpt *Action) normalPreempt(
 	filter func(*api.TaskInfo) bool,
 	predicateNodes []*api.NodeInfo,
 ) (bool, error) {
-	nodeScores := util.PrioritizeNodes(preemptor, predicateNodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn)
+	nodeScores := util.PrioritizeNodes(preemptor, predicateNodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn, ssn.DryRun())
 
 	selectedNodes := util.SortNodes(nodeScores)
 
@@ -359,7 +365,9 @@ func (pmpt *Action) normalPreempt(
 			}
 		}
 		victims := ssn.Preemptable(preemptor, preemptees)
-		metrics.UpdatePreemptionVictimsCount(len(victims))
+		if !ssn.DryRun() {
+			metrics.UpdatePreemptionVictimsCount(len(victims))
+		}
 
 		if err := util.ValidateVictims(preemptor, node, victims); err != nil {
 			klog.V(3).Infof("No validated victims on Node <%s>: %v", node.Name, err)
@@ -373,8 +381,12 @@ func (pmpt *Action) normalPreempt(
 		nodeStmt := framework.NewStatement(ssn)
 
 		victimsQueue := ssn.BuildVictimsPriorityQueue(victims, preemptor)
+		if ssn.VictimCostEnabled() {
+			victimsQueue = orderVictimsByCost(ssn, preemptor, node, victimsQueue)
+		}
 		// Preempt victims for tasks, pick lowest priority task first.
 		preempted := api.EmptyResource()
+		var evicted []*api.TaskInfo
 
 		for !victimsQueue.Empty() {
 			// If reclaimed enough resources, break loop to avoid Sub panic.
@@ -395,6 +407,10 @@ func (pmpt *Action) normalPreempt(
 				preemptee.Namespace, preemptee.Name, preemptor.Namespace, preemptor.Name)
 			nodeStmt.Evict(preemptee, "preempt")
 			preempted.Add(preemptee.Resreq)
+			evicted = append(evicted, preemptee)
+		}
+		if ssn.VictimCostEnabled() && len(evicted) != 0 {
+			nodeStmt.SetEvictReason(utils.VictimCostReason("preempt", ssn.VictimCost(victimCostContext(ssn, preemptor), evicted)))
 		}
 
 		evictionOccurred := false
@@ -402,7 +418,9 @@ func (pmpt *Action) normalPreempt(
 			evictionOccurred = true
 		}
 
-		metrics.RegisterPreemptionAttempts()
+		if !ssn.DryRun() {
+			metrics.RegisterPreemptionAttempts()
+		}
 		klog.V(3).Infof("Preempted <%v> for Task <%s/%s> requested <%v>.",
 			preempted, preemptor.Namespace, preemptor.Name, preemptor.InitResreq)
 
@@ -498,6 +516,9 @@ func (pmpt *Action) topologyAwarePreempt(
 	tmpStmt := framework.NewStatement(ssn)
 
 	prepareCandidate(bestCandidate, preemptor.Pod, tmpStmt)
+	if !ssn.DryRun() {
+		metrics.RegisterPreemptionAttempts()
+	}
 	if err := tmpStmt.Pipeline(preemptor, bestCandidate.Name(), true); err != nil {
 		klog.Errorf("Failed to pipeline Task <%s/%s> on Node <%s>",
 			preemptor.Namespace, preemptor.Name, bestCandidate.Name())
@@ -540,10 +561,44 @@ func prepareCandidate(c *candidate, pod *v1.Pod, stmt *framework.Statement) {
 	for _, victim := range c.Victims() {
 		klog.V(3).Infof("Try to preempt Task <%s/%s> for Task <%s/%s>",
 			victim.Namespace, victim.Name, pod.Namespace, pod.Name)
-		stmt.Evict(victim, "preempt")
+		stmt.Evict(victim, utils.VictimCostReason("preempt", c.cost))
+	}
+}
+
+// victimCostContext returns the context the cost of the victims of the preemptor is estimated in.
+func victimCostContext(ssn *framework.Session, preemptor *api.TaskInfo) *api.EvictionContext {
+	return &api.EvictionContext{
+		Kind: api.EvictionKindTaskPreempt,
+		Job:  ssn.Jobs[preemptor.Job],
+		Task: preemptor,
+	}
+}
+
+// orderVictimsByCost reorders the victims so that the cheapest victims which make room for the preemptor on the
+// node are evicted first, the other victims follow in the original order.
+func orderVictimsByCost(ssn *framework.Session, preemptor *api.TaskInfo, node *api.NodeInfo,
+	victimsQueue *util.PriorityQueue) *util.PriorityQueue {
+	var groups [][]*api.TaskInfo
+	for !victimsQueue.Empty() {
+		groups = append(groups, []*api.TaskInfo{victimsQueue.Pop().(*api.TaskInfo)})
 	}
 
-	metrics.RegisterPreemptionAttempts()
+	order, _ := utils.OrderVictimsByCost(ssn, victimCostContext(ssn, preemptor), groups, preemptor.InitResreq,
+		func(freed *api.Resource) bool {
+			return preemptor.InitResreq.LessEqual(node.FutureIdle().Add(freed), api.Zero)
+		})
+
+	rank := make(map[api.TaskID]int, len(order))
+	for r, i := range order {
+		rank[groups[i][0].UID] = r
+	}
+	ordered := util.NewPriorityQueue(func(l, r interface{}) bool {
+		return rank[l.(*api.TaskInfo).UID] < rank[r.(*api.TaskInfo).UID]
+	})
+	for _, i := range order {
+		ordered.Push(groups[i][0])
+	}
+	return ordered
 }
 
 // podTerminatingByPreemption returns true if the pod is in the termination state caused by preempt action.
@@ -637,6 +692,9 @@ func (pmpt *Action) DryRunPreemption(
 				victims: victims,
 				name:    nodeInfoCopy.Name,
 			}
+			if pmpt.ssn.VictimCostEnabled() {
+				c.cost = pmpt.ssn.VictimCost(victimCostContext(pmpt.ssn, preemptor), victims)
+			}
 			candidates.add(c)
 			if candidates.size() >= numCandidates {
 				cancel()
@@ -661,6 +719,8 @@ func (pmpt *Action) DryRunPreemption(
 type candidate struct {
 	victims []*api.TaskInfo
 	name    string
+	// cost is the cost of evicting the victims, nil if no plugin estimates it.
+	cost api.VictimCost
 }
 
 // Victims returns s.victims.
@@ -754,7 +814,9 @@ func SelectVictimsOnNode(
 	klog.V(3).Infof("all preemptees: %v", preemptees)
 
 	allVictims := ssn.Preemptable(preemptor, preemptees)
-	metrics.UpdatePreemptionVictimsCount(len(allVictims))
+	if !ssn.DryRun() {
+		metrics.UpdatePreemptionVictimsCount(len(allVictims))
+	}
 
 	if err := util.ValidateVictims(preemptor, nodeInfo, allVictims); err != nil {
 		klog.V(3).Infof("No validated victims on Node <%s>: %v", nodeInfo.Name, err)
@@ -764,6 +826,9 @@ func SelectVictimsOnNode(
 	klog.V(3).Infof("allVictims: %v", allVictims)
 
 	victimsQueue := ssn.BuildVictimsPriorityQueue(allVictims, preemptor)
+	if ssn.VictimCostEnabled() {
+		victimsQueue = orderVictimsByCost(ssn, preemptor, nodeInfo, victimsQueue)
+	}
 
 	for !victimsQueue.Empty() {
 		task := victimsQueue.Pop().(*api.TaskInfo)
@@ -854,6 +919,10 @@ func SelectCandidate(candidates []*candidate) *candidate {
 	if len(candidates) == 1 {
 		return candidates[0]
 	}
+	// The candidates of the lowest victim cost are preferred if the cost is estimated.
+	if candidates = cheapestCandidates(candidates); len(candidates) == 1 {
+		return candidates[0]
+	}
 
 	victimsMap := CandidatesToVictimsMap(candidates)
 	scoreFuncs := OrderedScoreFuncs(victimsMap)
@@ -861,10 +930,9 @@ func SelectCandidate(candidates []*candidate) *candidate {
 
 	// Same as candidatesToVictimsMap, this logic is not applicable for out-of-tree
 	// preemption plugins that exercise different candidates on the same nominated node.
-	if victims := victimsMap[candidateNode]; victims != nil {
-		return &candidate{
-			victims: victims,
-			name:    candidateNode,
+	for _, c := range candidates {
+		if c.Name() == candidateNode {
+			return c
 		}
 	}
 
@@ -874,6 +942,23 @@ func SelectCandidate(candidates []*candidate) *candidate {
 	return candidates[0]
 }
 
+// cheapestCandidates returns the candidates of the lowest victim cost, all of them if the cost is not estimated.
+func cheapestCandidates(candidates []*candidate) []*candidate {
+	var cheapest []*candidate
+	for _, c := range candidates {
+		if c.cost == nil {
+			return candidates
+		}
+		switch {
+		case len(cheapest) == 0 || c.cost.Total() < cheapest[0].cost.Total():
+			cheapest = []*candidate{c}
+		case c.cost.Total() == cheapest[0].cost.Total():
+			cheapest = append(cheapest, c)
+		}
+	}
+	return cheapest
+}
+
 func CandidatesToVictimsMap(candidates []*candidate) map[string][]*api.TaskInfo {
 	m := make(map[string][]*api.TaskInfo, len(candidates))
 	for _, c := range candidates {
@@ -107,6 +107,11 @@ func (ra *Action) Execute(ssn *framework.Session) {
 			break
 		}
 
+		if ssn.ActionTimedOut() {
+			klog.V(3).Infof("Reclaim ran out of its time budget, leave the rest of the queues to the next session.")
+			break
+		}
+
 		queue := queues.Pop().(*api.QueueInfo)
 		if ssn.Overused(queue) {
 			klog.V(3).Infof("Queue <%s> is overused, ignore it.", queue.Name)
@@ -174,7 +179,7 @@ func (ra *Action) Execute(ssn *framework.Session) {
 
 func (ra *Action) reclaimForTask(ssn *framework.Session, stmt *framework.Statement, task *api.TaskInfo, job *api.JobInfo) {
 	totalNodes := ssn.FilterOutUnschedulableAndUnresolvableNodesForTask(task)
-	predicateHelper := util.NewPredicateHelper()
+	predicateHelper := util.NewPredicateHelper(ssn.DryRun())
 	predicateNodes, _ := predicateHelper.PredicateNodes(task, totalNodes, ssn.PredicateForPreemptAction, ra.enablePredicateErrorCache, ssn.NodesInShard)
 	predicateNodesByShard := util.GetPredicatedNodeByShard(predicateNodes, ssn.NodesInShard)
 	var predicateNodesByShardFlattened []*api.NodeInfo
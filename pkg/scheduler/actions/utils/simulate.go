@@ -238,7 +238,7 @@ func runSimulateTrialAtHyperNode(
 	}()
 
 	tasks := sjWS.tasks.Clone()
-	ph := util.NewPredicateHelper()
+	ph := util.NewPredicateHelper(ssn.DryRun())
 	for !tasks.Empty() {
 		task := tasks.Pop().(*api.TaskInfo)
 		if queue != nil && !ssn.Allocatable(queue, task) {
@@ -355,7 +355,7 @@ func prioritizeNodesForSimulate(ssn *framework.Session, task *api.TaskInfo, pred
 		case len(nodes) == 1:
 			bestNode = nodes[0]
 		default:
-			nodeScores := util.PrioritizeNodes(task, nodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn)
+			nodeScores := util.PrioritizeNodes(task, nodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn, ssn.DryRun())
 			bestNode = ssn.BestNodeFn(task, nodeScores)
 			if bestNode == nil {
 				bestNode, _ = util.SelectBestNodeAndScore(nodeScores)
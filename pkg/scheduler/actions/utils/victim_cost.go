@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"sort"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// OrderVictimsByCost returns the order in which the victim groups are evicted, so that the cheapest set of groups
+// which frees enough resources comes first, and the cost of that set. A group is evicted as a whole, e.g. the
+// tasks of a bundle. The groups are expected in the default victim order, which breaks ties and is kept for the
+// groups not in the set. The cost is nil if no set of groups frees enough resources.
+//
+// Each group is costed once on its own, and the set is built greedily by the lowest cost per resource freed towards
+// need, then the groups which turn out not to be needed are reprieved. The cost shared by groups, e.g. of a gang
+// broken by any of them, is counted for each of them in the ordering, but only once in the cost of the set.
+func OrderVictimsByCost(ssn *framework.Session, ctx *api.EvictionContext, groups [][]*api.TaskInfo,
+	need *api.Resource, fits func(freed *api.Resource) bool) ([]int, api.VictimCost) {
+	order := make([]int, 0, len(groups))
+	inSet := make([]bool, len(groups))
+
+	collect := func(set []int) []*api.TaskInfo {
+		var tasks []*api.TaskInfo
+		for _, i := range set {
+			tasks = append(tasks, groups[i]...)
+		}
+		return tasks
+	}
+
+	ratios := make([]float64, len(groups))
+	candidates := make([]int, 0, len(groups))
+	for i, group := range groups {
+		gain := scoreAgainstNeed(sumTasks(group), need, true)
+		if gain <= 0 {
+			continue
+		}
+		ratios[i] = ssn.VictimCost(ctx, group).Total() / gain
+		candidates = append(candidates, i)
+	}
+	sort.SliceStable(candidates, func(l, r int) bool {
+		return ratios[candidates[l]] < ratios[candidates[r]]
+	})
+
+	var set []int
+	freed := api.EmptyResource()
+	for _, i := range candidates {
+		if fits(freed) {
+			break
+		}
+		set = append(set, i)
+		inSet[i] = true
+		freed.Add(sumTasks(groups[i]))
+	}
+	if !fits(freed) {
+		for i := range groups {
+			order = append(order, i)
+		}
+		return order, nil
+	}
+
+	// A group taken early may not be needed once larger groups are taken, try the latest taken first.
+	for i := len(set) - 1; i >= 0; i-- {
+		rest := append(append([]int(nil), set[:i]...), set[i+1:]...)
+		if fits(sumTasks(collect(rest))) {
+			inSet[set[i]] = false
+			set = rest
+		}
+	}
+
+	order = append(order, set...)
+	for i := range groups {
+		if !inSet[i] {
+			order = append(order, i)
+		}
+	}
+	return order, ssn.VictimCost(ctx, collect(set))
+}
+
+// VictimCostReason returns the eviction reason with the cost of the victims appended, so that the cost
+// breakdown is recorded in the eviction events.
+func VictimCostReason(reason string, cost api.VictimCost) string {
+	if cost == nil {
+		return reason
+	}
+	return fmt.Sprintf("%s, victim cost %v", reason, cost)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+func TestOrderVictimsByCost(t *testing.T) {
+	buildTask := func(name string, milliCPU float64) *api.TaskInfo {
+		return &api.TaskInfo{UID: api.TaskID(name), Name: name, Resreq: &api.Resource{MilliCPU: milliCPU}}
+	}
+
+	tests := []struct {
+		name          string
+		tasks         []*api.TaskInfo
+		costs         map[string]float64
+		need          float64
+		expectedOrder []int
+		expectedCost  float64
+		expectNoFit   bool
+	}{
+		{
+			name:          "cheap small victims are preferred over an expensive large one",
+			tasks:         []*api.TaskInfo{buildTask("large", 2000), buildTask("small1", 1000), buildTask("small2", 1000)},
+			costs:         map[string]float64{"large": 5, "small1": 1, "small2": 1},
+			need:          2000,
+			expectedOrder: []int{1, 2, 0},
+			expectedCost:  2,
+		},
+		{
+			name:          "victim which is not needed is reprieved",
+			tasks:         []*api.TaskInfo{buildTask("small", 1000), buildTask("large", 2000)},
+			costs:         map[string]float64{"small": 0.4, "large": 1},
+			need:          2000,
+			expectedOrder: []int{1, 0},
+			expectedCost:  1,
+		},
+		{
+			name:          "original order is kept if no set fits",
+			tasks:         []*api.TaskInfo{buildTask("small1", 1000), buildTask("small2", 1000)},
+			costs:         map[string]float64{"small1": 2, "small2": 1},
+			need:          3000,
+			expectedOrder: []int{0, 1},
+			expectNoFit:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			enabled := true
+			ssn := &framework.Session{Tiers: []conf.Tier{{Plugins: []conf.PluginOption{{Name: "fake", EnabledVictimCost: &enabled}}}}}
+			calls := 0
+			ssn.AddVictimCostFn("fake", func(_ *api.EvictionContext, victims []*api.TaskInfo) api.VictimCost {
+				calls++
+				cost := api.VictimCost{"fake": 0}
+				for _, victim := range victims {
+					cost["fake"] += test.costs[victim.Name]
+				}
+				return cost
+			})
+
+			groups := make([][]*api.TaskInfo, 0, len(test.tasks))
+			for _, task := range test.tasks {
+				groups = append(groups, []*api.TaskInfo{task})
+			}
+			need := &api.Resource{MilliCPU: test.need}
+			order, cost := OrderVictimsByCost(ssn, &api.EvictionContext{}, groups, need, func(freed *api.Resource) bool {
+				return need.LessEqual(freed, api.Zero)
+			})
+
+			assert.Equal(t, test.expectedOrder, order)
+			// Each victim is costed once, and the set once more.
+			assert.LessOrEqual(t, calls, len(test.tasks)+1)
+			if test.expectNoFit {
+				assert.Nil(t, cost)
+				return
+			}
+			assert.InDelta(t, test.expectedCost, cost.Total(), 1e-9)
+		})
+	}
+}
+
+func TestOrderVictimsByCostDisabled(t *testing.T) {
+	disabled := false
+	ssn := &framework.Session{Tiers: []conf.Tier{{Plugins: []conf.PluginOption{{Name: "fake", EnabledVictimCost: &disabled}}}}}
+	ssn.AddVictimCostFn("fake", func(_ *api.EvictionContext, victims []*api.TaskInfo) api.VictimCost {
+		t.Errorf("the victim cost of a disabled plugin is estimated")
+		return nil
+	})
+	assert.False(t, ssn.VictimCostEnabled())
+	assert.Empty(t, ssn.VictimCost(&api.EvictionContext{}, nil))
+}
+
+func TestVictimCostReason(t *testing.T) {
+	assert.Equal(t, "preempt", VictimCostReason("preempt", nil))
+	assert.Equal(t, "preempt, victim cost 3.50 (gang=1.00, runtime=2.50)",
+		VictimCostReason("preempt", api.VictimCost{"runtime": 2.5, "gang": 1}))
+}
@@ -42,6 +42,9 @@ type ClusterInfo struct {
 	NodeList                  []string
 	CSINodesStatus            map[string]*CSINodeStatusInfo
 	NodesInShard              sets.Set[string]
+	// Generation is the generation of the incremental snapshot the cluster info was taken from, zero if the
+	// incremental snapshot is disabled.
+	Generation uint64
 }
 
 func (ci ClusterInfo) String() string {
@@ -45,6 +45,7 @@ import (
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	"volcano.sh/apis/pkg/apis/scheduling"
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	commonutil "volcano.sh/volcano/pkg/util"
 )
 
 type subJobCondition func(*SubJobInfo) bool
@@ -76,6 +77,10 @@ func (db *DisruptionBudget) Clone() *DisruptionBudget {
 // when job waits longer than waiting time, it should enqueue at once, and cluster should reserve resources for it
 const JobWaitingTime = "sla-waiting-time"
 
+// JobElastic is the job and podgroup annotation which marks a job as elastic when it is "true": the job runs with any
+// number of replicas between its minAvailable and its replicas, and grows and shrinks in the order of the task ranks.
+const JobElastic = "volcano.sh/elastic"
+
 // TaskID is UID type for Task
 type TaskID types.UID
 
@@ -410,6 +415,11 @@ type JobInfo struct {
 	MinAvailable int32
 
 	WaitingTime *time.Duration
+	// RunningEstimate is the estimated running duration of the job, nil if it is unknown.
+	RunningEstimate *time.Duration
+	// PredictedRuntime is the running duration of the job predicted from the history of similar jobs,
+	// nil if it is unknown.
+	PredictedRuntime *time.Duration
 
 	JobFitErrors   string
 	NodesFitErrors map[TaskID]*FitErrors
@@ -509,6 +519,13 @@ func (ji *JobInfo) SetPodGroup(pg *PodGroup) {
 		}
 	}
 
+	ji.RunningEstimate, err = ji.extractRunningEstimate(pg)
+	if err != nil {
+		klog.Warningf("Error occurs in parsing running estimate for job <%s/%s>, err: %s.",
+			pg.Namespace, pg.Name, err.Error())
+		ji.RunningEstimate = nil
+	}
+
 	ji.Preemptable = ji.extractPreemptable(pg)
 	ji.RevocableZone = ji.extractRevocableZone(pg)
 	ji.Budget = ji.extractBudget(pg)
@@ -556,6 +573,25 @@ func (ji *JobInfo) extractWaitingTime(pg *PodGroup, waitingTimeKey string) (*tim
 	return &jobWaitingTime, nil
 }
 
+// extractRunningEstimate reads the estimated running duration of the job from podgroup annotations
+func (ji *JobInfo) extractRunningEstimate(pg *PodGroup) (*time.Duration, error) {
+	value, exist := pg.Annotations[commonutil.JobRunningEstimate]
+	if !exist {
+		return nil, nil
+	}
+
+	estimate, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if estimate <= 0 {
+		return nil, errors.New("invalid running estimate")
+	}
+
+	return &estimate, nil
+}
+
 // extractPreemptable return volcano.sh/preemptable value for job
 func (ji *JobInfo) extractPreemptable(pg *PodGroup) bool {
 	// check annotation first
@@ -753,6 +789,15 @@ func (ji *JobInfo) DeleteTaskInfo(ti *TaskInfo) {
 	klog.Warningf("failed to find task <%v/%v> in job <%v/%v>", ti.Namespace, ti.Name, ji.Namespace, ji.Name)
 }
 
+// EstimatedRuntime returns the running duration declared by the job, or the predicted one if the job
+// declares none; nil if both are unknown.
+func (ji *JobInfo) EstimatedRuntime() *time.Duration {
+	if ji.RunningEstimate != nil {
+		return ji.RunningEstimate
+	}
+	return ji.PredictedRuntime
+}
+
 // Clone is used to clone a jobInfo object
 func (ji *JobInfo) Clone() *JobInfo {
 	info := &JobInfo{
@@ -763,12 +808,14 @@ func (ji *JobInfo) Clone() *JobInfo {
 		Queue:     ji.Queue,
 		Priority:  ji.Priority,
 
-		MinAvailable:   ji.MinAvailable,
-		WaitingTime:    ji.WaitingTime,
-		JobFitErrors:   ji.JobFitErrors,
-		NodesFitErrors: make(map[TaskID]*FitErrors),
-		Allocated:      EmptyResource(),
-		TotalRequest:   EmptyResource(),
+		MinAvailable:     ji.MinAvailable,
+		WaitingTime:      ji.WaitingTime,
+		RunningEstimate:  ji.RunningEstimate,
+		PredictedRuntime: ji.PredictedRuntime,
+		JobFitErrors:     ji.JobFitErrors,
+		NodesFitErrors:   make(map[TaskID]*FitErrors),
+		Allocated:        EmptyResource(),
+		TotalRequest:     EmptyResource(),
 
 		PodGroup: func() *PodGroup {
 			if ji.PodGroup != nil {
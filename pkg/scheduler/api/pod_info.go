@@ -25,6 +25,7 @@ package api
 import (
 	"encoding/json"
 	"strconv"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -220,6 +221,34 @@ func GetPodRevocableZone(pod *v1.Pod) string {
 	return ""
 }
 
+// GetPodCheckpointBeforeEviction return whether the pod opted in to checkpoint before eviction
+func GetPodCheckpointBeforeEviction(pod *v1.Pod) bool {
+	value, found := pod.Annotations[v1beta1.PodCheckpointBeforeEviction]
+	if !found {
+		return false
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		klog.Warningf("invalid %s=%s", v1beta1.PodCheckpointBeforeEviction, value)
+		return false
+	}
+	return b
+}
+
+// GetPodLastCheckpointTime return the time the pod last completed a checkpoint, false if it is unknown
+func GetPodLastCheckpointTime(pod *v1.Pod) (time.Time, bool) {
+	value, found := pod.Annotations[v1beta1.PodLastCheckpointTime]
+	if !found {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		klog.Warningf("invalid %s=%s", v1beta1.PodLastCheckpointTime, value)
+		return time.Time{}, false
+	}
+	return t, true
+}
+
 // GetPodTopologyInfo return volcano.sh/numa-topology-policy value for pod
 func GetPodTopologyInfo(pod *v1.Pod) *TopologyInfo {
 	info := TopologyInfo{
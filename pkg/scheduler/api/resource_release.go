@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"sort"
+	"time"
+)
+
+// ResourceRelease is the resource a running task is expected to release when it ends.
+type ResourceRelease struct {
+	End      time.Time
+	Resource *Resource
+}
+
+// ExpectedReleases returns the resources released by the running tasks of the jobs whose running duration
+// is known, ordered by their expected end. Tasks which overran their estimate are expected to end now.
+func ExpectedReleases(jobs map[JobID]*JobInfo, now time.Time) []ResourceRelease {
+	var releases []ResourceRelease
+	for _, job := range jobs {
+		estimate := job.EstimatedRuntime()
+		if estimate == nil {
+			continue
+		}
+		for _, task := range job.Tasks {
+			if task.Status != Running && task.Status != Bound && task.Status != Binding {
+				continue
+			}
+			start := now
+			if task.Pod != nil && task.Pod.Status.StartTime != nil {
+				start = task.Pod.Status.StartTime.Time
+			}
+			end := start.Add(*estimate)
+			if end.Before(now) {
+				end = now
+			}
+			releases = append(releases, ResourceRelease{End: end, Resource: task.Resreq})
+		}
+	}
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].End.Before(releases[j].End)
+	})
+	return releases
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// runtimeHistorySize is the number of the most recent runtimes kept for each key.
+	runtimeHistorySize = 20
+	// runtimeHistoryMinSamples is the number of runtimes needed before a prediction is made.
+	runtimeHistoryMinSamples = 3
+	// runtimeHistoryPercentile is the percentile of the kept runtimes used as prediction, a high percentile
+	// keeps the prediction on the safe side for backfilling.
+	runtimeHistoryPercentile = 0.9
+	// runtimeHistorySyncPeriod is the period the runtime history is persisted.
+	runtimeHistorySyncPeriod = time.Minute
+)
+
+// RuntimePredictor predicts the running duration of jobs.
+type RuntimePredictor interface {
+	// Run restores the model of the predictor and keeps it persisted until stopCh is closed.
+	Run(stopCh <-chan struct{})
+	// Observe learns the running duration of a completed job.
+	Observe(key RuntimeKey, duration time.Duration)
+	// Predict returns the running duration of the job, nil if it is unknown.
+	Predict(job *JobInfo) *time.Duration
+}
+
+// RuntimeKey identifies similar jobs whose running durations are expected to be close.
+// Volcano jobs carry no user identity, so the namespace stands for the user.
+type RuntimeKey struct {
+	Queue string `json:"queue"`
+	User  string `json:"user"`
+	// Image is the sorted, comma separated list of the container images of the job.
+	Image string `json:"image"`
+}
+
+// NewRuntimeKey returns the runtime key of a job from the pod specs of its tasks.
+func NewRuntimeKey(queue, namespace string, specs []*v1.PodSpec) RuntimeKey {
+	images := sets.New[string]()
+	for _, spec := range specs {
+		for _, c := range spec.Containers {
+			images.Insert(c.Image)
+		}
+	}
+	return RuntimeKey{Queue: queue, User: namespace, Image: strings.Join(sets.List(images), ",")}
+}
+
+// RuntimeKeyOfJob returns the runtime key of the job.
+func RuntimeKeyOfJob(job *JobInfo) RuntimeKey {
+	var specs []*v1.PodSpec
+	for _, task := range job.Tasks {
+		if task.Pod != nil {
+			specs = append(specs, &task.Pod.Spec)
+		}
+	}
+	return NewRuntimeKey(string(job.Queue), job.Namespace, specs)
+}
+
+// runtimeRecord is the persisted runtime history of a key.
+type runtimeRecord struct {
+	RuntimeKey
+	// Seconds are the most recent running durations in seconds, the oldest first.
+	Seconds []int64 `json:"seconds"`
+}
+
+// HistoryRuntimePredictor predicts the running duration of a job by a high percentile of the most recent
+// running durations of completed jobs with the same runtime key. The history is kept in a ConfigMap so that
+// it survives scheduler restarts. Each scheduler observes only the jobs it schedules, so the history of each one
+// is kept under its own data key of the shared ConfigMap, and no scheduler overwrites the history of another.
+type HistoryRuntimePredictor struct {
+	sync.RWMutex
+
+	kubeClient kubernetes.Interface
+	namespace  string
+	name       string
+	// dataKey is the ConfigMap data key which holds the runtime history of the scheduler.
+	dataKey string
+
+	history map[RuntimeKey][]int64
+	dirty   bool
+}
+
+// NewHistoryRuntimePredictor returns a predictor which persists its history under the data key dataKey of the
+// ConfigMap namespace/name.
+func NewHistoryRuntimePredictor(kubeClient kubernetes.Interface, namespace, name, dataKey string) *HistoryRuntimePredictor {
+	return &HistoryRuntimePredictor{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		name:       name,
+		dataKey:    dataKey,
+		history:    map[RuntimeKey][]int64{},
+	}
+}
+
+// Run restores the history from the ConfigMap and persists it periodically.
+func (p *HistoryRuntimePredictor) Run(stopCh <-chan struct{}) {
+	if err := p.Load(context.TODO()); err != nil {
+		klog.Errorf("Failed to load runtime history from ConfigMap %s/%s: %v", p.namespace, p.name, err)
+	}
+	go wait.Until(func() {
+		if err := p.Save(context.TODO()); err != nil {
+			klog.Errorf("Failed to save runtime history to ConfigMap %s/%s: %v", p.namespace, p.name, err)
+		}
+	}, runtimeHistorySyncPeriod, stopCh)
+}
+
+// Observe records the running duration of a completed job.
+func (p *HistoryRuntimePredictor) Observe(key RuntimeKey, duration time.Duration) {
+	if duration <= 0 {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	seconds := append(p.history[key], int64(duration.Round(time.Second)/time.Second))
+	if len(seconds) > runtimeHistorySize {
+		seconds = seconds[len(seconds)-runtimeHistorySize:]
+	}
+	p.history[key] = seconds
+	p.dirty = true
+}
+
+// Predict returns the predicted running duration of the job, nil if there are not enough similar jobs.
+func (p *HistoryRuntimePredictor) Predict(job *JobInfo) *time.Duration {
+	key := RuntimeKeyOfJob(job)
+
+	p.RLock()
+	seconds := slices.Clone(p.history[key])
+	p.RUnlock()
+
+	if len(seconds) < runtimeHistoryMinSamples {
+		return nil
+	}
+	slices.Sort(seconds)
+	index := int(float64(len(seconds)-1) * runtimeHistoryPercentile)
+	predicted := time.Duration(seconds[index]) * time.Second
+	return &predicted
+}
+
+// Load replaces the history by the one persisted in the ConfigMap, a missing ConfigMap or data key is an empty
+// history.
+func (p *HistoryRuntimePredictor) Load(ctx context.Context) error {
+	cm, err := p.kubeClient.CoreV1().ConfigMaps(p.namespace).Get(ctx, p.name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	var records []runtimeRecord
+	if data := cm.Data[p.dataKey]; data != "" {
+		if err := json.Unmarshal([]byte(data), &records); err != nil {
+			return err
+		}
+	}
+
+	history := make(map[RuntimeKey][]int64, len(records))
+	for _, r := range records {
+		history[r.RuntimeKey] = r.Seconds
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.history = history
+	p.dirty = false
+	klog.V(3).Infof("Loaded runtime history of %d keys from ConfigMap %s/%s", len(history), p.namespace, p.name)
+	return nil
+}
+
+// Save persists the history into the ConfigMap if it changed since the last save. The data keys of the other
+// schedulers are kept, a conflicting update is retried on the next sync.
+func (p *HistoryRuntimePredictor) Save(ctx context.Context) error {
+	p.Lock()
+	if !p.dirty {
+		p.Unlock()
+		return nil
+	}
+	records := make([]runtimeRecord, 0, len(p.history))
+	for key, seconds := range p.history {
+		records = append(records, runtimeRecord{RuntimeKey: key, Seconds: slices.Clone(seconds)})
+	}
+	p.dirty = false
+	p.Unlock()
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Queue != records[j].Queue {
+			return records[i].Queue < records[j].Queue
+		}
+		if records[i].User != records[j].User {
+			return records[i].User < records[j].User
+		}
+		return records[i].Image < records[j].Image
+	})
+	data, err := json.Marshal(records)
+	if err == nil {
+		err = p.writeConfigMap(ctx, string(data))
+	}
+	if err != nil {
+		// Retry on the next sync.
+		p.Lock()
+		p.dirty = true
+		p.Unlock()
+	}
+	return err
+}
+
+func (p *HistoryRuntimePredictor) writeConfigMap(ctx context.Context, data string) error {
+	client := p.kubeClient.CoreV1().ConfigMaps(p.namespace)
+	cm, err := client.Get(ctx, p.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		cm = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: p.namespace, Name: p.name},
+			Data:       map[string]string{p.dataKey: data},
+		}
+		_, err = client.Create(ctx, cm, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	cm = cm.DeepCopy()
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[p.dataKey] = data
+	_, err = client.Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func buildRuntimeJob(queue string, images ...string) *JobInfo {
+	job := NewJobInfo("ns1/job1")
+	job.Queue = QueueID(queue)
+	job.Namespace = "ns1"
+	for i, image := range images {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: fmt.Sprintf("p%d", i), UID: types.UID(fmt.Sprintf("p%d", i))},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "c", Image: image}}},
+		}
+		job.AddTaskInfo(NewTaskInfo(pod))
+	}
+	return job
+}
+
+func TestRuntimeKeyOfJob(t *testing.T) {
+	job := buildRuntimeJob("q1", "worker:v1", "ps:v1", "worker:v1")
+	specs := []*v1.PodSpec{
+		{Containers: []v1.Container{{Image: "ps:v1"}}},
+		{Containers: []v1.Container{{Image: "worker:v1"}}},
+	}
+	expected := RuntimeKey{Queue: "q1", User: "ns1", Image: "ps:v1,worker:v1"}
+	assert.Equal(t, expected, RuntimeKeyOfJob(job))
+	assert.Equal(t, expected, NewRuntimeKey("q1", "ns1", specs))
+}
+
+func TestHistoryRuntimePredictor(t *testing.T) {
+	job := buildRuntimeJob("q1", "worker:v1")
+	key := RuntimeKeyOfJob(job)
+	p := NewHistoryRuntimePredictor(fake.NewSimpleClientset(), "volcano-system", "runtime-history", "volcano")
+
+	p.Observe(key, 10*time.Minute)
+	p.Observe(key, 20*time.Minute)
+	assert.Nil(t, p.Predict(job), "expected no prediction before enough samples")
+
+	p.Observe(key, 30*time.Minute)
+	p.Observe(RuntimeKey{Queue: "q2", User: "ns1", Image: "worker:v1"}, 5*time.Hour)
+	if predicted := p.Predict(job); assert.NotNil(t, predicted) {
+		assert.Equal(t, 20*time.Minute, *predicted)
+	}
+
+	// Only the most recent runtimes are kept.
+	for i := 0; i < runtimeHistorySize; i++ {
+		p.Observe(key, time.Hour)
+	}
+	if predicted := p.Predict(job); assert.NotNil(t, predicted) {
+		assert.Equal(t, time.Hour, *predicted)
+	}
+}
+
+func TestHistoryRuntimePredictorPersistence(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	job := buildRuntimeJob("q1", "worker:v1")
+	key := RuntimeKeyOfJob(job)
+
+	p := NewHistoryRuntimePredictor(client, "volcano-system", "runtime-history", "volcano")
+	assert.NoError(t, p.Load(context.TODO()), "a missing ConfigMap is an empty history")
+	for _, d := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute} {
+		p.Observe(key, d)
+	}
+	// Saved twice to cover both creating and updating the ConfigMap.
+	assert.NoError(t, p.Save(context.TODO()))
+	p.Observe(key, 4*time.Minute)
+	assert.NoError(t, p.Save(context.TODO()))
+
+	restored := NewHistoryRuntimePredictor(client, "volcano-system", "runtime-history", "volcano")
+	assert.NoError(t, restored.Load(context.TODO()))
+	assert.Equal(t, p.Predict(job), restored.Predict(job))
+	assert.Equal(t, []int64{60, 120, 180, 240}, restored.history[key])
+}
+
+func TestHistoryRuntimePredictorSharedConfigMap(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	job := buildRuntimeJob("q1", "worker:v1")
+	key := RuntimeKeyOfJob(job)
+
+	// Two schedulers persist their own histories in the same ConfigMap.
+	p1 := NewHistoryRuntimePredictor(client, "volcano-system", "runtime-history", "scheduler-0")
+	p2 := NewHistoryRuntimePredictor(client, "volcano-system", "runtime-history", "scheduler-1")
+	p1.Observe(key, time.Minute)
+	assert.NoError(t, p1.Save(context.TODO()))
+	p2.Observe(key, time.Hour)
+	assert.NoError(t, p2.Save(context.TODO()))
+
+	for dataKey, expected := range map[string][]int64{"scheduler-0": {60}, "scheduler-1": {3600}} {
+		restored := NewHistoryRuntimePredictor(client, "volcano-system", "runtime-history", dataKey)
+		assert.NoError(t, restored.Load(context.TODO()))
+		assert.Equal(t, expected, restored.history[key], "history of %s", dataKey)
+	}
+}
@@ -24,6 +24,8 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
 
 	fwk "k8s.io/kube-scheduler/framework"
@@ -348,6 +350,43 @@ type EvictionContext struct {
 // Plugins use EvictionContext.Kind to branch between gang and legacy modes.
 type UnifiedEvictableFn func(ctx *EvictionContext, candidates []*TaskInfo) ([]*TaskInfo, int)
 
+// VictimCost is the cost of evicting a set of victims, broken down by the factors it is made of,
+// e.g. the work lost since the victims started.
+type VictimCost map[string]float64
+
+// Add adds the factors of other to the cost.
+func (c VictimCost) Add(other VictimCost) {
+	for factor, value := range other {
+		c[factor] += value
+	}
+}
+
+// Total returns the sum of all factors of the cost.
+func (c VictimCost) Total() float64 {
+	total := 0.0
+	for _, value := range c {
+		total += value
+	}
+	return total
+}
+
+// String returns the total cost followed by its factors sorted by name, e.g. "12.50 (gang=10.00, runtime=2.50)".
+func (c VictimCost) String() string {
+	factors := make([]string, 0, len(c))
+	for factor := range c {
+		factors = append(factors, factor)
+	}
+	sort.Strings(factors)
+	for i, factor := range factors {
+		factors[i] = fmt.Sprintf("%s=%.2f", factor, c[factor])
+	}
+	return fmt.Sprintf("%.2f (%s)", c.Total(), strings.Join(factors, ", "))
+}
+
+// VictimCostFn is the func declaration used to estimate the cost of evicting the victims together,
+// the victim set of the lowest cost is preferred.
+type VictimCostFn func(ctx *EvictionContext, victims []*TaskInfo) VictimCost
+
 // NodeOrderFn is the func declaration used to get priority score for a node for a particular task.
 type NodeOrderFn func(*TaskInfo, *NodeInfo) (float64, error)
 
@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -73,6 +74,7 @@ import (
 	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/features"
 	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/decisionlog"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
 	"volcano.sh/volcano/pkg/scheduler/metrics/source"
 	schedulercache "volcano.sh/volcano/pkg/schedulercommon/cache"
@@ -198,6 +200,25 @@ type SchedulerCache struct {
 	resourceSyncTimeout time.Duration
 	// resourceClaimCache is a cache for ResourceClaims, used for DRA
 	resourceClaimCache *assumecache.AssumeCache
+
+	// runtimePredictor learns the running duration of completed jobs, nil if it is disabled.
+	runtimePredictor schedulingapi.RuntimePredictor
+	// decisionLog writes the binding decisions of the bound tasks, nil if it is disabled.
+	decisionLog *decisionlog.Log
+
+	// incremental is the copy of the cache the snapshots apply the changes to, nil if incremental snapshot is disabled.
+	incremental *incrementalSnapshot
+	// sessionTrigger is signalled when a job with a priority of at least sessionTriggerPriority arrives, nil if
+	// session trigger is disabled.
+	sessionTrigger         chan struct{}
+	sessionTriggerPriority int32
+
+	// waitingCheckpoints are the tasks whose pods are asked to checkpoint before they are evicted, they are kept
+	// in Releasing until their pods are deleted.
+	waitingCheckpoints sets.Set[schedulingapi.TaskID]
+
+	// dryRun is true if the cache only backs simulated sessions, its events must not update the scheduler metrics.
+	dryRun bool
 }
 
 type multiSchedulerInfo struct {
@@ -218,6 +239,10 @@ type BindContext struct {
 	TaskInfo *schedulingapi.TaskInfo
 	// Extensions stores extra bind context information of each plugin
 	Extensions map[string]BindContextExtension
+	// SessionID is the uid of the session which allocated the task.
+	SessionID types.UID
+	// NodeScores are the highest scores of the nodes for the task, only kept if the decision log is enabled.
+	NodeScores []decisionlog.NodeScore
 }
 
 // DefaultBinder with kube client and event recorder
@@ -264,10 +289,25 @@ func NewDefaultBinder(kbclient kubernetes.Interface, record record.EventRecorder
 type defaultEvictor struct {
 	kubeclient kubernetes.Interface
 	recorder   record.EventRecorder
+	// checkpointTimeout is the maximum time to wait for pods which opted in to checkpoint before eviction,
+	// zero disables the checkpoint request.
+	checkpointTimeout time.Duration
+	// checkpoints is told about the pods waiting for their checkpoint before they are deleted, may be nil.
+	checkpoints checkpointTracker
 }
 
-// Evict will send delete pod request to api server
+// Evict will send delete pod request to api server, pods which opted in to checkpoint before eviction are
+// deleted later, once they checkpointed.
 func (de *defaultEvictor) Evict(p *v1.Pod, reason string) error {
+	if de.checkpointTimeout > 0 && schedulingapi.GetPodCheckpointBeforeEviction(p) {
+		if timeout := checkpointTimeout(p, de.checkpointTimeout); timeout > 0 {
+			return de.evictAfterCheckpoint(p, reason, timeout)
+		}
+	}
+	return de.evict(p, reason)
+}
+
+func (de *defaultEvictor) evict(p *v1.Pod, reason string) error {
 	klog.V(3).Infof("Evicting pod %v/%v, because of %v", p.Namespace, p.Name, reason)
 
 	evictMsg := fmt.Sprintf("Pod is evicted, because of %v", reason)
@@ -599,11 +639,17 @@ func newSchedulerCache(config *rest.Config, schedulerNames []string, defaultQueu
 		bindMethodMap = NewDefaultBinder(sc.kubeClient, sc.Recorder)
 	}
 	sc.Binder = GetBindMethod()
+	sc.setDecisionLog()
 
-	sc.Evictor = &defaultEvictor{
-		kubeclient: sc.kubeClient,
-		recorder:   sc.Recorder,
+	evictor := &defaultEvictor{
+		kubeclient:  sc.kubeClient,
+		recorder:    sc.Recorder,
+		checkpoints: sc,
+	}
+	if options.ServerOpts != nil {
+		evictor.checkpointTimeout = options.ServerOpts.CheckpointEvictionTimeout
 	}
+	sc.Evictor = evictor
 
 	sc.StatusUpdater = &defaultStatusUpdater{
 		kubeclient: sc.kubeClient,
@@ -616,6 +662,7 @@ func newSchedulerCache(config *rest.Config, schedulerNames []string, defaultQueu
 	}
 
 	sc.binderRegistry = NewBinderRegistry()
+	sc.setIncrementalScheduling()
 
 	// add all events handlers
 	sc.addEventHandler()
@@ -818,6 +865,25 @@ func (sc *SchedulerCache) addEventHandler() {
 	sc.hyperNodesInitialEventTracker = schedulercache.NewQueueHandlerTracker(handlerRegistration)
 	handlers["hypernode"] = schedulercache.NewInitialEventHandlerRegistration(handlerRegistration, sc.hyperNodesInitialEventTracker)
 
+	if options.ServerOpts != nil && options.ServerOpts.EnableRuntimePredictor {
+		namespace, name, err := cache.SplitMetaNamespaceKey(options.ServerOpts.RuntimeHistoryConfigMap)
+		if err != nil || namespace == "" || name == "" {
+			klog.Errorf("Invalid runtime history ConfigMap %q, runtime predictor is disabled", options.ServerOpts.RuntimeHistoryConfigMap)
+		} else {
+			// Schedulers with other names may share the ConfigMap, the history is kept under the names of this one,
+			// or under the replica in the multi-scheduler mode, where each replica observes the jobs it schedules.
+			dataKey := strings.Join(sc.schedulerNames, "_")
+			if sc.c != nil {
+				dataKey = sc.schedulerPodName
+			}
+			sc.runtimePredictor = schedulingapi.NewHistoryRuntimePredictor(sc.kubeClient, namespace, name, dataKey)
+			handlerRegistration, _ = sc.vcInformerFactory.Batch().V1alpha1().Jobs().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+				UpdateFunc: sc.UpdateJobV1alpha1,
+			})
+			handlers["job"] = handlerRegistration
+		}
+	}
+
 	if options.ServerOpts.ShardingMode == util.HardShardingMode || options.ServerOpts.ShardingMode == util.SoftShardingMode {
 		sc.nodeShardInformer = sc.vcInformerFactory.Shard().V1alpha1().NodeShards()
 		handlerRegistration, _ = sc.nodeShardInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -855,6 +921,11 @@ func (sc *SchedulerCache) addEventHandler() {
 
 // Run  starts the schedulerCache
 func (sc *SchedulerCache) Run(stopCh <-chan struct{}) {
+	if sc.runtimePredictor != nil {
+		// Restore the runtime history before the jobs completed meanwhile are observed. The cache runs only in the
+		// leader once leader election is enabled, so the standby replicas don't write the history.
+		sc.runtimePredictor.Run(stopCh)
+	}
 	sc.informerFactory.Start(stopCh)
 	sc.vcInformerFactory.Start(stopCh)
 	sc.WaitForCacheSync(stopCh)
@@ -962,6 +1033,7 @@ func (sc *SchedulerCache) Evict(taskInfo *schedulingapi.TaskInfo, reason string)
 		return err
 	}
 
+	sc.markTaskChanged(task)
 	job.UpdateTaskStatus(task, schedulingapi.Releasing)
 
 	// Add new task to node.
@@ -997,6 +1069,7 @@ func (sc *SchedulerCache) Bind(ctx context.Context, bindContexts []*BindContext,
 	for _, bindContext := range bindContexts {
 		if reason, ok := errMsg[bindContext.TaskInfo.UID]; !ok {
 			sc.Recorder.Eventf(bindContext.TaskInfo.Pod, v1.EventTypeNormal, "Scheduled", "Successfully assigned %v/%v to %v", bindContext.TaskInfo.Namespace, bindContext.TaskInfo.Name, bindContext.TaskInfo.NodeName)
+			sc.decisionLog.Bound(bindContext.TaskInfo.Pod, bindContext.TaskInfo.NodeName, bindContext.SessionID, bindContext.NodeScores)
 		} else {
 			unschedulableMsg := fmt.Sprintf("failed to bind to node %s: %s", bindContext.TaskInfo.NodeName, reason)
 			if err := sc.taskUnschedulable(bindContext.TaskInfo, schedulingapi.PodReasonSchedulerError, unschedulableMsg, ""); err != nil {
@@ -1015,6 +1088,23 @@ func (sc *SchedulerCache) Bind(ctx context.Context, bindContexts []*BindContext,
 	}
 }
 
+// setDecisionLog sets the log of the binding decisions if a sink is configured.
+func (sc *SchedulerCache) setDecisionLog() {
+	if options.ServerOpts == nil || options.ServerOpts.DecisionLogSink == "" {
+		return
+	}
+	sink, err := decisionlog.NewSink(options.ServerOpts.DecisionLogSink, options.ServerOpts.DecisionLogFile, sc.Recorder)
+	if err != nil {
+		klog.Errorf("Failed to create the sink of the decision log, decision log is disabled: %v", err)
+		return
+	}
+	var shard string
+	if options.ServerOpts.ShardingMode == util.HardShardingMode || options.ServerOpts.ShardingMode == util.SoftShardingMode {
+		shard = options.ServerOpts.ShardName
+	}
+	sc.decisionLog = decisionlog.NewLog(util.GenerateComponentName(sc.schedulerNames), shard, options.ServerOpts.DecisionLogTopNodes, sink)
+}
+
 // BindPodGroup binds job to silo cluster
 func (sc *SchedulerCache) BindPodGroup(job *schedulingapi.JobInfo, cluster string) error {
 	if _, err := sc.PodGroupBinder.Bind(job, cluster); err != nil {
@@ -1065,6 +1155,7 @@ func (sc *SchedulerCache) AddUnassignedNumaPods(allocatedSets map[schedulingapi.
 				node.UnassignedNumaPods = make(map[schedulingapi.PodMeta]schedulingapi.ResNumaSets)
 			}
 			node.UnassignedNumaPods[podMeta] = resSets
+			sc.markNodeChanged(nodeName)
 			klog.V(3).Infof("added pod %v with resourceSet %v to the unassigned numa pods of node %s", podMeta, resSets, nodeName)
 		}
 	}
@@ -1164,7 +1255,10 @@ func (sc *SchedulerCache) processCleanupJob() {
 		klog.V(5).Infof("Just add pguid:%v, try to delete pguid:%v", newPgVersion, oldPgVersion)
 		if oldPgVersion == newPgVersion {
 			delete(sc.Jobs, currJob.UID)
-			metrics.DeleteJobMetrics(currJob.Name, string(currJob.Queue), currJob.Namespace)
+			sc.markJobChanged(currJob.UID)
+			if !sc.dryRun {
+				metrics.DeleteJobMetrics(currJob.Name, string(currJob.Queue), currJob.Namespace)
+			}
 			klog.V(3).Infof("Job <%v:%v/%v> was deleted.", currJob.UID, currJob.Namespace, currJob.Name)
 		}
 		sc.DeletedJobs.Forget(jobKey)
@@ -1357,6 +1451,8 @@ func (sc *SchedulerCache) AddBindTask(bindContext *BindContext) error {
 	}
 
 	originalStatus := task.Status
+	sc.markJobChanged(job.UID)
+	sc.markNodeChanged(node.Name)
 	job.UpdateTaskStatus(task, schedulingapi.Binding)
 
 	err = bindContext.TaskInfo.SetPodResourceDecision()
@@ -1423,6 +1519,10 @@ func (sc *SchedulerCache) executePreBind(ctx context.Context, bindContext *BindC
 			}
 			return err
 		}
+		if sc.dryRun {
+			executedPreBinders = append(executedPreBinders, preBinder)
+			continue
+		}
 		metrics.UpdateSchedulingStageDuration(metrics.SchedulingStagePreBind, time.Since(start))
 		if bindContext.TaskInfo != nil && bindContext.TaskInfo.Pod != nil {
 			metrics.UpdateTaskScheduleDuration(metrics.TaskStagePreBound, metrics.Duration(bindContext.TaskInfo.Pod.CreationTimestamp.Time))
@@ -1479,34 +1579,18 @@ func (sc *SchedulerCache) BindTask() {
 
 // Snapshot returns the complete snapshot of the cluster from cache
 func (sc *SchedulerCache) Snapshot() *schedulingapi.ClusterInfo {
+	if sc.incremental != nil {
+		return sc.incrementalSnapshotOf()
+	}
+
 	sc.Mutex.Lock()
 	defer sc.Mutex.Unlock()
 
-	snapshot := &schedulingapi.ClusterInfo{
-		Nodes:                make(map[string]*schedulingapi.NodeInfo),
-		HyperNodes:           make(map[string]*schedulingapi.HyperNodeInfo),
-		HyperNodesSetByTier:  make(map[int]sets.Set[string]),
-		HyperNodeTierNameMap: make(schedulingapi.HyperNodeTierNameMap),
-		RealNodesSet:         make(map[string]sets.Set[string]),
-		Jobs:                 make(map[schedulingapi.JobID]*schedulingapi.JobInfo),
-		Queues:               make(map[schedulingapi.QueueID]*schedulingapi.QueueInfo),
-		NamespaceInfo:        make(map[schedulingapi.NamespaceName]*schedulingapi.NamespaceInfo),
-		RevocableNodes:       make(map[string]*schedulingapi.NodeInfo),
-		NodeList:             make([]string, len(sc.NodeList)),
-		CSINodesStatus:       make(map[string]*schedulingapi.CSINodeStatusInfo),
-		NodesInShard:         sets.Set[string]{},
-	}
-
-	copy(snapshot.NodeList, sc.NodeList)
-	snapshot.NodesInShard = sc.InUseNodesInShard.Clone()
+	snapshot := sc.newSnapshot()
 	for _, value := range sc.Nodes {
 		value.RefreshNumaSchedulerInfoByCrd()
 	}
 
-	for _, value := range sc.CSINodesStatus {
-		snapshot.CSINodesStatus[value.CSINodeName] = value.Clone()
-	}
-
 	for _, value := range sc.Nodes {
 		if !value.Ready() {
 			continue
@@ -1519,15 +1603,6 @@ func (sc *SchedulerCache) Snapshot() *schedulingapi.ClusterInfo {
 		}
 	}
 
-	// Snapshot hyperNodes.
-	sc.HyperNodesInfo.Lock()
-	snapshot.HyperNodes = sc.HyperNodesInfo.HyperNodes()
-	snapshot.HyperNodesSetByTier = sc.HyperNodesInfo.HyperNodesSetByTier()
-	snapshot.HyperNodeTierNameMap = sc.HyperNodesInfo.HyperNodeTierNameMap()
-	snapshot.RealNodesSet = sc.HyperNodesInfo.RealNodesSet()
-	snapshot.HyperNodesReadyToSchedule = sc.HyperNodesInfo.Ready()
-	sc.HyperNodesInfo.Unlock()
-
 	for _, value := range sc.Queues {
 		snapshot.Queues[value.UID] = value.Clone()
 	}
@@ -1537,30 +1612,18 @@ func (sc *SchedulerCache) Snapshot() *schedulingapi.ClusterInfo {
 
 	cloneJob := func(value *schedulingapi.JobInfo) {
 		defer wg.Done()
-		if value.PodGroup != nil {
-			value.Priority = sc.defaultPriority
-
-			priName := value.PodGroup.Spec.PriorityClassName
-			if priorityClass, found := sc.PriorityClasses[priName]; found {
-				value.Priority = priorityClass.Value
-			}
-
-			klog.V(4).Infof("The priority of job <%s/%s> is <%s/%d>",
-				value.Namespace, value.Name, priName, value.Priority)
-		}
+		sc.setJobPriority(value)
 
 		clonedJob := value.Clone()
+		if sc.runtimePredictor != nil {
+			clonedJob.PredictedRuntime = sc.runtimePredictor.Predict(clonedJob)
+		}
 
 		cloneJobLock.Lock()
 		snapshot.Jobs[value.UID] = clonedJob
 		cloneJobLock.Unlock()
 	}
 
-	for _, value := range sc.NamespaceCollection {
-		info := value.Snapshot()
-		snapshot.NamespaceInfo[info.Name] = info
-	}
-
 	for _, value := range sc.Jobs {
 		// If no scheduling spec, does not handle it.
 		if value.PodGroup == nil {
@@ -1583,12 +1646,71 @@ func (sc *SchedulerCache) Snapshot() *schedulingapi.ClusterInfo {
 
 	klog.V(3).InfoS("SnapShot for scheduling", "jobNum", len(snapshot.Jobs), "QueueNum",
 		len(snapshot.Queues), "NodeNum", len(snapshot.Nodes))
+	return snapshot
+}
+
+// newSnapshot returns a snapshot of the cache without jobs, nodes and queues. Assumes that lock is already acquired.
+func (sc *SchedulerCache) newSnapshot() *schedulingapi.ClusterInfo {
+	snapshot := &schedulingapi.ClusterInfo{
+		Nodes:                make(map[string]*schedulingapi.NodeInfo),
+		HyperNodes:           make(map[string]*schedulingapi.HyperNodeInfo),
+		HyperNodesSetByTier:  make(map[int]sets.Set[string]),
+		HyperNodeTierNameMap: make(schedulingapi.HyperNodeTierNameMap),
+		RealNodesSet:         make(map[string]sets.Set[string]),
+		Jobs:                 make(map[schedulingapi.JobID]*schedulingapi.JobInfo),
+		Queues:               make(map[schedulingapi.QueueID]*schedulingapi.QueueInfo),
+		NamespaceInfo:        make(map[schedulingapi.NamespaceName]*schedulingapi.NamespaceInfo),
+		RevocableNodes:       make(map[string]*schedulingapi.NodeInfo),
+		NodeList:             make([]string, len(sc.NodeList)),
+		CSINodesStatus:       make(map[string]*schedulingapi.CSINodeStatusInfo),
+		NodesInShard:         sets.Set[string]{},
+	}
+
+	copy(snapshot.NodeList, sc.NodeList)
+	snapshot.NodesInShard = sc.InUseNodesInShard.Clone()
+
+	for _, value := range sc.CSINodesStatus {
+		snapshot.CSINodesStatus[value.CSINodeName] = value.Clone()
+	}
+
+	// Snapshot hyperNodes.
+	sc.HyperNodesInfo.Lock()
+	snapshot.HyperNodes = sc.HyperNodesInfo.HyperNodes()
+	snapshot.HyperNodesSetByTier = sc.HyperNodesInfo.HyperNodesSetByTier()
+	snapshot.HyperNodeTierNameMap = sc.HyperNodesInfo.HyperNodeTierNameMap()
+	snapshot.RealNodesSet = sc.HyperNodesInfo.RealNodesSet()
+	snapshot.HyperNodesReadyToSchedule = sc.HyperNodesInfo.Ready()
+	sc.HyperNodesInfo.Unlock()
+
+	for _, value := range sc.NamespaceCollection {
+		info := value.Snapshot()
+		snapshot.NamespaceInfo[info.Name] = info
+	}
+
 	if klog.V(4).Enabled() {
 		klog.InfoS("HyperNode snapShot for scheduling", "tiers", snapshot.HyperNodesSetByTier, "realNodesSet", snapshot.RealNodesSet, "hyperNodesReadyToSchedule", snapshot.HyperNodesReadyToSchedule)
 	}
 	return snapshot
 }
 
+// jobPriority returns the priority of the job by its priority class. Assumes that lock is already acquired.
+func (sc *SchedulerCache) jobPriority(job *schedulingapi.JobInfo) int32 {
+	if priorityClass, found := sc.PriorityClasses[job.PodGroup.Spec.PriorityClassName]; found {
+		return priorityClass.Value
+	}
+	return sc.defaultPriority
+}
+
+// setJobPriority sets the priority of the job by its priority class. Assumes that lock is already acquired.
+func (sc *SchedulerCache) setJobPriority(job *schedulingapi.JobInfo) {
+	if job.PodGroup == nil {
+		return
+	}
+	job.Priority = sc.jobPriority(job)
+	klog.V(4).Infof("The priority of job <%s/%s> is <%s/%d>",
+		job.Namespace, job.Name, job.PodGroup.Spec.PriorityClassName, job.Priority)
+}
+
 func (sc *SchedulerCache) SharedDRAManager() fwk.SharedDRAManager {
 	return sc.sharedDRAManager
 }
@@ -1730,6 +1852,7 @@ func (sc *SchedulerCache) updateJobAnnotations(job *schedulingapi.JobInfo) {
 			jobInCache.PodGroup.SetAnnotations(map[string]string{})
 		}
 		jobInCache.PodGroup.GetAnnotations()[schedulingapi.JobAllocatedHyperNode] = job.PodGroup.GetAnnotations()[schedulingapi.JobAllocatedHyperNode]
+		sc.markJobChanged(job.UID)
 	}
 }
 
@@ -1739,6 +1862,7 @@ func (sc *SchedulerCache) updateJobInfo(job *schedulingapi.JobInfo) {
 
 	if jobInCache, ok := sc.Jobs[job.UID]; ok {
 		jobInCache.AllocatedHyperNode = job.AllocatedHyperNode
+		sc.markJobChanged(job.UID)
 		for subJobID, subJobInCache := range jobInCache.SubJobs {
 			if subJob, found := job.SubJobs[subJobID]; found {
 				subJobInCache.AllocatedHyperNode = subJob.AllocatedHyperNode
@@ -1765,6 +1889,11 @@ func (sc *SchedulerCache) recordPodGroupEvent(podGroup *schedulingapi.PodGroup,
 	sc.Recorder.Eventf(pg, eventType, reason, "%s", msg)
 }
 
+// SetDryRun keeps the events of a cache which only backs simulated sessions out of the scheduler metrics.
+func (sc *SchedulerCache) SetDryRun(dryRun bool) {
+	sc.dryRun = dryRun
+}
+
 func (sc *SchedulerCache) SetMetricsConf(conf map[string]string) {
 	sc.metricsConf = conf
 }
@@ -1825,6 +1954,7 @@ func (sc *SchedulerCache) setMetricsData(usageInfo map[string]*source.NodeMetric
 		}
 		klog.V(5).Infof("node: %s, ResourceUsage: %+v => %+v", nodeName, *nodeInfo.ResourceUsage, nodeUsage)
 		nodeInfo.ResourceUsage = nodeUsage
+		sc.markNodeChanged(nodeName)
 	}
 }
 
@@ -149,6 +149,7 @@ func newMockSchedulerCache(schedulerName string) *SchedulerCache {
 		msc.updateNodeSelectors(options.ServerOpts.NodeSelector)
 	}
 	msc.setBatchBindParallel()
+	msc.setIncrementalScheduling()
 	msc.nodeWorkers = getNodeWorkers()
 	msc.initMockInformers()
 
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// checkpointPollInterval is the interval the pod is checked for the acknowledgement of the checkpoint.
+var checkpointPollInterval = 2 * time.Second
+
+// checkpointTimeout returns how long to wait for the pod to checkpoint before it is evicted, the timeout
+// requested by the pod is bounded by maxTimeout.
+func checkpointTimeout(pod *v1.Pod, maxTimeout time.Duration) time.Duration {
+	value, found := pod.Annotations[v1beta1.PodCheckpointTimeout]
+	if !found {
+		return maxTimeout
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		klog.Warningf("invalid %s=%s", v1beta1.PodCheckpointTimeout, value)
+		return maxTimeout
+	}
+	return min(timeout, maxTimeout)
+}
+
+// checkpointTracker is told about the pods which wait for their checkpoint before they are deleted.
+type checkpointTracker interface {
+	// checkpointRequested is called before the checkpoint of the pod is requested.
+	checkpointRequested(pod *v1.Pod)
+	// checkpointEvicted is called once the pod is deleted after its checkpoint, or failed to be.
+	checkpointEvicted(pod *v1.Pod, err error)
+}
+
+// evictAfterCheckpoint requests a checkpoint of the pod and returns, the pod is deleted from a goroutine once it
+// acknowledges the checkpoint, it is gone, or the timeout expires. If the checkpoint can not be requested, the pod
+// is deleted at once.
+func (de *defaultEvictor) evictAfterCheckpoint(p *v1.Pod, reason string, timeout time.Duration) error {
+	if de.checkpoints != nil {
+		de.checkpoints.checkpointRequested(p)
+	}
+	requested, err := requestCheckpoint(context.TODO(), de.kubeclient, p, timeout)
+	if err != nil {
+		klog.Errorf("Failed to request checkpoint of pod <%s/%s> before eviction: %v", p.Namespace, p.Name, err)
+		if de.checkpoints != nil {
+			de.checkpoints.checkpointEvicted(p, nil)
+		}
+		return de.evict(p, reason)
+	}
+
+	de.recorder.Eventf(p, v1.EventTypeNormal, "CheckpointRequested", "Checkpoint requested before eviction, because of %v", reason)
+	go func() {
+		waitForCheckpoint(context.TODO(), de.kubeclient, p, requested, timeout)
+		err := de.evict(p, reason)
+		if de.checkpoints != nil {
+			de.checkpoints.checkpointEvicted(p, err)
+		}
+	}()
+	return nil
+}
+
+// waitForCheckpoint waits until the pod acknowledges the checkpoint requested at the given time, the pod is gone,
+// or the timeout expires. The pod is evicted afterwards in any case, so errors are only logged.
+func waitForCheckpoint(ctx context.Context, kubeClient kubernetes.Interface, pod *v1.Pod, requested time.Time, timeout time.Duration) {
+	remaining := time.Until(requested.Add(timeout))
+	if remaining <= 0 {
+		return
+	}
+	err := wait.PollUntilContextTimeout(ctx, checkpointPollInterval, remaining, true, func(ctx context.Context) (bool, error) {
+		current, err := kubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) || (err == nil && current.UID != pod.UID) {
+			return true, nil
+		}
+		if err != nil {
+			klog.V(4).Infof("Failed to get pod <%s/%s> waiting for checkpoint: %v", pod.Namespace, pod.Name, err)
+			return false, nil
+		}
+		// The workload may acknowledge with a time in seconds.
+		checkpointed, found := schedulingapi.GetPodLastCheckpointTime(current)
+		return found && !checkpointed.Before(requested.Truncate(time.Second)), nil
+	})
+	if err != nil {
+		klog.Warningf("Pod <%s/%s> did not acknowledge the checkpoint requested at %v within %v, evict it anyway",
+			pod.Namespace, pod.Name, requested.Format(time.RFC3339), timeout)
+		return
+	}
+	klog.V(3).Infof("Pod <%s/%s> checkpointed before eviction", pod.Namespace, pod.Name)
+}
+
+// requestCheckpoint annotates the pod with the time the checkpoint is requested and returns it. A request still
+// pending from an earlier eviction attempt is reused, so that retries do not extend the wait.
+func requestCheckpoint(ctx context.Context, kubeClient kubernetes.Interface, pod *v1.Pod, timeout time.Duration) (time.Time, error) {
+	now := time.Now().UTC()
+	if value, found := pod.Annotations[v1beta1.PodCheckpointRequested]; found {
+		if requested, err := time.Parse(time.RFC3339, value); err == nil && now.Before(requested.Add(timeout)) {
+			return requested, nil
+		}
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{v1beta1.PodCheckpointRequested: now.Format(time.RFC3339Nano)},
+		},
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if _, err := kubeClient.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return time.Time{}, err
+	}
+	klog.V(3).Infof("Requested checkpoint of pod <%s/%s> before eviction, wait up to %v", pod.Namespace, pod.Name, timeout)
+	return now, nil
+}
+
+// checkpointRequested keeps the task of the pod in Releasing while the pod waits for its checkpoint, as the
+// request updates the pod.
+func (sc *SchedulerCache) checkpointRequested(pod *v1.Pod) {
+	sc.Mutex.Lock()
+	defer sc.Mutex.Unlock()
+	if sc.waitingCheckpoints == nil {
+		sc.waitingCheckpoints = sets.New[schedulingapi.TaskID]()
+	}
+	sc.waitingCheckpoints.Insert(schedulingapi.TaskID(pod.UID))
+}
+
+// checkpointEvicted stops keeping the task of the pod in Releasing, the task is resynced if the pod failed to be
+// deleted.
+func (sc *SchedulerCache) checkpointEvicted(pod *v1.Pod, err error) {
+	sc.Mutex.Lock()
+	defer sc.Mutex.Unlock()
+	sc.waitingCheckpoints.Delete(schedulingapi.TaskID(pod.UID))
+	if err != nil {
+		sc.resyncTask(schedulingapi.NewTaskInfo(pod))
+	}
+}
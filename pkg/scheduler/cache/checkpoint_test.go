@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func buildCheckpointPod(annotations map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns1",
+			Name:        "p1",
+			UID:         "p1",
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestCheckpointTimeout(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    time.Duration
+	}{
+		{
+			name:     "no timeout on pod",
+			expected: time.Minute,
+		},
+		{
+			name:        "shorter timeout on pod",
+			annotations: map[string]string{v1beta1.PodCheckpointTimeout: "10s"},
+			expected:    10 * time.Second,
+		},
+		{
+			name:        "longer timeout on pod is bounded",
+			annotations: map[string]string{v1beta1.PodCheckpointTimeout: "1h"},
+			expected:    time.Minute,
+		},
+		{
+			name:        "invalid timeout on pod",
+			annotations: map[string]string{v1beta1.PodCheckpointTimeout: "soon"},
+			expected:    time.Minute,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := checkpointTimeout(buildCheckpointPod(test.annotations), time.Minute); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+// fakeCheckpointTracker records the pods waiting for their checkpoint and signals their eviction.
+type fakeCheckpointTracker struct {
+	requested chan *v1.Pod
+	evicted   chan error
+}
+
+func newFakeCheckpointTracker() *fakeCheckpointTracker {
+	return &fakeCheckpointTracker{requested: make(chan *v1.Pod, 1), evicted: make(chan error, 1)}
+}
+
+func (t *fakeCheckpointTracker) checkpointRequested(pod *v1.Pod) {
+	t.requested <- pod
+}
+
+func (t *fakeCheckpointTracker) checkpointEvicted(_ *v1.Pod, err error) {
+	t.evicted <- err
+}
+
+func TestEvictWaitsForCheckpoint(t *testing.T) {
+	checkpointPollInterval = 10 * time.Millisecond
+	defer func() { checkpointPollInterval = 2 * time.Second }()
+
+	waitEvicted := func(t *testing.T, tracker *fakeCheckpointTracker) {
+		select {
+		case err := <-tracker.evicted:
+			if err != nil {
+				t.Fatalf("failed to evict pod: %v", err)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("expected pod to be evicted after its checkpoint")
+		}
+	}
+
+	t.Run("acknowledged checkpoint", func(t *testing.T) {
+		pod := buildCheckpointPod(map[string]string{v1beta1.PodCheckpointBeforeEviction: "true"})
+		kubeClient := kubefake.NewSimpleClientset(pod)
+		tracker := newFakeCheckpointTracker()
+		evictor := &defaultEvictor{kubeclient: kubeClient, recorder: record.NewFakeRecorder(10), checkpointTimeout: time.Minute, checkpoints: tracker}
+
+		// The workload acknowledges the request as soon as it sees it.
+		go func() {
+			for {
+				current, err := kubeClient.CoreV1().Pods("ns1").Get(context.TODO(), "p1", metav1.GetOptions{})
+				if err != nil {
+					return
+				}
+				if requested, found := current.Annotations[v1beta1.PodCheckpointRequested]; found {
+					current.Annotations[v1beta1.PodLastCheckpointTime] = requested
+					kubeClient.CoreV1().Pods("ns1").Update(context.TODO(), current, metav1.UpdateOptions{})
+					return
+				}
+				time.Sleep(checkpointPollInterval)
+			}
+		}()
+
+		if err := evictor.Evict(pod, "preempt"); err != nil {
+			t.Fatalf("failed to evict pod: %v", err)
+		}
+		if len(tracker.requested) != 1 {
+			t.Errorf("expected the pod to be tracked before its checkpoint is requested")
+		}
+		waitEvicted(t, tracker)
+		if _, err := kubeClient.CoreV1().Pods("ns1").Get(context.TODO(), "p1", metav1.GetOptions{}); err == nil {
+			t.Errorf("expected pod to be evicted")
+		}
+	})
+
+	t.Run("unacknowledged checkpoint times out", func(t *testing.T) {
+		pod := buildCheckpointPod(map[string]string{
+			v1beta1.PodCheckpointBeforeEviction: "true",
+			v1beta1.PodCheckpointTimeout:        "1s",
+		})
+		kubeClient := kubefake.NewSimpleClientset(pod)
+		tracker := newFakeCheckpointTracker()
+		evictor := &defaultEvictor{kubeclient: kubeClient, recorder: record.NewFakeRecorder(10), checkpointTimeout: time.Minute, checkpoints: tracker}
+
+		// Evict does not wait for the checkpoint, the pod is still there with the request.
+		if err := evictor.Evict(pod, "preempt"); err != nil {
+			t.Fatalf("failed to evict pod: %v", err)
+		}
+		current, err := kubeClient.CoreV1().Pods("ns1").Get(context.TODO(), "p1", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("expected pod to wait for its checkpoint: %v", err)
+		}
+		if _, found := current.Annotations[v1beta1.PodCheckpointRequested]; !found {
+			t.Errorf("expected checkpoint to be requested on pod, got annotations %v", current.Annotations)
+		}
+
+		waitEvicted(t, tracker)
+		if _, err := kubeClient.CoreV1().Pods("ns1").Get(context.TODO(), "p1", metav1.GetOptions{}); err == nil {
+			t.Errorf("expected pod to be evicted after the timeout")
+		}
+	})
+
+	t.Run("pod not opted in is evicted at once", func(t *testing.T) {
+		pod := buildCheckpointPod(nil)
+		kubeClient := kubefake.NewSimpleClientset(pod)
+		tracker := newFakeCheckpointTracker()
+		evictor := &defaultEvictor{kubeclient: kubeClient, recorder: record.NewFakeRecorder(10), checkpointTimeout: time.Minute, checkpoints: tracker}
+
+		if err := evictor.Evict(pod, "preempt"); err != nil {
+			t.Fatalf("failed to evict pod: %v", err)
+		}
+		if len(tracker.requested) != 0 {
+			t.Errorf("expected the pod not to wait for a checkpoint")
+		}
+		if _, err := kubeClient.CoreV1().Pods("ns1").Get(context.TODO(), "p1", metav1.GetOptions{}); err == nil {
+			t.Errorf("expected pod to be evicted")
+		}
+	})
+}
+
+func TestWaitingCheckpointKeepsTaskReleasing(t *testing.T) {
+	sc := &SchedulerCache{
+		Jobs:  map[schedulingapi.JobID]*schedulingapi.JobInfo{},
+		Nodes: map[string]*schedulingapi.NodeInfo{},
+	}
+	pod := util.BuildPod("ns1", "p1", "n1", v1.PodRunning, schedulingapi.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+
+	sc.checkpointRequested(pod)
+	// The pod is updated with the checkpoint request.
+	if err := sc.addTask(schedulingapi.NewTaskInfo(pod)); err != nil {
+		t.Fatalf("failed to add task: %v", err)
+	}
+	task := sc.Jobs["ns1/pg1"].Tasks[schedulingapi.TaskID(pod.UID)]
+	if task.Status != schedulingapi.Releasing {
+		t.Errorf("expected task waiting for its checkpoint to be Releasing, got %v", task.Status)
+	}
+
+	sc.checkpointEvicted(pod, nil)
+	if sc.waitingCheckpoints.Has(task.UID) {
+		t.Errorf("expected task to stop waiting for its checkpoint once evicted")
+	}
+}
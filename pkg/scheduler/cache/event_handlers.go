@@ -30,6 +30,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
@@ -47,6 +48,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager/topology"
 	"k8s.io/utils/cpuset"
 
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	nodeinfov1alpha1 "volcano.sh/apis/pkg/apis/nodeinfo/v1alpha1"
 	"volcano.sh/apis/pkg/apis/scheduling"
 	"volcano.sh/apis/pkg/apis/scheduling/scheme"
@@ -226,6 +228,11 @@ func (sc *SchedulerCache) getCSIDriverInfoFromSC(pvc *v1.PersistentVolumeClaim)
 }
 
 func (sc *SchedulerCache) addTask(pi *schedulingapi.TaskInfo) error {
+	sc.markTaskChanged(pi)
+	// The pod is updated when its checkpoint is requested, it is still being evicted.
+	if pi.Status == schedulingapi.Running && sc.waitingCheckpoints.Has(pi.UID) {
+		pi.Status = schedulingapi.Releasing
+	}
 	if len(pi.NodeName) != 0 {
 		if _, found := sc.Nodes[pi.NodeName]; !found {
 			sc.Nodes[pi.NodeName] = schedulingapi.NewNodeInfo(nil)
@@ -377,6 +384,7 @@ func (sc *SchedulerCache) clearUnassignedNumaTask(ti *schedulingapi.TaskInfo) {
 		if node != nil {
 			podMeta := schedulingapi.PodMeta{UID: ti.Pod.UID, Name: ti.Pod.Name, Namespace: ti.Pod.Namespace}
 			if resSets, ok := node.UnassignedNumaPods[podMeta]; ok {
+				sc.markNodeChanged(node.Name)
 				delete(node.UnassignedNumaPods, podMeta)
 				klog.V(3).Infof("deleted unassigned pod %v with resourceSet %v on node %s", podMeta, resSets, node.Name)
 			}
@@ -385,6 +393,7 @@ func (sc *SchedulerCache) clearUnassignedNumaTask(ti *schedulingapi.TaskInfo) {
 }
 
 func (sc *SchedulerCache) deleteTask(ti *schedulingapi.TaskInfo) error {
+	sc.markTaskChanged(ti)
 	if len(ti.Job) != 0 {
 		if job, found := sc.Jobs[ti.Job]; found {
 			job.DeleteTaskInfo(ti)
@@ -466,7 +475,10 @@ func (sc *SchedulerCache) AddPod(obj interface{}) {
 		return
 	}
 	if pod.Spec.NodeName == "" {
-		metrics.UpdateTaskScheduleDuration(metrics.TaskStageWatched, metrics.Duration(pod.CreationTimestamp.Time))
+		if !sc.dryRun {
+			metrics.UpdateTaskScheduleDuration(metrics.TaskStageWatched, metrics.Duration(pod.CreationTimestamp.Time))
+		}
+		sc.triggerSessionForPod(pod)
 	}
 	klog.V(3).Infof("Added pod <%s/%v> into cache.", pod.Namespace, pod.Name)
 }
@@ -573,6 +585,7 @@ func (sc *SchedulerCache) AddOrUpdateNode(node *v1.Node) error {
 	sc.Mutex.Lock()
 	defer sc.Mutex.Unlock()
 
+	sc.markNodeChanged(node.Name)
 	if sc.Nodes[node.Name] != nil {
 		sc.Nodes[node.Name].SetNode(node)
 		sc.removeNodeImageStates(node.Name)
@@ -611,6 +624,7 @@ func (sc *SchedulerCache) RemoveNode(nodeName string) error {
 		return fmt.Errorf("node <%s> does not exist", nodeName)
 	}
 
+	sc.markNodeChanged(nodeName)
 	numaInfo := sc.Nodes[nodeName].NumaInfo
 	if numaInfo != nil {
 		klog.V(3).Infof("delete numatopo <%s/%s>", numaInfo.Namespace, numaInfo.Name)
@@ -860,6 +874,7 @@ func getJobID(pg *schedulingapi.PodGroup) schedulingapi.JobID {
 // Assumes that lock is already acquired.
 func (sc *SchedulerCache) setPodGroup(ss *schedulingapi.PodGroup) error {
 	job := getJobID(ss)
+	sc.markJobChanged(job)
 	if _, found := sc.Jobs[job]; !found {
 		sc.Jobs[job] = schedulingapi.NewJobInfo(job)
 	}
@@ -871,8 +886,10 @@ func (sc *SchedulerCache) setPodGroup(ss *schedulingapi.PodGroup) error {
 		sc.Jobs[job].Queue = schedulingapi.QueueID(sc.defaultQueue)
 	}
 
-	metrics.UpdateE2eSchedulingStartTimeByJob(sc.Jobs[job].Name, string(sc.Jobs[job].Queue), sc.Jobs[job].Namespace,
-		sc.Jobs[job].CreationTimestamp.Time)
+	if !sc.dryRun {
+		metrics.UpdateE2eSchedulingStartTimeByJob(sc.Jobs[job].Name, string(sc.Jobs[job].Queue), sc.Jobs[job].Namespace,
+			sc.Jobs[job].CreationTimestamp.Time)
+	}
 	return nil
 }
 
@@ -890,6 +907,7 @@ func (sc *SchedulerCache) deletePodGroup(id schedulingapi.JobID) error {
 
 	// Unset SchedulingSpec
 	job.UnsetPodGroup()
+	sc.markJobChanged(id)
 
 	sc.deleteJob(job)
 
@@ -922,6 +940,7 @@ func (sc *SchedulerCache) AddPodGroupV1beta1(obj interface{}) {
 		klog.Errorf("Failed to add PodGroup %s into cache: %v", ss.Name, err)
 		return
 	}
+	sc.triggerSession(sc.Jobs[getJobID(pg)])
 }
 
 // UpdatePodGroupV1beta1 add podgroup to scheduler cache
@@ -1061,9 +1080,65 @@ func (sc *SchedulerCache) DeleteQueueV1beta1(obj interface{}) {
 	sc.deleteQueue(schedulingapi.QueueID(ss.Name))
 }
 
+// UpdateJobV1alpha1 feeds the running duration of the completed Volcano Jobs to the runtime predictor
+func (sc *SchedulerCache) UpdateJobV1alpha1(oldObj, newObj interface{}) {
+	oldJob, ok := oldObj.(*batch.Job)
+	if !ok {
+		klog.Errorf("Cannot convert oldObj to *batch.Job: %v", oldObj)
+		return
+	}
+	newJob, ok := newObj.(*batch.Job)
+	if !ok {
+		klog.Errorf("Cannot convert newObj to *batch.Job: %v", newObj)
+		return
+	}
+
+	// Only the transition is observed, the jobs completed before the informer synced are in the history already
+	// or were completed while the scheduler was down.
+	if newJob.Status.State.Phase != batch.Completed || oldJob.Status.State.Phase == batch.Completed ||
+		!responsibleForJob(newJob, sc.schedulerNames, sc.schedulerPodName, sc.c) {
+		return
+	}
+	duration, found := runningDuration(newJob)
+	if !found {
+		return
+	}
+
+	specs := make([]*v1.PodSpec, 0, len(newJob.Spec.Tasks))
+	for i := range newJob.Spec.Tasks {
+		specs = append(specs, &newJob.Spec.Tasks[i].Template.Spec)
+	}
+	key := schedulingapi.NewRuntimeKey(newJob.Spec.Queue, newJob.Namespace, specs)
+	klog.V(4).Infof("Job <%s/%s> completed in %v, observed as %+v", newJob.Namespace, newJob.Name, duration, key)
+	sc.runtimePredictor.Observe(key, duration)
+}
+
+// runningDuration returns the time the completed job ran, from its last transition to Running until it completed.
+// The RunningDuration of the job status is not used, since it counts from the creation of the job, and so includes
+// the time the job waited to be scheduled and the time of its restarts.
+func runningDuration(job *batch.Job) (time.Duration, bool) {
+	var started, completed *metav1.Time
+	for _, cond := range job.Status.Conditions {
+		switch cond.Status {
+		case batch.Running:
+			started = cond.LastTransitionTime
+		case batch.Completing, batch.Completed:
+			// The job completed once its tasks succeeded, the Completing phase only cleans up the rest of its pods.
+			if completed == nil || (cond.LastTransitionTime != nil && cond.LastTransitionTime.Before(completed)) {
+				completed = cond.LastTransitionTime
+			}
+		}
+	}
+	if started == nil || completed == nil || !started.Before(completed) {
+		return 0, false
+	}
+	return completed.Sub(started.Time), true
+}
+
 func (sc *SchedulerCache) addQueue(queue *scheduling.Queue) {
 	qi := schedulingapi.NewQueueInfo(queue)
 	sc.Queues[qi.UID] = qi
+	sc.markQueueChanged(qi.UID)
 }
 
 func (sc *SchedulerCache) updateQueue(queue *scheduling.Queue) {
@@ -1073,7 +1148,10 @@ func (sc *SchedulerCache) updateQueue(queue *scheduling.Queue) {
 func (sc *SchedulerCache) deleteQueue(id schedulingapi.QueueID) {
 	if queue, ok := sc.Queues[id]; ok {
 		delete(sc.Queues, id)
-		metrics.DeleteQueueMetrics(queue.Name)
+		sc.markQueueChanged(id)
+		if !sc.dryRun {
+			metrics.DeleteQueueMetrics(queue.Name)
+		}
 	}
 }
 
@@ -1144,6 +1222,7 @@ func (sc *SchedulerCache) deletePriorityClass(pc *schedulingv1.PriorityClass) {
 	}
 
 	delete(sc.PriorityClasses, pc.Name)
+	sc.markAllJobsChanged()
 }
 
 func (sc *SchedulerCache) addPriorityClass(pc *schedulingv1.PriorityClass) {
@@ -1157,6 +1236,7 @@ func (sc *SchedulerCache) addPriorityClass(pc *schedulingv1.PriorityClass) {
 	}
 
 	sc.PriorityClasses[pc.Name] = pc
+	sc.markAllJobsChanged()
 }
 
 func (sc *SchedulerCache) updateResourceQuota(quota *v1.ResourceQuota) {
@@ -1297,6 +1377,7 @@ func (sc *SchedulerCache) addNumaInfo(info *nodeinfov1alpha1.Numatopology) error
 	}
 
 	sc.Nodes[info.Name].NumaInfo = getNumaInfo(info)
+	sc.markNodeChanged(info.Name)
 	for resName, NumaResInfo := range sc.Nodes[info.Name].NumaInfo.NumaResMap {
 		klog.V(3).Infof("resource %s Allocatable %v on node[%s] into cache", resName, NumaResInfo, info.Name)
 	}
@@ -1309,6 +1390,7 @@ func (sc *SchedulerCache) addNumaInfo(info *nodeinfov1alpha1.Numatopology) error
 func (sc *SchedulerCache) deleteNumaInfo(info *nodeinfov1alpha1.Numatopology) {
 	if sc.Nodes[info.Name] != nil {
 		sc.Nodes[info.Name].NumaInfo = nil
+		sc.markNodeChanged(info.Name)
 		klog.V(3).Infof("delete numainfo in cache for node<%s>", info.Name)
 	}
 }
@@ -1376,6 +1458,7 @@ func (sc *SchedulerCache) AddJob(obj interface{}) {
 	sc.Mutex.Lock()
 	defer sc.Mutex.Unlock()
 	sc.Jobs[job.UID] = job
+	sc.markJobChanged(job.UID)
 }
 
 // csiAttachLimitResourceName returns the node ResourceName used for per-CSI-driver volume attach limits.
@@ -21,6 +21,7 @@ import (
 	"log"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/cpuset"
 
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	"volcano.sh/apis/pkg/apis/scheduling"
 	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
@@ -1775,3 +1777,54 @@ func TestAddPodWithUnresolvedPVCCachesTaskForResync(t *testing.T) {
 	// informer catches up.
 	assert.Equal(t, 1, sc.errTasks.Len(), "task must be enqueued for resync")
 }
+
+// fakeRuntimePredictor records the running durations it observes.
+type fakeRuntimePredictor struct {
+	observed []time.Duration
+}
+
+func (p *fakeRuntimePredictor) Run(stopCh <-chan struct{}) {}
+
+func (p *fakeRuntimePredictor) Observe(key schedulingapi.RuntimeKey, duration time.Duration) {
+	p.observed = append(p.observed, duration)
+}
+
+func (p *fakeRuntimePredictor) Predict(job *schedulingapi.JobInfo) *time.Duration {
+	return nil
+}
+
+func TestSchedulerCache_UpdateJobV1alpha1(t *testing.T) {
+	created := time.Now().Add(-3 * time.Hour)
+	at := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(created.Add(d))
+		return &t
+	}
+
+	// The job waited in Pending for two hours before it ran for 30 minutes.
+	job := &batch.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "ns1", CreationTimestamp: metav1.NewTime(created)},
+		Spec:       batch.JobSpec{SchedulerName: "volcano", Queue: "q1"},
+		Status: batch.JobStatus{
+			State:           batch.JobState{Phase: batch.Running},
+			RunningDuration: &metav1.Duration{Duration: 2 * time.Hour},
+			Conditions: []batch.JobCondition{
+				{Status: batch.Pending, LastTransitionTime: at(0)},
+				{Status: batch.Running, LastTransitionTime: at(2 * time.Hour)},
+			},
+		},
+	}
+	completed := job.DeepCopy()
+	completed.Status.State.Phase = batch.Completed
+	completed.Status.RunningDuration = &metav1.Duration{Duration: 2*time.Hour + 31*time.Minute}
+	completed.Status.Conditions = append(completed.Status.Conditions,
+		batch.JobCondition{Status: batch.Completing, LastTransitionTime: at(2*time.Hour + 30*time.Minute)},
+		batch.JobCondition{Status: batch.Completed, LastTransitionTime: at(2*time.Hour + 31*time.Minute)})
+
+	predictor := &fakeRuntimePredictor{}
+	sc := &SchedulerCache{schedulerNames: []string{"volcano"}, runtimePredictor: predictor}
+	sc.UpdateJobV1alpha1(job, completed)
+	// Observed once, only at the transition.
+	sc.UpdateJobV1alpha1(completed, completed)
+
+	assert.Equal(t, []time.Duration{30 * time.Minute}, predictor.observed)
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const snapshotWorkers = 16
+
+/*
+The incremental snapshot keeps a copy of the jobs, nodes and queues of the cache, and the event handlers record
+which of them changed. A snapshot copies only the changed objects from the cache into a new generation, which
+shares the objects that did not change with the previous one, so the cache is locked for the time it takes to
+copy the changes instead of the whole cluster.
+
+The session can not be given the objects of the generation, because the actions and plugins modify the objects
+they are given. Instead, a closed session releases its snapshot, and the next snapshot takes over the jobs and
+nodes of the released one which changed neither in the cache nor in the session, so that only the objects which
+changed are copied again. The session is considered to have changed a job or a node if the state of any of its
+tasks or, for nodes, the resources differ from the generation; jobs with pending tasks are always copied, as the
+session modifies the tasks it tries to place. The fields the session sets on every job, e.g. the status of its
+pod group, and the devices of the nodes, are reset from the generation when they are taken over. Queues are few
+and their status is modified by every session, so they are always copied.
+*/
+
+// incrementalSnapshot is the copy of the jobs, nodes and queues of the cache, as of the last snapshot.
+type incrementalSnapshot struct {
+	// Mutex serializes the snapshots, which read the generation after the cache is unlocked.
+	sync.Mutex
+
+	generation uint64
+	jobs       map[schedulingapi.JobID]*schedulingapi.JobInfo
+	nodes      map[string]*schedulingapi.NodeInfo
+	queues     map[schedulingapi.QueueID]*schedulingapi.QueueInfo
+
+	// The objects changed since the last snapshot, guarded by the lock of the cache.
+	changedJobs   sets.Set[schedulingapi.JobID]
+	changedNodes  sets.Set[string]
+	changedQueues sets.Set[schedulingapi.QueueID]
+	// allJobsChanged is set when the priority of any job may have changed, e.g. a priority class was updated.
+	allJobsChanged bool
+
+	// The jobs and nodes released by the last closed session, guarded by the mutex.
+	releasedJobs  map[schedulingapi.JobID]releasedJob
+	releasedNodes map[string]releasedNode
+}
+
+// releasedJob is a job of a closed session, with the job of the generation it was copied from.
+type releasedJob struct {
+	job    *schedulingapi.JobInfo
+	source *schedulingapi.JobInfo
+}
+
+// releasedNode is a node of a closed session, with the node of the generation it was copied from.
+type releasedNode struct {
+	node   *schedulingapi.NodeInfo
+	source *schedulingapi.NodeInfo
+}
+
+func newIncrementalSnapshot() *incrementalSnapshot {
+	return &incrementalSnapshot{
+		jobs:          make(map[schedulingapi.JobID]*schedulingapi.JobInfo),
+		nodes:         make(map[string]*schedulingapi.NodeInfo),
+		queues:        make(map[schedulingapi.QueueID]*schedulingapi.QueueInfo),
+		changedJobs:   sets.New[schedulingapi.JobID](),
+		changedNodes:  sets.New[string](),
+		changedQueues: sets.New[schedulingapi.QueueID](),
+		releasedJobs:  make(map[schedulingapi.JobID]releasedJob),
+		releasedNodes: make(map[string]releasedNode),
+	}
+}
+
+// setIncrementalScheduling enables the incremental snapshot and the session trigger according to the options.
+func (sc *SchedulerCache) setIncrementalScheduling() {
+	if options.ServerOpts == nil {
+		return
+	}
+	if options.ServerOpts.EnableIncrementalSnapshot {
+		sc.incremental = newIncrementalSnapshot()
+	}
+	if options.ServerOpts.EnableSessionTrigger {
+		sc.sessionTrigger = make(chan struct{}, 1)
+		sc.sessionTriggerPriority = options.ServerOpts.SessionTriggerPriority
+	}
+}
+
+// markJobChanged records that the job changed since the last snapshot. Assumes that lock is already acquired.
+func (sc *SchedulerCache) markJobChanged(id schedulingapi.JobID) {
+	if sc.incremental != nil {
+		sc.incremental.changedJobs.Insert(id)
+	}
+}
+
+// markAllJobsChanged records that all the jobs changed since the last snapshot. Assumes that lock is already acquired.
+func (sc *SchedulerCache) markAllJobsChanged() {
+	if sc.incremental != nil {
+		sc.incremental.allJobsChanged = true
+	}
+}
+
+// markNodeChanged records that the node changed since the last snapshot. Assumes that lock is already acquired.
+func (sc *SchedulerCache) markNodeChanged(name string) {
+	if sc.incremental != nil {
+		sc.incremental.changedNodes.Insert(name)
+	}
+}
+
+// markQueueChanged records that the queue changed since the last snapshot. Assumes that lock is already acquired.
+func (sc *SchedulerCache) markQueueChanged(id schedulingapi.QueueID) {
+	if sc.incremental != nil {
+		sc.incremental.changedQueues.Insert(id)
+	}
+}
+
+// markTaskChanged records that the job and the node of the task changed since the last snapshot.
+// Assumes that lock is already acquired.
+func (sc *SchedulerCache) markTaskChanged(ti *schedulingapi.TaskInfo) {
+	if len(ti.Job) != 0 {
+		sc.markJobChanged(ti.Job)
+	}
+	if len(ti.NodeName) != 0 {
+		sc.markNodeChanged(ti.NodeName)
+	}
+}
+
+// applyChanges copies the objects changed since the last snapshot into a new generation.
+// Assumes that lock is already acquired.
+func (sc *SchedulerCache) applyChanges() {
+	inc := sc.incremental
+	if inc.generation == 0 || inc.allJobsChanged {
+		for id := range sc.Jobs {
+			inc.changedJobs.Insert(id)
+		}
+	}
+	if inc.generation == 0 {
+		for name := range sc.Nodes {
+			inc.changedNodes.Insert(name)
+		}
+		for id := range sc.Queues {
+			inc.changedQueues.Insert(id)
+		}
+	}
+	if inc.generation != 0 && inc.changedJobs.Len() == 0 && inc.changedNodes.Len() == 0 && inc.changedQueues.Len() == 0 {
+		return
+	}
+
+	jobs := make([]*schedulingapi.JobInfo, 0, inc.changedJobs.Len())
+	for id := range inc.changedJobs {
+		job, found := sc.Jobs[id]
+		if !found || job.PodGroup == nil {
+			delete(inc.jobs, id)
+			continue
+		}
+		sc.setJobPriority(job)
+		jobs = append(jobs, job)
+	}
+	clones := make([]*schedulingapi.JobInfo, len(jobs))
+	workqueue.ParallelizeUntil(context.TODO(), snapshotWorkers, len(jobs), func(i int) {
+		clones[i] = jobs[i].Clone()
+	})
+	for _, job := range clones {
+		inc.jobs[job.UID] = job
+	}
+
+	for name := range inc.changedNodes {
+		node, found := sc.Nodes[name]
+		if !found {
+			delete(inc.nodes, name)
+			continue
+		}
+		node.RefreshNumaSchedulerInfoByCrd()
+		inc.nodes[name] = node.Clone()
+	}
+
+	for id := range inc.changedQueues {
+		queue, found := sc.Queues[id]
+		if !found {
+			delete(inc.queues, id)
+			continue
+		}
+		inc.queues[id] = queue.Clone()
+	}
+
+	klog.V(4).InfoS("Applied changes to incremental snapshot", "generation", inc.generation+1,
+		"jobs", inc.changedJobs.Len(), "nodes", inc.changedNodes.Len(), "queues", inc.changedQueues.Len())
+	inc.changedJobs = sets.New[schedulingapi.JobID]()
+	inc.changedNodes = sets.New[string]()
+	inc.changedQueues = sets.New[schedulingapi.QueueID]()
+	inc.allJobsChanged = false
+	inc.generation++
+	metrics.UpdateSnapshotGeneration(inc.generation)
+}
+
+// incrementalSnapshotOf applies the changes since the last snapshot, and copies the generation for the session,
+// taking over the jobs and nodes released by the last session which did not change.
+func (sc *SchedulerCache) incrementalSnapshotOf() *schedulingapi.ClusterInfo {
+	inc := sc.incremental
+	inc.Lock()
+	defer inc.Unlock()
+
+	sc.Mutex.Lock()
+	sc.applyChanges()
+	snapshot := sc.newSnapshot()
+	sc.Mutex.Unlock()
+
+	// The released objects are handed to this snapshot only, whoever takes it.
+	releasedJobs, releasedNodes := inc.releasedJobs, inc.releasedNodes
+	inc.releasedJobs = make(map[schedulingapi.JobID]releasedJob)
+	inc.releasedNodes = make(map[string]releasedNode)
+
+	snapshot.Generation = inc.generation
+	for id, queue := range inc.queues {
+		snapshot.Queues[id] = queue.Clone()
+	}
+
+	nodes := make([]*schedulingapi.NodeInfo, 0, len(inc.nodes))
+	for _, node := range inc.nodes {
+		if node.Ready() {
+			nodes = append(nodes, node)
+		}
+	}
+	var reusedNodes, reusedJobs atomic.Int32
+	nodeCopies := make([]*schedulingapi.NodeInfo, len(nodes))
+	workqueue.ParallelizeUntil(context.TODO(), snapshotWorkers, len(nodes), func(i int) {
+		if released, found := releasedNodes[nodes[i].Name]; found && released.source == nodes[i] &&
+			takeOverNode(released.node, nodes[i]) {
+			nodeCopies[i] = released.node
+			reusedNodes.Add(1)
+			return
+		}
+		nodeCopies[i] = nodes[i].Clone()
+	})
+	for _, node := range nodeCopies {
+		snapshot.Nodes[node.Name] = node
+		if node.RevocableZone != "" {
+			snapshot.RevocableNodes[node.Name] = node
+		}
+	}
+
+	jobs := make([]*schedulingapi.JobInfo, 0, len(inc.jobs))
+	for _, job := range inc.jobs {
+		if _, found := snapshot.Queues[job.Queue]; !found {
+			klog.V(3).Infof("The Queue <%v> of Job <%v/%v> does not exist, ignore it.",
+				job.Queue, job.Namespace, job.Name)
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	jobCopies := make([]*schedulingapi.JobInfo, len(jobs))
+	workqueue.ParallelizeUntil(context.TODO(), snapshotWorkers, len(jobs), func(i int) {
+		if released, found := releasedJobs[jobs[i].UID]; found && released.source == jobs[i] {
+			jobCopies[i] = takeOverJob(released.job, jobs[i])
+		}
+		if jobCopies[i] != nil {
+			reusedJobs.Add(1)
+		} else {
+			jobCopies[i] = jobs[i].Clone()
+		}
+		if sc.runtimePredictor != nil {
+			jobCopies[i].PredictedRuntime = sc.runtimePredictor.Predict(jobCopies[i])
+		}
+	})
+	for _, job := range jobCopies {
+		snapshot.Jobs[job.UID] = job
+	}
+
+	klog.V(3).InfoS("SnapShot for scheduling", "generation", snapshot.Generation, "jobNum", len(snapshot.Jobs),
+		"QueueNum", len(snapshot.Queues), "NodeNum", len(snapshot.Nodes),
+		"reusedJobs", reusedJobs.Load(), "reusedNodes", reusedNodes.Load())
+	return snapshot
+}
+
+// ReleaseSnapshot hands back the snapshot of a closed session, whose jobs and nodes are taken over by the next
+// snapshot if they did not change. The snapshot must not be used after it is released.
+func (sc *SchedulerCache) ReleaseSnapshot(snapshot *schedulingapi.ClusterInfo) {
+	inc := sc.incremental
+	if inc == nil || snapshot == nil {
+		return
+	}
+	inc.Lock()
+	defer inc.Unlock()
+
+	// The objects are copied from the current generation only if no changes were applied since the snapshot.
+	if snapshot.Generation != inc.generation {
+		return
+	}
+	for id, job := range snapshot.Jobs {
+		if source, found := inc.jobs[id]; found {
+			inc.releasedJobs[id] = releasedJob{job: job, source: source}
+		}
+	}
+	for name, node := range snapshot.Nodes {
+		if source, found := inc.nodes[name]; found {
+			inc.releasedNodes[name] = releasedNode{node: node, source: source}
+		}
+	}
+}
+
+// sameTaskState returns whether the session left the task as it is in the generation.
+func sameTaskState(task, source *schedulingapi.TaskInfo) bool {
+	return task.Pod == source.Pod && task.Status == source.Status && task.NodeName == source.NodeName &&
+		task.JobAllocatedHyperNode == source.JobAllocatedHyperNode && task.EvictionOccurred == source.EvictionOccurred
+}
+
+// sameTasks returns whether the session left the tasks as they are in the generation.
+func sameTasks(tasks, sources map[schedulingapi.TaskID]*schedulingapi.TaskInfo) bool {
+	if len(tasks) != len(sources) {
+		return false
+	}
+	for id, source := range sources {
+		task, found := tasks[id]
+		if !found || !sameTaskState(task, source) {
+			return false
+		}
+	}
+	return true
+}
+
+// takeOverNode resets the node of the closed session to its source in the generation, and returns false if the
+// session changed its tasks or resources, in which case the node has to be copied again.
+func takeOverNode(node, source *schedulingapi.NodeInfo) bool {
+	if !sameTasks(node.Tasks, source.Tasks) ||
+		!node.Idle.Equal(source.Idle, schedulingapi.Zero) || !node.Used.Equal(source.Used, schedulingapi.Zero) ||
+		!node.Releasing.Equal(source.Releasing, schedulingapi.Zero) ||
+		!node.Pipelined.Equal(source.Pipelined, schedulingapi.Zero) {
+		return false
+	}
+
+	// The plugins wrap and modify the devices, so they are copied from the generation again.
+	node.Others = source.CloneOthers()
+	node.NumaSchedulerInfo = nil
+	if source.NumaSchedulerInfo != nil {
+		node.NumaSchedulerInfo = source.NumaSchedulerInfo.DeepCopy()
+	}
+	return true
+}
+
+// takeOverJob returns the job of the closed session reset to its source in the generation, or nil if the source has
+// pending tasks or the session changed its tasks, in which case the job has to be copied again.
+func takeOverJob(job, source *schedulingapi.JobInfo) *schedulingapi.JobInfo {
+	if len(source.TaskStatusIndex[schedulingapi.Pending]) != 0 || !sameTasks(job.Tasks, source.Tasks) ||
+		len(job.SubJobs) != len(source.SubJobs) || !job.Allocated.Equal(source.Allocated, schedulingapi.Zero) {
+		return nil
+	}
+
+	// The fields of the job are taken from the generation, and only its tasks are kept from the session.
+	info := *source
+	info.TaskStatusIndex = job.TaskStatusIndex
+	info.Tasks = job.Tasks
+	info.Allocated = job.Allocated
+	info.TotalRequest = job.TotalRequest
+	info.TaskMinAvailable = job.TaskMinAvailable
+	info.MinSubJobs = job.MinSubJobs
+	info.SubJobs = job.SubJobs
+	info.TaskToSubJob = job.TaskToSubJob
+	info.NodesFitErrors = make(map[schedulingapi.TaskID]*schedulingapi.FitErrors)
+	info.NetworkTopology = source.NetworkTopology.DeepCopy()
+	if source.PodGroup != nil {
+		info.PodGroup = source.PodGroup.Clone()
+	}
+	if source.Budget != nil {
+		info.Budget = source.Budget.Clone()
+	}
+	for id, subJob := range info.SubJobs {
+		sourceSubJob, found := source.SubJobs[id]
+		if !found {
+			return nil
+		}
+		subJob.CloneStatusFrom(sourceSubJob)
+		subJob.NetworkTopology = sourceSubJob.NetworkTopology.DeepCopy()
+	}
+	return &info
+}
+
+// SessionTrigger returns the channel signalled when a job of a high priority arrives, nil if session trigger is disabled.
+func (sc *SchedulerCache) SessionTrigger() <-chan struct{} {
+	if sc.sessionTrigger == nil {
+		return nil
+	}
+	return sc.sessionTrigger
+}
+
+// triggerSession asks for a session ahead of the schedule period if the job is of a high priority and has pending
+// tasks. Assumes that lock is already acquired.
+func (sc *SchedulerCache) triggerSession(job *schedulingapi.JobInfo) {
+	if sc.sessionTrigger == nil || job == nil || job.PodGroup == nil || len(job.TaskStatusIndex[schedulingapi.Pending]) == 0 {
+		return
+	}
+	priority := sc.jobPriority(job)
+	if priority < sc.sessionTriggerPriority {
+		return
+	}
+	select {
+	case sc.sessionTrigger <- struct{}{}:
+		klog.V(3).Infof("Job <%s/%s> of priority %d arrived, trigger a session.", job.Namespace, job.Name, priority)
+	default:
+	}
+}
+
+// triggerSessionForPod asks for a session ahead of the schedule period if the job of the pending pod is of a high
+// priority. Assumes that lock is already acquired.
+func (sc *SchedulerCache) triggerSessionForPod(pod *v1.Pod) {
+	if sc.sessionTrigger == nil {
+		return
+	}
+	pi := schedulingapi.NewTaskInfo(pod)
+	sc.triggerSession(sc.Jobs[pi.Job])
+}
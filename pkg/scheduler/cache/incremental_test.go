@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func buildGroupPod(name, nodeName, group string, phase v1.PodPhase) *v1.Pod {
+	pod := buildPod("ns", name, nodeName, phase, api.BuildResourceList("1", "1G"), nil, nil)
+	pod.Annotations = map[string]string{schedulingv1beta1.KubeGroupNameAnnotationKey: group}
+	return pod
+}
+
+func buildGroup(name, priorityClass string) *api.PodGroup {
+	return &api.PodGroup{
+		PodGroup: scheduling.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec:       scheduling.PodGroupSpec{Queue: "default", PriorityClassName: priorityClass},
+		},
+		Version: api.PodGroupVersionV1Beta1,
+	}
+}
+
+func TestIncrementalSnapshot(t *testing.T) {
+	sc := NewDefaultMockSchedulerCache("volcano")
+	sc.incremental = newIncrementalSnapshot()
+
+	sc.addQueue(&scheduling.Queue{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	for _, name := range []string{"n1", "n2"} {
+		sc.AddOrUpdateNode(buildNode(name, api.BuildResourceList("4", "8G", api.ScalarResource{Name: "pods", Value: "10"})))
+	}
+	sc.setPodGroup(buildGroup("pg1", "high"))
+	if err := sc.addPod(buildGroupPod("p1", "n1", "pg1", v1.PodRunning)); err != nil {
+		t.Fatal(err)
+	}
+	jobID := api.JobID("ns/pg1")
+
+	first := sc.Snapshot()
+	if first.Generation != 1 || len(first.Jobs) != 1 || len(first.Nodes) != 2 || len(first.Queues) != 1 {
+		t.Fatalf("unexpected first snapshot: generation %d, %d jobs, %d nodes, %d queues",
+			first.Generation, len(first.Jobs), len(first.Nodes), len(first.Queues))
+	}
+
+	// The incremental snapshot holds the same jobs and nodes as a full one.
+	incremental := sc.incremental
+	sc.incremental = nil
+	full := sc.Snapshot()
+	sc.incremental = incremental
+	if !reflect.DeepEqual(full.Jobs, first.Jobs) || !reflect.DeepEqual(full.Nodes, first.Nodes) {
+		t.Errorf("expected the incremental snapshot to equal the full snapshot")
+	}
+
+	// The changes made by the session are not seen by the next one.
+	first.Jobs[jobID].PodGroup.Status.Phase = scheduling.PodGroupInqueue
+	first.Nodes["n1"].RemoveTask(first.Jobs[jobID].Tasks["ns-p1"])
+	n1 := sc.incremental.nodes["n1"]
+	second := sc.Snapshot()
+	if second.Generation != 1 {
+		t.Errorf("expected the generation to stay 1 without changes, got %d", second.Generation)
+	}
+	if second.Jobs[jobID].PodGroup.Status.Phase == scheduling.PodGroupInqueue || len(second.Nodes["n1"].Tasks) != 1 {
+		t.Errorf("expected the session not to change the next snapshot")
+	}
+
+	// Only the changed objects are copied again.
+	if err := sc.addPod(buildGroupPod("p2", "n2", "pg1", v1.PodRunning)); err != nil {
+		t.Fatal(err)
+	}
+	third := sc.Snapshot()
+	if third.Generation != 2 {
+		t.Errorf("expected generation 2 after a change, got %d", third.Generation)
+	}
+	if len(third.Jobs[jobID].Tasks) != 2 || len(third.Nodes["n2"].Tasks) != 1 {
+		t.Errorf("expected the new task in the job and on its node")
+	}
+	if sc.incremental.nodes["n1"] != n1 {
+		t.Errorf("expected the node without changes to be shared with the previous generation")
+	}
+
+	// The priority of the jobs follows their priority classes.
+	sc.addPriorityClass(&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "high"}, Value: 100})
+	if priority := sc.Snapshot().Jobs[jobID].Priority; priority != 100 {
+		t.Errorf("expected the priority of the job to be 100, got %d", priority)
+	}
+
+	// The jobs of a deleted queue are left out.
+	sc.deleteQueue("default")
+	if fourth := sc.Snapshot(); len(fourth.Jobs) != 0 || len(fourth.Queues) != 0 {
+		t.Errorf("expected no jobs and queues after the queue is deleted, got %d jobs, %d queues",
+			len(fourth.Jobs), len(fourth.Queues))
+	}
+}
+
+func TestReleaseSnapshot(t *testing.T) {
+	sc := NewDefaultMockSchedulerCache("volcano")
+	sc.incremental = newIncrementalSnapshot()
+
+	sc.addQueue(&scheduling.Queue{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	for _, name := range []string{"n1", "n2", "n3"} {
+		sc.AddOrUpdateNode(buildNode(name, api.BuildResourceList("4", "8G", api.ScalarResource{Name: "pods", Value: "10"})))
+	}
+	sc.setPodGroup(buildGroup("running", ""))
+	sc.setPodGroup(buildGroup("evicted", ""))
+	sc.setPodGroup(buildGroup("pending", ""))
+	for _, pod := range []*v1.Pod{
+		buildGroupPod("p1", "n1", "running", v1.PodRunning),
+		buildGroupPod("p2", "n2", "evicted", v1.PodRunning),
+		buildGroupPod("p3", "", "pending", v1.PodPending),
+	} {
+		if err := sc.addPod(pod); err != nil {
+			t.Fatal(err)
+		}
+	}
+	running, evicted, pending := api.JobID("ns/running"), api.JobID("ns/evicted"), api.JobID("ns/pending")
+
+	first := sc.Snapshot()
+	// The session changes the status of a pod group and evicts a task, and n3 changes in the cache.
+	first.Jobs[running].PodGroup.Status.Phase = scheduling.PodGroupInqueue
+	task := first.Jobs[evicted].Tasks["ns-p2"]
+	first.Jobs[evicted].UpdateTaskStatus(task, api.Releasing)
+	first.Nodes["n2"].UpdateTask(task)
+	sc.AddOrUpdateNode(buildNode("n3", api.BuildResourceList("8", "16G", api.ScalarResource{Name: "pods", Value: "10"})))
+	sc.ReleaseSnapshot(first)
+
+	second := sc.Snapshot()
+	if second.Jobs[running].Tasks["ns-p1"] != first.Jobs[running].Tasks["ns-p1"] || second.Nodes["n1"] != first.Nodes["n1"] {
+		t.Errorf("expected the objects which did not change to be reused instead of copied")
+	}
+	if second.Jobs[running].PodGroup.Status.Phase == scheduling.PodGroupInqueue {
+		t.Errorf("expected the status of the pod group of a reused job to be reset")
+	}
+	if second.Jobs[running].PodGroup == sc.incremental.jobs[running].PodGroup {
+		t.Errorf("expected the pod group of a reused job not to be shared with the generation")
+	}
+	if second.Jobs[evicted].Tasks["ns-p2"] == task || second.Nodes["n2"] == first.Nodes["n2"] ||
+		second.Jobs[evicted].Tasks["ns-p2"].Status != api.Running {
+		t.Errorf("expected the objects changed by the session to be copied again")
+	}
+	if second.Nodes["n3"] == first.Nodes["n3"] || second.Jobs[pending].Tasks["ns-p3"] == first.Jobs[pending].Tasks["ns-p3"] {
+		t.Errorf("expected the objects changed in the cache and the jobs with pending tasks to be copied again")
+	}
+
+	// The objects of a snapshot which is not released are not reused.
+	third := sc.Snapshot()
+	if third.Jobs[running].Tasks["ns-p1"] == second.Jobs[running].Tasks["ns-p1"] || third.Nodes["n1"] == second.Nodes["n1"] {
+		t.Errorf("expected the objects of a snapshot which is not released to be copied")
+	}
+}
+
+func TestSessionTrigger(t *testing.T) {
+	tests := []struct {
+		name          string
+		priorityClass string
+		// podGroupFirst adds the pod group before its pod.
+		podGroupFirst bool
+		nodeName      string
+		expected      bool
+	}{
+		{
+			name:          "pending pod of a high priority job",
+			priorityClass: "high",
+			podGroupFirst: true,
+			expected:      true,
+		},
+		{
+			name:          "pod group of a high priority job after its pending pod",
+			priorityClass: "high",
+			expected:      true,
+		},
+		{
+			name:          "pending pod of a low priority job",
+			priorityClass: "low",
+			podGroupFirst: true,
+			expected:      false,
+		},
+		{
+			name:          "running pod of a high priority job",
+			priorityClass: "high",
+			podGroupFirst: true,
+			nodeName:      "n1",
+			expected:      false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := NewDefaultMockSchedulerCache("volcano")
+			sc.sessionTrigger = make(chan struct{}, 1)
+			sc.sessionTriggerPriority = 1000
+			sc.addPriorityClass(&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "high"}, Value: 1000})
+			sc.addPriorityClass(&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "low"}, Value: 10})
+
+			pg := &schedulingv1beta1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: "ns"},
+				Spec:       schedulingv1beta1.PodGroupSpec{Queue: "default", PriorityClassName: test.priorityClass},
+			}
+			pod := buildGroupPod("p1", test.nodeName, "pg1", v1.PodPending)
+			if test.podGroupFirst {
+				sc.AddPodGroupV1beta1(pg)
+				sc.AddPod(pod)
+			} else {
+				sc.AddPod(pod)
+				sc.AddPodGroupV1beta1(pg)
+			}
+
+			triggered := false
+			select {
+			case <-sc.SessionTrigger():
+				triggered = true
+			default:
+			}
+			if triggered != test.expected {
+				t.Errorf("expected triggered to be %v, got %v", test.expected, triggered)
+			}
+		})
+	}
+}
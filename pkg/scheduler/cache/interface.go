@@ -45,6 +45,10 @@ type Cache interface {
 	// Snapshot deep copy overall cache information into snapshot
 	Snapshot() *api.ClusterInfo
 
+	// ReleaseSnapshot hands back the snapshot of a closed session, so that its jobs and nodes which did not change
+	// may be reused by the next snapshot instead of being copied again
+	ReleaseSnapshot(snapshot *api.ClusterInfo)
+
 	// WaitForCacheSync waits for all cache synced
 	WaitForCacheSync(stopCh <-chan struct{})
 
@@ -107,6 +111,10 @@ type Cache interface {
 
 	//OnSessionClose is called after session close
 	OnSessionClose()
+
+	// SessionTrigger returns the channel signalled when a job of a high priority arrives, so that a session is
+	// started ahead of the schedule period, nil if session trigger is disabled
+	SessionTrigger() <-chan struct{}
 }
 
 // Binder interface for binding task and hostname
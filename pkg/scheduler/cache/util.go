@@ -32,6 +32,7 @@ import (
 	"k8s.io/klog/v2"
 	"stathat.com/c/consistent"
 
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 )
 
@@ -107,6 +108,26 @@ func responsibleForPodGroup(pg *scheduling.PodGroup, mySchedulerPodName string,
 	return true
 }
 
+// responsibleForJob returns true if the Volcano Job is assigned to current scheduler in multi-schedulers scenario,
+// which is the scheduler its PodGroup is assigned to
+func responsibleForJob(job *batch.Job, schedulerNames []string, mySchedulerPodName string, c *consistent.Consistent) bool {
+	if !slices.Contains(schedulerNames, job.Spec.SchedulerName) {
+		return false
+	}
+	if c != nil {
+		schedulerPodName, err := c.Get(job.Name)
+		if err != nil {
+			klog.Errorf("Failed to get scheduler by hash algorithm, err: %v", err)
+		}
+		if schedulerPodName != mySchedulerPodName {
+			return false
+		}
+	}
+
+	klog.V(4).Infof("schedulerPodName %v is responsible to Job %v/%v", mySchedulerPodName, job.Namespace, job.Name)
+	return true
+}
+
 // getMultiSchedulerInfo return the Pod name of current scheduler and the hash table for all schedulers
 func getMultiSchedulerInfo() (schedulerPodName string, c *consistent.Consistent) {
 	multiSchedulerEnable := os.Getenv("MULTI_SCHEDULER_ENABLE")
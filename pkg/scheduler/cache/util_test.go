@@ -23,6 +23,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	"stathat.com/c/consistent"
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 )
 
@@ -105,3 +107,30 @@ func TestRemoveVolcanoSchGate(t *testing.T) {
 		})
 	}
 }
+
+func TestResponsibleForJob(t *testing.T) {
+	c := consistent.New()
+	c.Add("volcano-scheduler-0")
+	c.Add("volcano-scheduler-1")
+
+	job := &batch.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "default"},
+		Spec:       batch.JobSpec{SchedulerName: "volcano"},
+	}
+	owner, err := c.Get(job.Name)
+	if err != nil {
+		t.Fatalf("failed to get the scheduler of the job: %v", err)
+	}
+
+	if !responsibleForJob(job, []string{"volcano"}, "", nil) {
+		t.Errorf("expected the only scheduler to be responsible for the job")
+	}
+	if responsibleForJob(job, []string{"other"}, "", nil) {
+		t.Errorf("expected a scheduler with other names not to be responsible for the job")
+	}
+	for _, name := range []string{"volcano-scheduler-0", "volcano-scheduler-1"} {
+		if responsible := responsibleForJob(job, []string{"volcano"}, name, c); responsible != (name == owner) {
+			t.Errorf("expected %s to be responsible for the job %v, got %v", name, name == owner, responsible)
+		}
+	}
+}
@@ -28,6 +28,8 @@ var EnabledActionMap map[string]bool
 type SchedulerConfiguration struct {
 	// Actions defines the actions list of scheduler in order
 	Actions string `yaml:"actions"`
+	// Pipeline defines the stages of the actions in order, it takes the place of Actions
+	Pipeline []Stage `yaml:"pipeline"`
 	// Tiers defines plugins in different tiers
 	Tiers []Tier `yaml:"tiers"`
 	// Configurations is configuration for actions
@@ -40,6 +42,22 @@ type Tier struct {
 	Plugins []PluginOption `yaml:"plugins"`
 }
 
+// Stage defines an action of the pipeline, and in which sessions it runs
+type Stage struct {
+	// Action is name of action
+	Action string `yaml:"action"`
+	// Arguments defines the arguments of the action in this stage only, they take precedence over the ones in configurations
+	Arguments map[string]interface{} `yaml:"arguments"`
+	// EverySessions runs the action once every N sessions
+	EverySessions int `yaml:"everySessions"`
+	// Interval runs the action at most once per interval, e.g. 5m
+	Interval string `yaml:"interval"`
+	// When defines the conditions on the cluster state which must all hold for the action to run
+	When []string `yaml:"when"`
+	// Timeout defines the time budget of the action, e.g. 500ms, the action is cut short once it is spent
+	Timeout string `yaml:"timeout"`
+}
+
 // Configuration is configuration of action
 type Configuration struct {
 	// Name is name of action
@@ -102,6 +120,8 @@ type PluginOption struct {
 	EnabledSubJobOrder *bool `yaml:"enabledSubJobOrder"`
 	// EnabledHyperNodeGradient defines whether hyperNodeGradientFn is enabled
 	EnabledHyperNodeGradient *bool `yaml:"enabledHyperNodeGradient"`
+	// EnabledVictimCost defines whether victimCostFn is enabled
+	EnabledVictimCost *bool `yaml:"enabledVictimCost"`
 	// Arguments defines the different arguments that can be given to different plugins
 	Arguments map[string]interface{} `yaml:"arguments"`
 }
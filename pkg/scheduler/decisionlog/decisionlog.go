@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decisionlog writes a record of the binding decision of every pod bound by vc-scheduler or
+// vc-agent-scheduler to a sink, so that the scheduler, shard, session and node scores behind a binding can be
+// audited afterwards.
+package decisionlog
+
+import (
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// NodeScore is the score of a node for a pod.
+type NodeScore struct {
+	Node  string  `json:"node"`
+	Score float64 `json:"score"`
+}
+
+// Record is the binding decision of a pod.
+type Record struct {
+	Time      time.Time `json:"time"`
+	Scheduler string    `json:"scheduler"`
+	Shard     string    `json:"shard,omitempty"`
+	// SessionID is the uid of the session of vc-scheduler, or of the scheduling cycle of vc-agent-scheduler,
+	// which placed the pod.
+	SessionID types.UID `json:"sessionID,omitempty"`
+	Namespace string    `json:"namespace"`
+	Pod       string    `json:"pod"`
+	PodUID    types.UID `json:"podUID"`
+	Node      string    `json:"node"`
+	// Score is the score of the node, 0 if the node was the only one left after the predicates.
+	Score float64 `json:"score"`
+	// Alternatives are the other nodes with the highest scores, in descending order.
+	Alternatives []NodeScore `json:"alternatives,omitempty"`
+}
+
+// Log writes the binding decisions of a scheduler to its sink.
+type Log struct {
+	scheduler string
+	shard     string
+	topNodes  int
+	sink      Sink
+}
+
+// NewLog returns a Log of the scheduler writing to the sink, which records up to topNodes alternatives of a node.
+func NewLog(scheduler, shard string, topNodes int, sink Sink) *Log {
+	return &Log{
+		scheduler: scheduler,
+		shard:     shard,
+		topNodes:  topNodes,
+		sink:      sink,
+	}
+}
+
+// Bound writes the decision of the pod bound to the node. The scores are the highest scores of the nodes for the
+// pod, and may not hold the node, e.g. if a plugin chose another one.
+func (l *Log) Bound(pod *v1.Pod, node string, sessionID types.UID, scores []NodeScore) {
+	if l == nil || pod == nil {
+		return
+	}
+
+	record := &Record{
+		Time:      time.Now(),
+		Scheduler: l.scheduler,
+		Shard:     l.shard,
+		SessionID: sessionID,
+		Namespace: pod.Namespace,
+		Pod:       pod.Name,
+		PodUID:    pod.UID,
+		Node:      node,
+	}
+	for _, score := range scores {
+		if score.Node == node {
+			record.Score = score.Score
+			continue
+		}
+		if len(record.Alternatives) < l.topNodes {
+			record.Alternatives = append(record.Alternatives, score)
+		}
+	}
+
+	if err := l.sink.Write(record); err != nil {
+		klog.ErrorS(err, "Failed to write binding decision", "pod", klog.KObj(pod), "node", node)
+	}
+}
+
+// TopNodeScores returns the n highest scores of the nodes in descending order, the nodes of the same score are
+// ordered by name.
+func TopNodeScores(nodeScores map[float64][]*api.NodeInfo, n int) []NodeScore {
+	if n <= 0 {
+		return nil
+	}
+	var scores []NodeScore
+	for score, nodes := range nodeScores {
+		for _, node := range nodes {
+			scores = append(scores, NodeScore{Node: node.Name, Score: score})
+		}
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].Node < scores[j].Node
+	})
+	if len(scores) > n {
+		scores = scores[:n]
+	}
+	return scores
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decisionlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func buildPod() *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1", UID: "uid-p1"}}
+}
+
+func TestTopNodeScores(t *testing.T) {
+	nodeScores := map[float64][]*api.NodeInfo{
+		10: {{Name: "n3"}, {Name: "n1"}},
+		30: {{Name: "n2"}},
+		20: {{Name: "n4"}},
+	}
+
+	assert.Equal(t, []NodeScore{{Node: "n2", Score: 30}, {Node: "n4", Score: 20}, {Node: "n1", Score: 10}}, TopNodeScores(nodeScores, 3))
+	assert.Len(t, TopNodeScores(nodeScores, 10), 4)
+	assert.Nil(t, TopNodeScores(nodeScores, 0))
+}
+
+func TestLogBound(t *testing.T) {
+	tests := []struct {
+		name                 string
+		node                 string
+		scores               []NodeScore
+		expectedScore        float64
+		expectedAlternatives []NodeScore
+	}{
+		{
+			name:                 "the chosen node is taken out of the alternatives",
+			node:                 "n2",
+			scores:               []NodeScore{{Node: "n1", Score: 30}, {Node: "n2", Score: 20}, {Node: "n3", Score: 10}},
+			expectedScore:        20,
+			expectedAlternatives: []NodeScore{{Node: "n1", Score: 30}, {Node: "n3", Score: 10}},
+		},
+		{
+			name:                 "the alternatives are limited to the top nodes",
+			node:                 "n4",
+			scores:               []NodeScore{{Node: "n1", Score: 30}, {Node: "n2", Score: 20}, {Node: "n3", Score: 10}},
+			expectedAlternatives: []NodeScore{{Node: "n1", Score: 30}, {Node: "n2", Score: 20}},
+		},
+		{
+			name: "the only node left has no scores",
+			node: "n1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			log := NewLog("volcano", "shard1", 2, NewStreamSink(&buf))
+			log.Bound(buildPod(), test.node, "session1", test.scores)
+
+			var record Record
+			assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+			assert.Equal(t, "volcano", record.Scheduler)
+			assert.Equal(t, "shard1", record.Shard)
+			assert.Equal(t, "session1", string(record.SessionID))
+			assert.Equal(t, "ns1", record.Namespace)
+			assert.Equal(t, "p1", record.Pod)
+			assert.Equal(t, "uid-p1", string(record.PodUID))
+			assert.Equal(t, test.node, record.Node)
+			assert.Equal(t, test.expectedScore, record.Score)
+			assert.Equal(t, test.expectedAlternatives, record.Alternatives)
+		})
+	}
+}
+
+func TestNilLogBound(t *testing.T) {
+	var log *Log
+	log.Bound(buildPod(), "n1", "session1", nil)
+}
+
+func TestNewSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "decisions.log")
+	sink, err := NewSink(SinkFile, path, nil)
+	assert.NoError(t, err)
+	log := NewLog("volcano", "", 1, sink)
+	log.Bound(buildPod(), "n1", "session1", nil)
+	log.Bound(buildPod(), "n2", "session2", nil)
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 2)
+	var r Record
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &r))
+	assert.Equal(t, "n2", r.Node)
+
+	recorder := record.NewFakeRecorder(1)
+	sink, err = NewSink(SinkEvent, "", recorder)
+	assert.NoError(t, err)
+	NewLog("volcano", "shard1", 1, sink).Bound(buildPod(), "n1", "session1", []NodeScore{{Node: "n1", Score: 20}, {Node: "n2", Score: 10}})
+	assert.Equal(t, "Normal BindingDecision Bound to n1 (score 20) by volcano in shard shard1, session session1; alternatives: n2 (score 10)", <-recorder.Events)
+
+	_, err = NewSink(SinkFile, "", nil)
+	assert.Error(t, err)
+	_, err = NewSink(SinkEvent, "", nil)
+	assert.Error(t, err)
+	_, err = NewSink("kafka", "", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decisionlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// SinkStdout writes the records to stdout as a stream of json objects.
+	SinkStdout = "stdout"
+	// SinkFile appends the records to a file as a stream of json objects.
+	SinkFile = "file"
+	// SinkEvent records the decisions as events of the pods.
+	SinkEvent = "event"
+
+	// EventReason is the reason of the events recorded by the event sink.
+	EventReason = "BindingDecision"
+)
+
+// Sink is where the records of the binding decisions are written to.
+type Sink interface {
+	Write(record *Record) error
+}
+
+// NewSink returns the sink of the kind. The file sink appends to the file at path, and the event sink records
+// events by the recorder.
+func NewSink(kind, path string, recorder record.EventRecorder) (Sink, error) {
+	switch kind {
+	case SinkStdout:
+		return NewStreamSink(os.Stdout), nil
+	case SinkFile:
+		if path == "" {
+			return nil, fmt.Errorf("the file of the %s sink is not set", SinkFile)
+		}
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %v", path, err)
+		}
+		return NewStreamSink(file), nil
+	case SinkEvent:
+		if recorder == nil {
+			return nil, fmt.Errorf("no event recorder for the %s sink", SinkEvent)
+		}
+		return NewEventSink(recorder), nil
+	default:
+		return nil, fmt.Errorf("unknown sink %q, supported sinks are %s, %s and %s", kind, SinkStdout, SinkFile, SinkEvent)
+	}
+}
+
+type streamSink struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewStreamSink returns a sink writing the records to w, one json object per line.
+func NewStreamSink(w io.Writer) Sink {
+	return &streamSink{encoder: json.NewEncoder(w)}
+}
+
+func (s *streamSink) Write(record *Record) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.encoder.Encode(record)
+}
+
+type eventSink struct {
+	recorder record.EventRecorder
+}
+
+// NewEventSink returns a sink recording the decisions as events of the pods.
+func NewEventSink(recorder record.EventRecorder) Sink {
+	return &eventSink{recorder: recorder}
+}
+
+func (s *eventSink) Write(r *Record) error {
+	pod := &v1.ObjectReference{
+		Kind:       "Pod",
+		APIVersion: "v1",
+		Namespace:  r.Namespace,
+		Name:       r.Pod,
+		UID:        r.PodUID,
+	}
+	s.recorder.Eventf(pod, v1.EventTypeNormal, EventReason, "%s", eventMessage(r))
+	return nil
+}
+
+func eventMessage(r *Record) string {
+	var message strings.Builder
+	fmt.Fprintf(&message, "Bound to %s (score %g) by %s", r.Node, r.Score, r.Scheduler)
+	if r.Shard != "" {
+		fmt.Fprintf(&message, " in shard %s", r.Shard)
+	}
+	if r.SessionID != "" {
+		fmt.Fprintf(&message, ", session %s", r.SessionID)
+	}
+	if len(r.Alternatives) > 0 {
+		alternatives := make([]string, 0, len(r.Alternatives))
+		for _, alternative := range r.Alternatives {
+			alternatives = append(alternatives, fmt.Sprintf("%s (score %g)", alternative.Node, alternative.Score))
+		}
+		fmt.Fprintf(&message, "; alternatives: %s", strings.Join(alternatives, ", "))
+	}
+	return message.String()
+}
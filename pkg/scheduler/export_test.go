@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	schedcache "volcano.sh/volcano/pkg/scheduler/cache"
+)
+
+// NewSchedulerForTest returns a scheduler on the cache with the scheduler configuration in the file.
+func NewSchedulerForTest(cache schedcache.Cache, schedulerConf string) *Scheduler {
+	pc := &Scheduler{cache: cache, schedulerConf: schedulerConf}
+	pc.loadSchedulerConf()
+	return pc
+}
+
+// RunOnce executes a single scheduling cycle.
+func (pc *Scheduler) RunOnce() {
+	pc.runOnce()
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// OperationCommitted means the operation of a statement was committed.
+	OperationCommitted = "Committed"
+	// OperationDiscarded means the operation of a statement was discarded.
+	OperationDiscarded = "Discarded"
+)
+
+// DecisionTracer keeps the scheduling decision traces of the most recent sessions.
+// It is safe for concurrent use and can be served over http.
+type DecisionTracer struct {
+	mutex    sync.RWMutex
+	capacity int
+	sessions []*SessionTrace
+}
+
+// SessionTrace records the scheduling decisions made for each task in one session.
+type SessionTrace struct {
+	mutex sync.Mutex
+
+	UID       types.UID             `json:"uid"`
+	StartTime time.Time             `json:"startTime"`
+	Tasks     map[string]*TaskTrace `json:"tasks"`
+}
+
+// TaskTrace records why a task was, or was not, placed on a node.
+type TaskTrace struct {
+	Job string `json:"job"`
+	// PredicateFailures is the plugins that rejected nodes for the task.
+	PredicateFailures []PredicateFailure `json:"predicateFailures,omitempty"`
+	// Scores is the node scores given by each plugin, keyed by plugin name and node name.
+	Scores map[string]map[string]float64 `json:"scores,omitempty"`
+	// Operations is the statement operations of the task in the order they were committed or discarded.
+	Operations []OperationTrace `json:"operations,omitempty"`
+}
+
+// PredicateFailure records the rejection of a node by the predicate of a plugin.
+type PredicateFailure struct {
+	Plugin string `json:"plugin"`
+	Node   string `json:"node"`
+	Reason string `json:"reason"`
+}
+
+// OperationTrace records the outcome of an operation on the task.
+type OperationTrace struct {
+	Operation string `json:"operation"`
+	Node      string `json:"node"`
+	Reason    string `json:"reason,omitempty"`
+	Result    string `json:"result"`
+}
+
+// NewDecisionTracer creates a DecisionTracer which keeps the traces of the latest capacity sessions.
+func NewDecisionTracer(capacity int) *DecisionTracer {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &DecisionTracer{
+		capacity: capacity,
+	}
+}
+
+func (t *DecisionTracer) newSessionTrace(uid types.UID) *SessionTrace {
+	trace := &SessionTrace{
+		UID:       uid,
+		StartTime: time.Now(),
+		Tasks:     map[string]*TaskTrace{},
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.sessions = append(t.sessions, trace)
+	if len(t.sessions) > t.capacity {
+		t.sessions = t.sessions[len(t.sessions)-t.capacity:]
+	}
+	return trace
+}
+
+// Session returns the trace of the session with the given uid, the latest session is returned if uid is empty.
+func (t *DecisionTracer) Session(uid types.UID) *SessionTrace {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if len(t.sessions) == 0 {
+		return nil
+	}
+	if uid == "" {
+		return t.sessions[len(t.sessions)-1]
+	}
+	for _, trace := range t.sessions {
+		if trace.UID == uid {
+			return trace
+		}
+	}
+	return nil
+}
+
+// Sessions returns the uids of the traced sessions from the oldest to the latest.
+func (t *DecisionTracer) Sessions() []types.UID {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	uids := make([]types.UID, 0, len(t.sessions))
+	for _, trace := range t.sessions {
+		uids = append(uids, trace.UID)
+	}
+	return uids
+}
+
+// ServeHTTP serves the traces as json. Without query parameters the uids of the traced sessions are returned,
+// `session` selects a session by uid or `latest`, and `task` (namespace/name) narrows the output down to one task.
+func (t *DecisionTracer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if !query.Has("session") && !query.Has("task") {
+		writeJSON(w, t.Sessions())
+		return
+	}
+
+	uid := types.UID(query.Get("session"))
+	if uid == "latest" {
+		uid = ""
+	}
+	trace := t.Session(uid)
+	if trace == nil {
+		http.Error(w, fmt.Sprintf("session %q is not traced", uid), http.StatusNotFound)
+		return
+	}
+
+	task := query.Get("task")
+	if task == "" {
+		trace.mutex.Lock()
+		defer trace.mutex.Unlock()
+		writeJSON(w, trace)
+		return
+	}
+
+	taskTrace := trace.Task(task)
+	if taskTrace == nil {
+		http.Error(w, fmt.Sprintf("task %q is not traced in session %s", task, trace.UID), http.StatusNotFound)
+		return
+	}
+	writeJSON(w, taskTrace)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		klog.Errorf("Failed to encode decision trace: %v", err)
+	}
+}
+
+// Task returns a copy of the trace of the task with the given namespace/name.
+func (st *SessionTrace) Task(key string) *TaskTrace {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	tt, found := st.Tasks[key]
+	if !found {
+		return nil
+	}
+	clone := &TaskTrace{
+		Job:               tt.Job,
+		PredicateFailures: append([]PredicateFailure(nil), tt.PredicateFailures...),
+		Operations:        append([]OperationTrace(nil), tt.Operations...),
+		Scores:            make(map[string]map[string]float64, len(tt.Scores)),
+	}
+	for plugin, scores := range tt.Scores {
+		clone.Scores[plugin] = make(map[string]float64, len(scores))
+		for node, score := range scores {
+			clone.Scores[plugin][node] = score
+		}
+	}
+	return clone
+}
+
+// WriteJSON dumps the whole session trace as json.
+func (st *SessionTrace) WriteJSON(w io.Writer) error {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	return json.NewEncoder(w).Encode(st)
+}
+
+// taskTrace returns the trace of the task, the caller must hold the lock.
+func (st *SessionTrace) taskTrace(task *api.TaskInfo) *TaskTrace {
+	key := fmt.Sprintf("%s/%s", task.Namespace, task.Name)
+	tt, found := st.Tasks[key]
+	if !found {
+		tt = &TaskTrace{
+			Job:    string(task.Job),
+			Scores: map[string]map[string]float64{},
+		}
+		st.Tasks[key] = tt
+	}
+	return tt
+}
+
+func (st *SessionTrace) recordPredicateFailure(task *api.TaskInfo, node *api.NodeInfo, plugin string, err error) {
+	if st == nil {
+		return
+	}
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	tt := st.taskTrace(task)
+	tt.PredicateFailures = append(tt.PredicateFailures, PredicateFailure{
+		Plugin: plugin,
+		Node:   node.Name,
+		Reason: err.Error(),
+	})
+}
+
+func (st *SessionTrace) recordScore(task *api.TaskInfo, plugin, node string, score float64) {
+	if st == nil {
+		return
+	}
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	tt := st.taskTrace(task)
+	if tt.Scores[plugin] == nil {
+		tt.Scores[plugin] = map[string]float64{}
+	}
+	tt.Scores[plugin][node] = score
+}
+
+func (st *SessionTrace) recordOperation(op Operation, task *api.TaskInfo, reason, result string) {
+	if st == nil {
+		return
+	}
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	tt := st.taskTrace(task)
+	tt.Operations = append(tt.Operations, OperationTrace{
+		Operation: op.String(),
+		Node:      task.NodeName,
+		Reason:    reason,
+		Result:    result,
+	})
+}
+
+func (op Operation) String() string {
+	switch op {
+	case Evict:
+		return "Evict"
+	case Pipeline:
+		return "Pipeline"
+	case Allocate:
+		return "Allocate"
+	default:
+		return "Unknown"
+	}
+}
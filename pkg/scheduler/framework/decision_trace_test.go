@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+func TestDecisionTracerKeepsRecentSessions(t *testing.T) {
+	tracer := NewDecisionTracer(2)
+	for _, uid := range []types.UID{"s1", "s2", "s3"} {
+		tracer.newSessionTrace(uid)
+	}
+
+	assert.Equal(t, []types.UID{"s2", "s3"}, tracer.Sessions())
+	assert.Nil(t, tracer.Session("s1"))
+	assert.Equal(t, types.UID("s3"), tracer.Session("").UID)
+}
+
+func TestSessionDecisionTrace(t *testing.T) {
+	enabled := true
+	ssn := &Session{
+		UID: "ssn-1",
+		Tiers: []conf.Tier{{Plugins: []conf.PluginOption{
+			{Name: "p1", EnabledPredicate: &enabled, EnabledNodeOrder: &enabled},
+			{Name: "p2", EnabledPredicate: &enabled, EnabledNodeOrder: &enabled},
+		}}},
+		predicateFns: map[string]api.PredicateFn{
+			"p1": func(task *api.TaskInfo, node *api.NodeInfo) error { return nil },
+			"p2": func(task *api.TaskInfo, node *api.NodeInfo) error {
+				if node.Name == "n2" {
+					return fmt.Errorf("node n2 is not allowed")
+				}
+				return nil
+			},
+		},
+		nodeOrderFns: map[string]api.NodeOrderFn{
+			"p1": func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) { return 10, nil },
+		},
+		batchNodeOrderFns: map[string]api.BatchNodeOrderFn{
+			"p2": func(task *api.TaskInfo, nodes []*api.NodeInfo) (map[string]float64, error) {
+				return map[string]float64{"n1": 5}, nil
+			},
+		},
+	}
+	tracer := NewDecisionTracer(1)
+	ssn.SetDecisionTracer(tracer)
+
+	task := &api.TaskInfo{Name: "t1", Namespace: "ns", Job: "ns/j1"}
+	n1, n2 := &api.NodeInfo{Name: "n1"}, &api.NodeInfo{Name: "n2"}
+	assert.NoError(t, ssn.PredicateFn(task, n1))
+	assert.Error(t, ssn.PredicateFn(task, n2))
+	_, err := ssn.NodeOrderFn(task, n1)
+	assert.NoError(t, err)
+	_, err = ssn.BatchNodeOrderFn(task, []*api.NodeInfo{n1})
+	assert.NoError(t, err)
+	task.NodeName = "n1"
+	ssn.decisionTrace.recordOperation(Allocate, task, "", OperationCommitted)
+
+	expected := &TaskTrace{
+		Job:               "ns/j1",
+		PredicateFailures: []PredicateFailure{{Plugin: "p2", Node: "n2", Reason: "node n2 is not allowed"}},
+		Scores:            map[string]map[string]float64{"p1": {"n1": 10}, "p2": {"n1": 5}},
+		Operations:        []OperationTrace{{Operation: "Allocate", Node: "n1", Result: OperationCommitted}},
+	}
+	assert.Equal(t, expected, tracer.Session("ssn-1").Task("ns/t1"))
+
+	recorder := httptest.NewRecorder()
+	tracer.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/decisions?session=latest&task=ns/t1", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	got := &TaskTrace{}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), got))
+	assert.Equal(t, expected, got)
+
+	recorder = httptest.NewRecorder()
+	tracer.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/decisions?session=latest&task=ns/t2", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestStatementDecisionTrace(t *testing.T) {
+	ssn, _, task, node := newTestSession(t)
+	tracer := NewDecisionTracer(1)
+	ssn.SetDecisionTracer(tracer)
+
+	stmt := NewStatement(ssn)
+	assert.NoError(t, stmt.Allocate(task, node))
+	stmt.Commit()
+
+	// The committed allocation is traced once, by the statement.
+	expected := []OperationTrace{{Operation: "Allocate", Node: node.Name, Result: OperationCommitted}}
+	assert.Equal(t, expected, tracer.Session(ssn.UID).Task(task.Namespace+"/"+task.Name).Operations)
+}
@@ -32,8 +32,19 @@ import (
 
 // OpenSession start the session
 func OpenSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Configuration) *Session {
+	return openSessionWithPlugins(cache, tiers, configurations, false)
+}
+
+// OpenDryRunSession starts a session which only simulates scheduling, like the sessions of the simulator and of
+// the what-if requests. It does not update the metrics of the scheduler.
+func OpenDryRunSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Configuration) *Session {
+	return openSessionWithPlugins(cache, tiers, configurations, true)
+}
+
+func openSessionWithPlugins(cache cache.Cache, tiers []conf.Tier, configurations []conf.Configuration, dryRun bool) *Session {
 	openStart := time.Now()
 	ssn := openSession(cache)
+	ssn.dryRun = dryRun
 	ssn.Tiers = tiers
 	ssn.Configurations = configurations
 	ssn.NodeMap = GenerateNodeMapAndSlice(ssn.Nodes)
@@ -48,23 +59,36 @@ func OpenSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Con
 				ssn.plugins[plugin.Name()] = plugin
 				onSessionOpenStart := time.Now()
 				plugin.OnSessionOpen(ssn)
-				metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionOpen, metrics.Duration(onSessionOpenStart))
+				if !ssn.DryRun() {
+					metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionOpen, metrics.Duration(onSessionOpenStart))
+				}
 			}
 		}
 	}
 
+	// Resolve the reserved nodes after all plugins are opened, so that they are known before any action runs.
+	ssn.ReservedNodes()
+
 	ssn.InitCycleState()
-	metrics.UpdateOpenSessionDuration(time.Since(openStart))
+	if !ssn.DryRun() {
+		metrics.UpdateOpenSessionDuration(time.Since(openStart))
+	}
 
 	return ssn
 }
 
 // CloseSession close the session
 func CloseSession(ssn *Session) {
+	if ssn.queuePositionEnabled {
+		updateQueuePositions(ssn)
+	}
+
 	for _, plugin := range ssn.plugins {
 		onSessionCloseStart := time.Now()
 		plugin.OnSessionClose(ssn)
-		metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionClose, metrics.Duration(onSessionCloseStart))
+		if !ssn.DryRun() {
+			metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionClose, metrics.Duration(onSessionCloseStart))
+		}
 	}
 
 	closeSession(ssn)
@@ -18,15 +18,19 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"time"
 
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
 	"volcano.sh/apis/pkg/apis/scheduling"
 	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
 const (
@@ -103,6 +107,109 @@ func isPodGroupStatusUpdated(newStatus, oldStatus scheduling.PodGroupStatus) boo
 	return !equality.Semantic.DeepEqual(newStatus, oldStatus) || isPodGroupConditionsUpdated(newCondition, oldCondition)
 }
 
+// updateQueuePositions sets the Queued condition on the waiting jobs, which tells their position in the queue by
+// JobOrderFn, the position of the queue by QueueOrderFn and, if the running durations of the jobs are known, when
+// the running jobs release enough resources for all the jobs up to the job. The estimate only accounts the jobs ahead
+// in the same queue, the jobs of the other queues may take the released resources first, so it is a lower bound of
+// the start time. The condition is removed from the other jobs. The message only changes with the positions and the
+// estimate, so that the status of the jobs is not written in every session.
+// It must be called before the plugins are closed, as the order functions rely on the state of the plugins.
+func updateQueuePositions(ssn *Session) {
+	waiting := map[api.QueueID]*util.PriorityQueue{}
+	for _, job := range ssn.Jobs {
+		if job.PodGroup == nil {
+			continue
+		}
+		if phase := getPodGroupPhase(job, false); phase != scheduling.PodGroupPending && phase != scheduling.PodGroupInqueue {
+			removePodGroupCondition(job, scheduling.PodGroupQueuedType)
+			continue
+		}
+		if _, found := ssn.Queues[job.Queue]; !found {
+			continue
+		}
+		if waiting[job.Queue] == nil {
+			waiting[job.Queue] = util.NewPriorityQueue(ssn.JobOrderFn)
+		}
+		waiting[job.Queue].Push(job)
+	}
+	if len(waiting) == 0 {
+		return
+	}
+
+	queues := util.NewPriorityQueue(ssn.QueueOrderFn)
+	for queueID := range waiting {
+		queues.Push(ssn.Queues[queueID])
+	}
+
+	idle := api.EmptyResource()
+	for _, node := range ssn.Nodes {
+		idle.Add(node.FutureIdle())
+	}
+	releases := api.ExpectedReleases(ssn.Jobs, ssn.StartTime)
+
+	for queuePosition := 1; !queues.Empty(); queuePosition++ {
+		queue := queues.Pop().(*api.QueueInfo)
+		jobs := waiting[queue.UID]
+
+		// The resources are released in order, so the jobs down the queue start no earlier than the ones ahead.
+		// Every queue is estimated against all the idle and released resources, as if it were alone.
+		required := api.EmptyResource()
+		available := idle.Clone()
+		next := 0
+		var start time.Time
+		for position := 1; !jobs.Empty(); position++ {
+			job := jobs.Pop().(*api.JobInfo)
+			required.Add(ssn.MissingResources(job))
+
+			message := fmt.Sprintf("position %d in queue %s, queue %d in scheduling order",
+				position, queue.Name, queuePosition)
+			for !required.LessEqual(available, api.Zero) && next < len(releases) {
+				available.Add(releases[next].Resource)
+				start = releases[next].End
+				next++
+			}
+			// Jobs fitting in the idle resources are not waiting for resources, their start time is unknown.
+			if !start.IsZero() && required.LessEqual(available, api.Zero) {
+				message += fmt.Sprintf(", estimated start at %s", start.Truncate(time.Minute).UTC().Format(time.RFC3339))
+			}
+			setPodGroupCondition(ssn, job, &scheduling.PodGroupCondition{
+				Type:    scheduling.PodGroupQueuedType,
+				Status:  v1.ConditionTrue,
+				Reason:  "Waiting",
+				Message: message,
+			})
+		}
+	}
+}
+
+// setPodGroupCondition sets the condition of the job, the transition time is kept if the condition is not changed.
+func setPodGroupCondition(ssn *Session, job *api.JobInfo, cond *scheduling.PodGroupCondition) {
+	conditions := job.PodGroup.Status.Conditions
+	for i, c := range conditions {
+		if c.Type != cond.Type {
+			continue
+		}
+		if c.Status == cond.Status && c.Reason == cond.Reason && c.Message == cond.Message {
+			return
+		}
+		conditions = append(conditions[:i:i], conditions[i+1:]...)
+		break
+	}
+	cond.TransitionID = string(ssn.UID)
+	cond.LastTransitionTime = metav1.Now()
+	job.PodGroup.Status.Conditions = append(conditions, *cond)
+}
+
+func removePodGroupCondition(job *api.JobInfo, condType scheduling.PodGroupConditionType) {
+	for i, c := range job.PodGroup.Status.Conditions {
+		if c.Type == condType {
+			conditions := job.PodGroup.Status.Conditions
+			job.PodGroup.Status.Conditions = append(conditions[:i:i], conditions[i+1:]...)
+			return
+		}
+	}
+}
+
 func (ju *JobUpdater) isJobAllocatedHyperNodeChanged(job *api.JobInfo) bool {
 	oldHyperNode := ju.ssn.PodGroupOldState.Annotations[job.UID][api.JobAllocatedHyperNode]
 	return oldHyperNode != job.PodGroup.GetAnnotations()[api.JobAllocatedHyperNode]
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+	commonutil "volcano.sh/volcano/pkg/util"
+)
+
+func TestUpdateQueuePositions(t *testing.T) {
+	created := metav1.Now()
+	sessionStart := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	buildJob := func(name, queue string, phase scheduling.PodGroupPhase, runningEstimate string, pods ...*v1.Pod) *api.JobInfo {
+		job := api.NewJobInfo(api.JobID("c1/" + name))
+		pg := &api.PodGroup{PodGroup: scheduling.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "c1", CreationTimestamp: created, Annotations: map[string]string{}},
+			Spec:       scheduling.PodGroupSpec{Queue: queue, MinMember: int32(len(pods))},
+			Status:     scheduling.PodGroupStatus{Phase: phase},
+		}}
+		if runningEstimate != "" {
+			pg.Annotations[commonutil.JobRunningEstimate] = runningEstimate
+		}
+		job.SetPodGroup(pg)
+		for _, pod := range pods {
+			job.AddTaskInfo(api.NewTaskInfo(pod))
+		}
+		return job
+	}
+	runningPod := func(name, pg string) *v1.Pod {
+		pod := util.BuildPod("c1", name, "n1", v1.PodRunning, api.BuildResourceList("4", "4Gi"), pg, nil, nil)
+		pod.Status.StartTime = &metav1.Time{Time: sessionStart.Add(-30 * time.Minute)}
+		return pod
+	}
+	pendingPod := func(name, pg, cpu string) *v1.Pod {
+		return util.BuildPod("c1", name, "", v1.PodPending, api.BuildResourceList(cpu, "4Gi"), pg, nil, nil)
+	}
+	buildSession := func(runningEstimate string) *Session {
+		running := buildJob("running", "q1", scheduling.PodGroupRunning, runningEstimate, runningPod("r0", "running"), runningPod("r1", "running"))
+		// A stale condition of a job which is not waiting anymore is removed.
+		running.PodGroup.Status.Conditions = []scheduling.PodGroupCondition{{Type: scheduling.PodGroupQueuedType, Status: v1.ConditionTrue}}
+		jobs := []*api.JobInfo{
+			running,
+			buildJob("j1", "q1", scheduling.PodGroupPending, "", pendingPod("j1-0", "j1", "4")),
+			buildJob("j2", "q1", scheduling.PodGroupInqueue, "", pendingPod("j2-0", "j2", "4")),
+			buildJob("j3", "q1", scheduling.PodGroupPending, "", pendingPod("j3-0", "j3", "8")),
+			buildJob("j4", "q2", scheduling.PodGroupPending, "", pendingPod("j4-0", "j4", "1")),
+		}
+
+		ssn := &Session{
+			UID:       "ssn-1",
+			StartTime: sessionStart,
+			Jobs:      map[api.JobID]*api.JobInfo{},
+			Nodes:     map[string]*api.NodeInfo{},
+			Queues:    map[api.QueueID]*api.QueueInfo{},
+		}
+		for _, job := range jobs {
+			ssn.Jobs[job.UID] = job
+		}
+		for _, name := range []string{"q1", "q2"} {
+			ssn.Queues[api.QueueID(name)] = api.NewQueueInfo(&scheduling.Queue{ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: created}})
+		}
+		return ssn
+	}
+
+	tests := []struct {
+		name            string
+		runningEstimate string
+		expected        map[api.JobID]string
+	}{
+		{
+			name: "running durations are unknown",
+			expected: map[api.JobID]string{
+				"c1/running": "",
+				"c1/j1":      "position 1 in queue q1, queue 1 in scheduling order",
+				"c1/j2":      "position 2 in queue q1, queue 1 in scheduling order",
+				"c1/j3":      "position 3 in queue q1, queue 1 in scheduling order",
+				"c1/j4":      "position 1 in queue q2, queue 2 in scheduling order",
+			},
+		},
+		{
+			// The running tasks release 8 cpus at 12:30, which is not enough for the jobs up to j3.
+			name:            "running durations are known",
+			runningEstimate: "1h",
+			expected: map[api.JobID]string{
+				"c1/running": "",
+				"c1/j1":      "position 1 in queue q1, queue 1 in scheduling order, estimated start at 2026-01-01T12:30:00Z",
+				"c1/j2":      "position 2 in queue q1, queue 1 in scheduling order, estimated start at 2026-01-01T12:30:00Z",
+				"c1/j3":      "position 3 in queue q1, queue 1 in scheduling order",
+				"c1/j4":      "position 1 in queue q2, queue 2 in scheduling order, estimated start at 2026-01-01T12:30:00Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ssn := buildSession(test.runningEstimate)
+			updateQueuePositions(ssn)
+
+			for id, message := range test.expected {
+				var conditions []scheduling.PodGroupCondition
+				for _, c := range ssn.Jobs[id].PodGroup.Status.Conditions {
+					if c.Type == scheduling.PodGroupQueuedType {
+						conditions = append(conditions, c)
+					}
+				}
+				if message == "" {
+					assert.Empty(t, conditions, "job %s", id)
+					continue
+				}
+				if assert.Len(t, conditions, 1, "job %s", id) {
+					assert.Equal(t, message, conditions[0].Message, "job %s", id)
+					assert.Equal(t, "ssn-1", conditions[0].TransitionID, "job %s", id)
+				}
+			}
+
+			// An unchanged position keeps its transition, even if jobs are added behind it.
+			ssn.UID = "ssn-2"
+			j5 := buildJob("j5", "q1", scheduling.PodGroupPending, "", pendingPod("j5-0", "j5", "1"))
+			j5.CreationTimestamp = metav1.NewTime(created.Add(time.Minute))
+			ssn.Jobs[j5.UID] = j5
+			updateQueuePositions(ssn)
+			assert.Equal(t, "ssn-1", ssn.Jobs["c1/j1"].PodGroup.Status.Conditions[0].TransitionID)
+			assert.Equal(t, "ssn-1", ssn.Jobs["c1/j3"].PodGroup.Status.Conditions[0].TransitionID)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+// StageCondition tells whether the cluster state of the session calls for the action of a stage.
+type StageCondition func(ssn *Session) bool
+
+const (
+	// ConditionStarvingQueue holds if a queue which is not overused has a starving job, which reclaim serves.
+	ConditionStarvingQueue = "starvingQueue"
+	// ConditionStarvingJob holds if a job is starving, which preempt serves.
+	ConditionStarvingJob = "starvingJob"
+	// ConditionPendingJob holds if a job has pending tasks.
+	ConditionPendingJob = "pendingJob"
+)
+
+var stageConditionMutex sync.RWMutex
+
+var stageConditions = map[string]StageCondition{
+	ConditionStarvingQueue: func(ssn *Session) bool {
+		for _, job := range ssn.Jobs {
+			if job.IsPending() || !ssn.JobStarving(job) {
+				continue
+			}
+			if queue, found := ssn.Queues[job.Queue]; found && !ssn.Overused(queue) {
+				return true
+			}
+		}
+		return false
+	},
+	ConditionStarvingJob: func(ssn *Session) bool {
+		for _, job := range ssn.Jobs {
+			if !job.IsPending() && ssn.JobStarving(job) {
+				return true
+			}
+		}
+		return false
+	},
+	ConditionPendingJob: func(ssn *Session) bool {
+		for _, job := range ssn.Jobs {
+			if len(job.TaskStatusIndex[api.Pending]) > 0 {
+				return true
+			}
+		}
+		return false
+	},
+}
+
+// RegisterStageCondition register the condition which the stages of the pipeline refer to by name in when
+func RegisterStageCondition(name string, condition StageCondition) {
+	stageConditionMutex.Lock()
+	defer stageConditionMutex.Unlock()
+
+	stageConditions[name] = condition
+}
+
+func getStageCondition(name string) (StageCondition, bool) {
+	stageConditionMutex.RLock()
+	defer stageConditionMutex.RUnlock()
+
+	condition, found := stageConditions[name]
+	return condition, found
+}
+
+// Stage is an action of the pipeline, it runs the action only in the sessions which meet its conditions,
+// and within its time budget.
+type Stage struct {
+	Action
+
+	everySessions int
+	interval      time.Duration
+	when          []string
+	timeout       time.Duration
+	// arguments of the action in this stage only, the same action may run in several stages.
+	arguments map[string]interface{}
+
+	// The sessions counted since the stage was loaded, and the last time the action ran.
+	sessions int
+	lastRun  time.Time
+}
+
+// NewStage returns the stage of the action configured by the stage option.
+func NewStage(action Action, option conf.Stage) (*Stage, error) {
+	stage := &Stage{Action: action, everySessions: option.EverySessions, when: option.When, arguments: option.Arguments}
+	if option.EverySessions < 0 {
+		return nil, fmt.Errorf("everySessions of action %s must not be negative", option.Action)
+	}
+	if option.Interval != "" {
+		interval, err := time.ParseDuration(option.Interval)
+		if err != nil || interval < 0 {
+			return nil, fmt.Errorf("invalid interval %q of action %s", option.Interval, option.Action)
+		}
+		stage.interval = interval
+	}
+	if option.Timeout != "" {
+		timeout, err := time.ParseDuration(option.Timeout)
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid timeout %q of action %s", option.Timeout, option.Action)
+		}
+		stage.timeout = timeout
+	}
+	for _, name := range option.When {
+		if _, found := getStageCondition(name); !found {
+			return nil, fmt.Errorf("unknown condition %s of action %s", name, option.Action)
+		}
+	}
+	return stage, nil
+}
+
+// Unwrap returns the action of the stage.
+func (s *Stage) Unwrap() Action {
+	return s.Action
+}
+
+// Execute runs the action if the stage is due and its conditions hold.
+func (s *Stage) Execute(ssn *Session) {
+	now := time.Now()
+	s.sessions++
+	if s.everySessions > 1 && (s.sessions-1)%s.everySessions != 0 {
+		klog.V(4).Infof("Skip action %s in session %d, it runs every %d sessions", s.Name(), s.sessions, s.everySessions)
+		registerStageResult(ssn, s.Name(), metrics.StageSkipped)
+		return
+	}
+	if s.interval > 0 && !s.lastRun.IsZero() && now.Sub(s.lastRun) < s.interval {
+		klog.V(4).Infof("Skip action %s, it ran %v ago and runs every %v", s.Name(), now.Sub(s.lastRun), s.interval)
+		registerStageResult(ssn, s.Name(), metrics.StageSkipped)
+		return
+	}
+	for _, name := range s.when {
+		if condition, found := getStageCondition(name); found && !condition(ssn) {
+			klog.V(4).Infof("Skip action %s, condition %s does not hold", s.Name(), name)
+			registerStageResult(ssn, s.Name(), metrics.StageSkipped)
+			return
+		}
+	}
+	s.lastRun = now
+
+	if len(s.arguments) != 0 {
+		configurations := ssn.Configurations
+		ssn.Configurations = withActionArguments(configurations, s.Name(), s.arguments)
+		defer func() {
+			ssn.Configurations = configurations
+		}()
+	}
+	if s.timeout > 0 {
+		ssn.actionDeadline = now.Add(s.timeout)
+		defer func() {
+			ssn.actionDeadline = time.Time{}
+		}()
+	}
+	s.Action.Execute(ssn)
+
+	if ssn.ActionTimedOut() {
+		klog.Warningf("Action %s was cut short after its time budget %v", s.Name(), s.timeout)
+		registerStageResult(ssn, s.Name(), metrics.StageTimedOut)
+		return
+	}
+	registerStageResult(ssn, s.Name(), metrics.StageCompleted)
+}
+
+// registerStageResult counts the result of the stage, unless the session is a dry run.
+func registerStageResult(ssn *Session, name, result string) {
+	if !ssn.DryRun() {
+		metrics.RegisterActionStageResult(name, result)
+	}
+}
+
+// withActionArguments returns a copy of the configurations in which the arguments are set in the configuration of
+// the action, the configurations themselves are not changed.
+func withActionArguments(configurations []conf.Configuration, actionName string, arguments map[string]interface{}) []conf.Configuration {
+	result := make([]conf.Configuration, 0, len(configurations)+1)
+	merged := false
+	for _, configuration := range configurations {
+		if configuration.Name == actionName && !merged {
+			args := make(map[string]interface{}, len(configuration.Arguments)+len(arguments))
+			for key, value := range configuration.Arguments {
+				args[key] = value
+			}
+			for key, value := range arguments {
+				args[key] = value
+			}
+			configuration.Arguments = args
+			merged = true
+		}
+		result = append(result, configuration)
+	}
+	if !merged {
+		result = append(result, conf.Configuration{Name: actionName, Arguments: arguments})
+	}
+	return result
+}
+
+// ActionTimedOut returns whether the running action has spent its time budget. The actions check it between
+// units of work, e.g. jobs or queues, and stop early once it is true, leaving the rest for the next session.
+func (ssn *Session) ActionTimedOut() bool {
+	return !ssn.actionDeadline.IsZero() && time.Now().After(ssn.actionDeadline)
+}
+
+// UnwrapStages returns the actions of the stages, without their conditions and time budgets.
+func UnwrapStages(actions []Action) []Action {
+	unwrapped := make([]Action, 0, len(actions))
+	for _, action := range actions {
+		if stage, ok := action.(*Stage); ok {
+			action = stage.Unwrap()
+		}
+		unwrapped = append(unwrapped, action)
+	}
+	return unwrapped
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+type fakeAction struct {
+	runs     int
+	timedOut bool
+	// work is the time an execution takes unless it is cut short.
+	work time.Duration
+	// modes are the argument "mode" of the executions.
+	modes []string
+}
+
+func (fa *fakeAction) Name() string { return "fake" }
+
+func (fa *fakeAction) Initialize() {}
+
+func (fa *fakeAction) Execute(ssn *Session) {
+	fa.runs++
+	mode := ""
+	GetArgOfActionFromConf(ssn.Configurations, fa.Name()).GetString(&mode, "mode")
+	fa.modes = append(fa.modes, mode)
+	for start := time.Now(); time.Since(start) < fa.work; time.Sleep(time.Millisecond) {
+		if ssn.ActionTimedOut() {
+			fa.timedOut = true
+			return
+		}
+	}
+}
+
+func (fa *fakeAction) UnInitialize() {}
+
+func TestStage(t *testing.T) {
+	hold := false
+	RegisterStageCondition("testHold", func(_ *Session) bool { return hold })
+
+	tests := []struct {
+		name         string
+		option       conf.Stage
+		sessions     int
+		expectedRuns int
+	}{
+		{
+			name:         "no conditions",
+			option:       conf.Stage{Action: "fake"},
+			sessions:     3,
+			expectedRuns: 3,
+		},
+		{
+			name:         "every 2 sessions",
+			option:       conf.Stage{Action: "fake", EverySessions: 2},
+			sessions:     5,
+			expectedRuns: 3,
+		},
+		{
+			name:         "at most once per interval",
+			option:       conf.Stage{Action: "fake", Interval: "1h"},
+			sessions:     3,
+			expectedRuns: 1,
+		},
+		{
+			name:         "condition does not hold",
+			option:       conf.Stage{Action: "fake", When: []string{"testHold"}},
+			sessions:     3,
+			expectedRuns: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			action := &fakeAction{}
+			stage, err := NewStage(action, test.option)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < test.sessions; i++ {
+				stage.Execute(&Session{})
+			}
+			if action.runs != test.expectedRuns {
+				t.Errorf("expected %d runs, got %d", test.expectedRuns, action.runs)
+			}
+		})
+	}
+
+	hold = true
+	action := &fakeAction{}
+	stage, _ := NewStage(action, conf.Stage{Action: "fake", When: []string{"testHold"}})
+	stage.Execute(&Session{})
+	if action.runs != 1 {
+		t.Errorf("expected the action to run once its condition holds, got %d runs", action.runs)
+	}
+}
+
+func TestStageTimeout(t *testing.T) {
+	action := &fakeAction{work: time.Minute}
+	stage, err := NewStage(action, conf.Stage{Action: "fake", Timeout: "20ms"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ssn := &Session{}
+	start := time.Now()
+	stage.Execute(ssn)
+	if !action.timedOut || time.Since(start) > 10*time.Second {
+		t.Errorf("expected the action to be cut short by its time budget")
+	}
+	if ssn.ActionTimedOut() {
+		t.Errorf("expected the time budget to be cleared after the action")
+	}
+}
+
+func TestStageArguments(t *testing.T) {
+	action := &fakeAction{}
+	fast, err := NewStage(action, conf.Stage{Action: "fake", Arguments: map[string]interface{}{"mode": "fast"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	thorough, err := NewStage(action, conf.Stage{Action: "fake", Arguments: map[string]interface{}{"mode": "thorough"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain, err := NewStage(action, conf.Stage{Action: "fake"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configurations := []conf.Configuration{{Name: "fake", Arguments: map[string]interface{}{"mode": "default"}}}
+	ssn := &Session{Configurations: configurations}
+	for _, stage := range []*Stage{fast, thorough, plain} {
+		stage.Execute(ssn)
+	}
+
+	expected := []string{"fast", "thorough", "default"}
+	if !reflect.DeepEqual(action.modes, expected) {
+		t.Errorf("expected the stages to run with modes %v, got %v", expected, action.modes)
+	}
+	if mode := configurations[0].Arguments["mode"]; mode != "default" {
+		t.Errorf("expected the configurations to be kept, got mode %v", mode)
+	}
+}
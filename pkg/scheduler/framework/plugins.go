@@ -4,6 +4,7 @@ Copyright 2018-2023 The Volcano Authors.
 
 Modifications made by Volcano authors:
 - Added support for loading custom plugins from external .so files
+- Added support for loaders of other custom plugin files, e.g. WASM modules
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -22,6 +23,7 @@ package framework
 
 import (
 	"fmt"
+	"maps"
 	"path/filepath"
 	"plugin"
 	"strings"
@@ -54,6 +56,14 @@ func CleanupPluginBuilders() {
 	pluginBuilders = map[string]PluginBuilder{}
 }
 
+// GetPluginBuilders returns a copy of all the registered plugin builders by name
+func GetPluginBuilders() map[string]PluginBuilder {
+	pluginMutex.RLock()
+	defer pluginMutex.RUnlock()
+
+	return maps.Clone(pluginBuilders)
+}
+
 // GetPluginBuilder get the pluginbuilder by name
 func GetPluginBuilder(name string) (PluginBuilder, bool) {
 	pluginMutex.RLock()
@@ -63,11 +73,37 @@ func GetPluginBuilder(name string) (PluginBuilder, bool) {
 	return pb, found
 }
 
+// PluginLoader builds the plugin of a custom plugin file
+type PluginLoader = func(pluginPath string) (PluginBuilder, error)
+
+// Custom plugin loaders by file extension
+var pluginLoaders = map[string]PluginLoader{".so": loadPluginBuilder}
+
+// RegisterPluginLoader register the loader of the custom plugin files with the extension, e.g. ".wasm"
+func RegisterPluginLoader(ext string, loader PluginLoader) {
+	pluginMutex.Lock()
+	defer pluginMutex.Unlock()
+
+	pluginLoaders[ext] = loader
+}
+
+func getPluginLoader(ext string) (PluginLoader, bool) {
+	pluginMutex.RLock()
+	defer pluginMutex.RUnlock()
+
+	loader, found := pluginLoaders[ext]
+	return loader, found
+}
+
 // LoadCustomPlugins loads custom implement plugins
 func LoadCustomPlugins(pluginsDir string) error {
-	pluginPaths, _ := filepath.Glob(fmt.Sprintf("%s/*.so", pluginsDir))
+	pluginPaths, _ := filepath.Glob(fmt.Sprintf("%s/*", pluginsDir))
 	for _, pluginPath := range pluginPaths {
-		pluginBuilder, err := loadPluginBuilder(pluginPath)
+		loader, found := getPluginLoader(filepath.Ext(pluginPath))
+		if !found {
+			continue
+		}
+		pluginBuilder, err := loader(pluginPath)
 		if err != nil {
 			return err
 		}
@@ -121,3 +157,30 @@ func GetAction(name string) (Action, bool) {
 	act, found := actionMap[name]
 	return act, found
 }
+
+// actionBuilders are the builders of the actions registered by RegisterActionBuilder
+var actionBuilders = map[string]func() Action{}
+
+// RegisterActionBuilder registers the action built by builder, and builder itself, so that every loaded
+// scheduler configuration gets its own instances of the action by NewAction.
+func RegisterActionBuilder[A Action](builder func() A) {
+	act := builder()
+
+	pluginMutex.Lock()
+	defer pluginMutex.Unlock()
+
+	actionMap[act.Name()] = act
+	actionBuilders[act.Name()] = func() Action { return builder() }
+}
+
+// NewAction returns a new instance of the action by name, or the registered one if the action has no builder.
+func NewAction(name string) (Action, bool) {
+	pluginMutex.RLock()
+	defer pluginMutex.RUnlock()
+
+	if builder, found := actionBuilders[name]; found {
+		return builder(), true
+	}
+	act, found := actionMap[name]
+	return act, found
+}
@@ -28,6 +28,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -50,6 +51,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/cache"
 	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/decisionlog"
 	"volcano.sh/volcano/pkg/scheduler/gate"
 	"volcano.sh/volcano/pkg/scheduler/util"
 )
@@ -65,11 +67,14 @@ const (
 // Session information for the current session
 type Session struct {
 	UID types.UID
+	// StartTime is when the session was opened, the time budgets of the session are counted from it.
+	StartTime time.Time
 
 	kubeClient      kubernetes.Interface
 	vcClient        vcclient.Interface
 	recorder        record.EventRecorder
 	cache           cache.Cache
+	snapshot        *api.ClusterInfo
 	restConfig      *rest.Config
 	informerFactory informers.SharedInformerFactory
 
@@ -79,11 +84,31 @@ type Session struct {
 	PodGroupOldState *api.PodGroupOldState
 	// DirtyJobs include the jobs that need to flush to SchedulerCache on session close
 	DirtyJobs sets.Set[api.JobID]
+	// DirtyQueues include the queues whose status is changed by plugins and needs to be updated on session close
+	DirtyQueues sets.Set[api.QueueID]
 
 	// schGateManager is the scheduler gate manager, passed in from the Scheduler.
 	// Nil when SchedulingGatesQueueAdmission feature gate is disabled.
 	schGateManager *gate.SchGateManager
 
+	// decisionTrace records the scheduling decisions of the session, nil if decision tracing is disabled.
+	decisionTrace *SessionTrace
+
+	// nodeScoresKept is the number of the highest scores of the nodes kept for a task for its binding decision,
+	// 0 if the decision log is disabled.
+	nodeScoresKept int
+	// nodeScores are the highest scores of the nodes for the tasks, by the uid of the tasks.
+	nodeScores sync.Map
+
+	// dryRun is true if the session only simulates scheduling, see OpenDryRunSession.
+	dryRun bool
+
+	// queuePositionEnabled sets the Queued condition on the waiting jobs on session close.
+	queuePositionEnabled bool
+
+	// actionDeadline is the end of the time budget of the running action, zero if it has no budget.
+	actionDeadline time.Time
+
 	Jobs           map[api.JobID]*api.JobInfo
 	Nodes          map[string]*api.NodeInfo
 	CSINodesStatus map[string]*api.CSINodeStatusInfo
@@ -130,6 +155,7 @@ type Session struct {
 	preemptableFns      map[string]api.EvictableFn
 	reclaimableFns      map[string]api.EvictableFn
 	unifiedEvictableFns map[string]api.UnifiedEvictableFn
+	victimCostFns       map[string]api.VictimCostFn
 	overusedFns         map[string]api.ValidateFn
 	// preemptiveFns means whether current queue can reclaim from other queue,
 	// while reclaimableFns means whether current queue's resources can be reclaimed.
@@ -168,6 +194,7 @@ func openSession(cache cache.Cache) *Session {
 	cache.OnSessionOpen()
 	ssn := &Session{
 		UID:             uuid.NewUUID(),
+		StartTime:       time.Now(),
 		kubeClient:      cache.Client(),
 		vcClient:        cache.VCClient(),
 		restConfig:      cache.ClientConfig(),
@@ -181,6 +208,7 @@ func openSession(cache cache.Cache) *Session {
 			Annotations: map[api.JobID]map[string]string{},
 		},
 		DirtyJobs:      sets.New[api.JobID](),
+		DirtyQueues:    sets.New[api.QueueID](),
 		Jobs:           map[api.JobID]*api.JobInfo{},
 		Nodes:          map[string]*api.NodeInfo{},
 		CSINodesStatus: map[string]*api.CSINodeStatusInfo{},
@@ -204,6 +232,7 @@ func openSession(cache cache.Cache) *Session {
 		preemptableFns:                map[string]api.EvictableFn{},
 		reclaimableFns:                map[string]api.EvictableFn{},
 		unifiedEvictableFns:           map[string]api.UnifiedEvictableFn{},
+		victimCostFns:                 map[string]api.VictimCostFn{},
 		overusedFns:                   map[string]api.ValidateFn{},
 		preemptiveFns:                 map[string]api.ValidateWithCandidateFn{},
 		allocatableFns:                map[string]api.AllocatableFn{},
@@ -228,6 +257,7 @@ func openSession(cache cache.Cache) *Session {
 	}
 
 	snapshot := cache.Snapshot()
+	ssn.snapshot = snapshot
 
 	ssn.Jobs = snapshot.Jobs
 	for _, job := range ssn.Jobs {
@@ -542,7 +572,7 @@ func updateQueueStatus(ssn *Session) {
 		var queueStatus = util.ConvertRes2ResList(allocatedResources[queueID]).DeepCopy()
 		queueStatus = mergeDRAAllocatedIntoResourceList(queueStatus, allocatedDRAResources[queueID])
 
-		if equality.Semantic.DeepEqual(ssn.Queues[queueID].Queue.Status.Allocated, queueStatus) {
+		if equality.Semantic.DeepEqual(ssn.Queues[queueID].Queue.Status.Allocated, queueStatus) && !ssn.DirtyQueues.Has(queueID) {
 			klog.V(5).Infof("Queue <%s> allocated resource keeps equal, no need to update queue status <%v>.",
 				queueID, ssn.Queues[queueID].Queue.Status.Allocated)
 			continue
@@ -562,6 +592,8 @@ func closeSession(ssn *Session) {
 
 	updateQueueStatus(ssn)
 
+	ssn.cache.ReleaseSnapshot(ssn.snapshot)
+	ssn.snapshot = nil
 	ssn.Jobs = nil
 	ssn.Nodes = nil
 	ssn.RevocableNodes = nil
@@ -631,6 +663,25 @@ func jobStatus(ssn *Session, jobInfo *api.JobInfo) scheduling.PodGroupStatus {
 	return status
 }
 
+// MissingResources returns the resources of the pending tasks, taken in TaskOrderFn, which are still needed
+// for the job to be ready.
+func (ssn *Session) MissingResources(job *api.JobInfo) *api.Resource {
+	required := api.EmptyResource()
+	missing := job.MinAvailable - job.ReadyTaskNum() - job.WaitingTaskNum()
+	if missing <= 0 {
+		return required
+	}
+
+	tasks := util.NewPriorityQueue(ssn.TaskOrderFn)
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		tasks.Push(task)
+	}
+	for ; missing > 0 && !tasks.Empty(); missing-- {
+		required.Add(tasks.Pop().(*api.TaskInfo).InitResreq)
+	}
+	return required
+}
+
 // FilterOutUnschedulableAndUnresolvableNodesForTask filter out those node that has UnschedulableAndUnresolvable
 func (ssn *Session) FilterOutUnschedulableAndUnresolvableNodesForTask(task *api.TaskInfo) []*api.NodeInfo {
 	fitErrors, ok1 := ssn.Jobs[task.Job]
@@ -824,6 +875,10 @@ func (ssn *Session) CreateBindContext(task *api.TaskInfo) *cache.BindContext {
 	bindContext := &cache.BindContext{
 		TaskInfo:   task,
 		Extensions: make(map[string]cache.BindContextExtension),
+		SessionID:  ssn.UID,
+	}
+	if scores, found := ssn.nodeScores.Load(task.UID); found {
+		bindContext.NodeScores = scores.([]decisionlog.NodeScore)
 	}
 
 	for _, plugin := range ssn.plugins {
@@ -960,6 +1015,39 @@ func (ssn *Session) SetSchGateManager(m *gate.SchGateManager) {
 	ssn.schGateManager = m
 }
 
+// KeepNodeScores keeps up to n highest scores of the nodes for every task recorded by RecordNodeScores, which go
+// into the binding decisions of the tasks.
+func (ssn *Session) KeepNodeScores(n int) {
+	ssn.nodeScoresKept = n
+}
+
+// RecordNodeScores records the scores of the nodes for the task, it does nothing unless KeepNodeScores is called.
+func (ssn *Session) RecordNodeScores(task *api.TaskInfo, nodeScores map[float64][]*api.NodeInfo) {
+	if ssn.nodeScoresKept <= 0 {
+		return
+	}
+	ssn.nodeScores.Store(task.UID, decisionlog.TopNodeScores(nodeScores, ssn.nodeScoresKept))
+}
+
+// DryRun tells whether the session only simulates scheduling, such a session must not update the metrics of the
+// scheduler.
+func (ssn *Session) DryRun() bool {
+	return ssn.dryRun
+}
+
+// EnableQueuePosition sets the position of the waiting jobs in their queue on their status on session close.
+func (ssn *Session) EnableQueuePosition(enabled bool) {
+	ssn.queuePositionEnabled = enabled
+}
+
+// SetDecisionTracer starts recording the scheduling decisions of the session into the tracer.
+func (ssn *Session) SetDecisionTracer(tracer *DecisionTracer) {
+	if tracer == nil {
+		return
+	}
+	ssn.decisionTrace = tracer.newSessionTrace(ssn.UID)
+}
+
 // VCClient returns the volcano client
 func (ssn *Session) VCClient() vcclient.Interface {
 	return ssn.vcClient
@@ -1016,6 +1104,11 @@ func (ssn *Session) MarkJobDirty(jobID api.JobID) {
 	ssn.DirtyJobs.Insert(jobID)
 }
 
+// MarkQueueDirty marks the status of the queue to be updated on session close.
+func (ssn *Session) MarkQueueDirty(queueID api.QueueID) {
+	ssn.DirtyQueues.Insert(queueID)
+}
+
 // String return nodes and jobs information in the session
 func (ssn *Session) String() string {
 	var b strings.Builder
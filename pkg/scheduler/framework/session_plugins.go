@@ -22,6 +22,7 @@ package framework
 
 import (
 	"context"
+	"time"
 
 	fwk "k8s.io/kube-scheduler/framework"
 
@@ -80,6 +81,14 @@ func (ssn *Session) AddUnifiedEvictableFn(name string, fn api.UnifiedEvictableFn
 	ssn.unifiedEvictableFns[name] = fn
 }
 
+// AddVictimCostFn registers a VictimCostFn to estimate the cost of evicting victims.
+func (ssn *Session) AddVictimCostFn(name string, fn api.VictimCostFn) {
+	if ssn.victimCostFns == nil {
+		ssn.victimCostFns = map[string]api.VictimCostFn{}
+	}
+	ssn.victimCostFns[name] = fn
+}
+
 // AddJobReadyFn add JobReady function
 func (ssn *Session) AddJobReadyFn(name string, vf api.ValidateFn) {
 	ssn.jobReadyFns[name] = vf
@@ -361,6 +370,39 @@ func (ssn *Session) UnifiedEvictable(ctx *api.EvictionContext, candidates []*api
 	return victims
 }
 
+// VictimCostEnabled returns whether any plugin estimates the cost of evicting victims.
+func (ssn *Session) VictimCostEnabled() bool {
+	for _, tier := range ssn.Tiers {
+		for _, plugin := range tier.Plugins {
+			if !isEnabled(plugin.EnabledVictimCost) {
+				continue
+			}
+			if _, found := ssn.victimCostFns[plugin.Name]; found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// VictimCost returns the cost of evicting the victims together, summed up over the plugins.
+func (ssn *Session) VictimCost(ctx *api.EvictionContext, victims []*api.TaskInfo) api.VictimCost {
+	cost := api.VictimCost{}
+	for _, tier := range ssn.Tiers {
+		for _, plugin := range tier.Plugins {
+			if !isEnabled(plugin.EnabledVictimCost) {
+				continue
+			}
+			fn, found := ssn.victimCostFns[plugin.Name]
+			if !found {
+				continue
+			}
+			cost.Add(fn(ctx, victims))
+		}
+	}
+	return cost
+}
+
 // Overused invoke overused function of the plugins
 func (ssn *Session) Overused(queue *api.QueueInfo) bool {
 	for _, tier := range ssn.Tiers {
@@ -859,6 +901,7 @@ func (ssn *Session) PredicateFn(task *api.TaskInfo, node *api.NodeInfo) error {
 			}
 			err := pfn(task, node)
 			if err != nil {
+				ssn.decisionTrace.recordPredicateFailure(task, node, plugin.Name, err)
 				return err
 			}
 		}
@@ -1002,6 +1045,7 @@ func (ssn *Session) NodeOrderFn(task *api.TaskInfo, node *api.NodeInfo) (float64
 			if err != nil {
 				return 0, err
 			}
+			ssn.decisionTrace.recordScore(task, plugin.Name, node.Name, score)
 			priorityScore += score
 		}
 	}
@@ -1025,6 +1069,7 @@ func (ssn *Session) BatchNodeOrderFn(task *api.TaskInfo, nodes []*api.NodeInfo)
 				return nil, err
 			}
 			for nodeName, score := range score {
+				ssn.decisionTrace.recordScore(task, plugin.Name, nodeName, score)
 				priorityScore[nodeName] += score
 			}
 		}
@@ -1050,6 +1095,7 @@ func (ssn *Session) NodeOrderMapFn(task *api.TaskInfo, node *api.NodeInfo) (map[
 				if err != nil {
 					return nodeScoreMap, priorityScore, err
 				}
+				ssn.decisionTrace.recordScore(task, plugin.Name, node.Name, score)
 				priorityScore += score
 			}
 			if pfn, found := ssn.nodeMapFns[plugin.Name]; found {
@@ -1103,6 +1149,7 @@ func (ssn *Session) NodeOrderReduceFn(task *api.TaskInfo, pluginNodeScoreMap map
 				return nodeScoreMap, err
 			}
 			for _, hp := range pluginNodeScoreMap[plugin.Name] {
+				ssn.decisionTrace.recordScore(task, plugin.Name, hp.Name, float64(hp.Score))
 				nodeScoreMap[hp.Name] += float64(hp.Score)
 			}
 		}
@@ -1153,26 +1200,39 @@ func (ssn *Session) HyperNodeGradientForSubJobFn(subJob *api.SubJobInfo, hyperNo
 }
 
 // BuildVictimsPriorityQueue returns a priority queue with victims sorted by:
-//  1. If victims belong to the same job, use !ssn.TaskOrderFn.
+//  1. If victims belong to the same job, use !ssn.TaskOrderFn, except that the
+//     victim which checkpointed more recently is preferred over the default task order.
 //  2. If either victim's job is missing, evict orphaned tasks first; if both
 //     are orphaned, use !ssn.TaskOrderFn.
 //  3. If the preemptor job is missing or victims are in the same queue, compare
-//     jobs with JobOrderCompareFn and use !ssn.TaskOrderFn as a tie-break.
+//     jobs with JobOrderCompareFn and use the victim task order of 1 as a tie-break.
 //  4. If victims are in different queues and preemptor job exists, use
 //     ssn.VictimQueueOrderFn.
 func (ssn *Session) BuildVictimsPriorityQueue(victims []*api.TaskInfo, preemptor *api.TaskInfo) *util.PriorityQueue {
+	victimTaskOrder := func(l, r interface{}) bool {
+		if res := ssn.TaskCompareFns(l, r); res != 0 {
+			return res > 0
+		}
+		lv := l.(*api.TaskInfo)
+		rv := r.(*api.TaskInfo)
+		if cmp := compareLastCheckpoint(lv, rv); cmp != 0 {
+			return cmp < 0
+		}
+		return !helpers.CompareTask(lv, rv)
+	}
+
 	jobThenTaskOrder := func(lvJob, rvJob *api.JobInfo, l, r interface{}) bool {
 		if cmp := ssn.JobOrderCompareFn(lvJob, rvJob); cmp != 0 {
 			return cmp > 0
 		}
-		return !ssn.TaskOrderFn(l, r)
+		return victimTaskOrder(l, r)
 	}
 
 	victimsQueue := util.NewPriorityQueue(func(l, r interface{}) bool {
 		lv := l.(*api.TaskInfo)
 		rv := r.(*api.TaskInfo)
 		if lv.Job == rv.Job {
-			return !ssn.TaskOrderFn(l, r)
+			return victimTaskOrder(l, r)
 		}
 
 		lvJob, lvJobFound := ssn.Jobs[lv.Job]
@@ -1214,6 +1274,28 @@ func (ssn *Session) BuildVictimsPriorityQueue(victims []*api.TaskInfo, preemptor
 	return victimsQueue
 }
 
+// compareLastCheckpoint returns a negative value if l checkpointed more recently than r, so that less work
+// since the last checkpoint is lost by evicting it. Tasks which never checkpointed come last.
+func compareLastCheckpoint(l, r *api.TaskInfo) int {
+	var lt, rt time.Time
+	var lFound, rFound bool
+	if l.Pod != nil {
+		lt, lFound = api.GetPodLastCheckpointTime(l.Pod)
+	}
+	if r.Pod != nil {
+		rt, rFound = api.GetPodLastCheckpointTime(r.Pod)
+	}
+	switch {
+	case lFound && rFound:
+		return rt.Compare(lt)
+	case lFound:
+		return -1
+	case rFound:
+		return 1
+	}
+	return 0
+}
+
 // RegisterBinder registers the passed binder to the cache, the binder type can be such as pre-binder, post-binder
 func (ssn *Session) RegisterBinder(name string, binder interface{}) {
 	ssn.cache.RegisterBinder(name, binder)
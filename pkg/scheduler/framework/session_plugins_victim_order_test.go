@@ -125,3 +125,86 @@ func TestBuildVictimsPriorityQueueJobTieBreaksWithTaskOrder(t *testing.T) {
 		assert.Equal(t, "p-low", first.Name)
 	})
 }
+
+func TestBuildVictimsPriorityQueuePrefersRecentCheckpoint(t *testing.T) {
+	trueValue := true
+	plugins := map[string]framework.PluginBuilder{priority.PluginName: priority.New}
+	pri := int32(1)
+	queueQ1 := util.BuildQueue("q1", 1, nil)
+	nodeN1 := util.BuildNode("n1", api.BuildResourceList("10", "10Gi", []api.ScalarResource{{Name: "pods", Value: "20"}}...), nil)
+	pg1 := util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1.PodGroupRunning)
+
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:             priority.PluginName,
+			EnabledJobOrder:  &trueValue,
+			EnabledTaskOrder: &trueValue,
+		}},
+	}}
+
+	buildPod := func(name string, created int64, checkpointed string) *v1.Pod {
+		pod := util.BuildPodWithPriority("ns1", name, "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil, &pri)
+		pod.CreationTimestamp = metav1.NewTime(time.Unix(created, 0))
+		if checkpointed != "" {
+			pod.Annotations[schedulingv1.PodLastCheckpointTime] = checkpointed
+		}
+		return pod
+	}
+
+	tests := []struct {
+		name     string
+		pods     []*v1.Pod
+		expected string
+	}{
+		{
+			name: "without checkpoints the newer pod goes first",
+			pods: []*v1.Pod{
+				buildPod("p-old", 10, ""),
+				buildPod("p-new", 20, ""),
+			},
+			expected: "p-new",
+		},
+		{
+			name: "checkpointed pod goes first",
+			pods: []*v1.Pod{
+				buildPod("p-old", 10, "2026-01-01T00:00:00Z"),
+				buildPod("p-new", 20, ""),
+			},
+			expected: "p-old",
+		},
+		{
+			name: "most recently checkpointed pod goes first",
+			pods: []*v1.Pod{
+				buildPod("p-old", 10, "2026-01-01T01:00:00Z"),
+				buildPod("p-new", 20, "2026-01-01T00:00:00Z"),
+			},
+			expected: "p-old",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tc := uthelper.TestCommonStruct{
+				Plugins:   plugins,
+				Queues:    []*schedulingv1.Queue{queueQ1.DeepCopy()},
+				Nodes:     []*v1.Node{nodeN1.DeepCopy()},
+				PodGroups: []*schedulingv1.PodGroup{pg1.DeepCopy()},
+				Pods:      test.pods,
+			}
+			ssn := tc.RegisterSession(tiers, nil)
+			defer tc.Close()
+
+			var victims []*api.TaskInfo
+			for _, job := range ssn.Jobs {
+				for _, task := range job.Tasks {
+					victims = append(victims, task)
+				}
+			}
+			assert.Len(t, victims, 2)
+
+			pq := ssn.BuildVictimsPriorityQueue(victims, &api.TaskInfo{Job: api.JobID("missing")})
+			first := pq.Pop().(*api.TaskInfo)
+			assert.Equal(t, test.expected, first.Name)
+		})
+	}
+}
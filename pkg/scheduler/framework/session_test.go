@@ -10,6 +10,7 @@ import (
 	"volcano.sh/apis/pkg/apis/scheduling"
 	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
 	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/decisionlog"
 )
 
 func TestSession_adjustNetworkTopologySpec(t *testing.T) {
@@ -695,3 +696,23 @@ func TestAdjustNetworkTopologySpec_SoftToHardConversion(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateBindContextWithNodeScores(t *testing.T) {
+	task := &api.TaskInfo{UID: "t1", Namespace: "ns1", Name: "p1"}
+	nodeScores := map[float64][]*api.NodeInfo{
+		30: {{Name: "n1"}},
+		20: {{Name: "n2"}},
+		10: {{Name: "n3"}},
+	}
+
+	ssn := &Session{UID: "session1"}
+	ssn.RecordNodeScores(task, nodeScores)
+	bindContext := ssn.CreateBindContext(task)
+	assert.Equal(t, "session1", string(bindContext.SessionID))
+	assert.Nil(t, bindContext.NodeScores)
+
+	ssn.KeepNodeScores(2)
+	ssn.RecordNodeScores(task, nodeScores)
+	bindContext = ssn.CreateBindContext(task)
+	assert.Equal(t, []decisionlog.NodeScore{{Node: "n1", Score: 30}, {Node: "n2", Score: 20}}, bindContext.NodeScores)
+}
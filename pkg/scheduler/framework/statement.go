@@ -99,6 +99,16 @@ func (s *Statement) Evict(reclaimee *api.TaskInfo, reason string) {
 	})
 }
 
+// SetEvictReason sets the reason of the evictions in the statement, for reasons which are known only once all
+// the victims are evicted, e.g. their cost.
+func (s *Statement) SetEvictReason(reason string) {
+	for i := range s.operations {
+		if s.operations[i].name == Evict {
+			s.operations[i].reason = reason
+		}
+	}
+}
+
 func (s *Statement) evict(reclaimee *api.TaskInfo, reason string) error {
 	if err := s.ssn.cache.Evict(reclaimee, reason); err != nil {
 		if e := s.unevict(reclaimee); e != nil {
@@ -328,7 +338,9 @@ func (s *Statement) allocate(task *api.TaskInfo) error {
 		return fmt.Errorf("failed to find job %s", task.Job)
 	}
 
-	metrics.UpdateTaskScheduleDuration(metrics.TaskStageAssumed, metrics.Duration(task.Pod.CreationTimestamp.Time))
+	if !s.ssn.DryRun() {
+		metrics.UpdateTaskScheduleDuration(metrics.TaskStageAssumed, metrics.Duration(task.Pod.CreationTimestamp.Time))
+	}
 	return nil
 }
 
@@ -367,6 +379,7 @@ func (s *Statement) Discard() {
 	for i := len(s.operations) - 1; i >= 0; i-- {
 		op := s.operations[i]
 		op.task.GenerateLastTxContext()
+		s.ssn.decisionTrace.recordOperation(op.name, op.task, op.reason, OperationDiscarded)
 		switch op.name {
 		case Evict:
 			err := s.unevict(op.task)
@@ -393,6 +406,7 @@ func (s *Statement) Commit() {
 	klog.V(3).Info("Committing operations ...")
 	for _, op := range s.operations {
 		op.task.ClearLastTxContext()
+		s.ssn.decisionTrace.recordOperation(op.name, op.task, op.reason, OperationCommitted)
 		switch op.name {
 		case Evict:
 			err := s.evict(op.task, op.reason)
@@ -46,6 +46,11 @@ const (
 	SchedulingStageScoring   = "Scoring"
 	SchedulingStagePreBind   = "PreBind"
 	SchedulingStageBind      = "Bind"
+
+	// Results of the stages of the action pipeline
+	StageSkipped   = "skipped"
+	StageCompleted = "completed"
+	StageTimedOut  = "timed_out"
 )
 
 var (
@@ -171,6 +176,54 @@ var (
 		}, []string{"job_id"},
 	)
 
+	actionStageResults = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "action_stage_results_total",
+			Help:      "Number of sessions in which an action of the pipeline was skipped, completed, or cut short by its time budget",
+		}, []string{"action", "result"},
+	)
+
+	sessionBudgetExceeded = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "session_budget_exceeded_total",
+			Help:      "Number of sessions in which an action stopped early because the time budget of the session was spent",
+		}, []string{"action"},
+	)
+
+	deferredJobs = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "deferred_jobs",
+			Help:      "Number of jobs an action left to the next session when it ran out of time in the last session",
+		}, []string{"action"},
+	)
+
+	triggeredSessions = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "triggered_sessions_total",
+			Help:      "Number of sessions started ahead of the schedule period because a job of a high priority arrived",
+		},
+	)
+
+	parallelAllocateConflicts = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "parallel_allocate_conflicts_total",
+			Help:      "Number of jobs planned in parallel by allocate whose plans conflicted with the jobs committed before them",
+		},
+	)
+
+	snapshotGeneration = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "snapshot_generation",
+			Help:      "Generation of the incremental snapshot of the cache, bumped whenever a snapshot applies changes",
+		},
+	)
+
 	unscheduleJobCount = promauto.NewGauge(
 		prometheus.GaugeOpts{
 			Subsystem: VolcanoSubSystemName,
@@ -203,6 +256,36 @@ func UpdateActionDuration(actionName string, duration time.Duration) {
 	actionSchedulingLatency.WithLabelValues(actionName).Observe(DurationInMilliseconds(duration))
 }
 
+// RegisterActionStageResult records whether an action of the pipeline was skipped, completed or timed out in a session
+func RegisterActionStageResult(actionName, result string) {
+	actionStageResults.WithLabelValues(actionName, result).Inc()
+}
+
+// RegisterSessionBudgetExceeded records a session in which the action ran out of time
+func RegisterSessionBudgetExceeded(actionName string) {
+	sessionBudgetExceeded.WithLabelValues(actionName).Inc()
+}
+
+// UpdateDeferredJobs updates the number of jobs the action left to the next session
+func UpdateDeferredJobs(actionName string, count int) {
+	deferredJobs.WithLabelValues(actionName).Set(float64(count))
+}
+
+// RegisterTriggeredSession records a session started ahead of the schedule period
+func RegisterTriggeredSession() {
+	triggeredSessions.Inc()
+}
+
+// RegisterParallelAllocateConflicts records the jobs whose plans conflicted with the jobs committed before them
+func RegisterParallelAllocateConflicts(count int) {
+	parallelAllocateConflicts.Add(float64(count))
+}
+
+// UpdateSnapshotGeneration updates the generation of the incremental snapshot
+func UpdateSnapshotGeneration(generation uint64) {
+	snapshotGeneration.Set(float64(generation))
+}
+
 // UpdateE2eDuration updates entire end to end scheduling latency
 func UpdateE2eDuration(duration time.Duration) {
 	e2eSchedulingLatency.Observe(DurationInMilliseconds(duration))
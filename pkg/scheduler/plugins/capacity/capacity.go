@@ -76,6 +76,8 @@ type capacityPlugin struct {
 	dynamicResourceAllocationEnable bool
 	// draConsumableCapacityEnable controls whether Capacity dimensions inside DRA are enforced
 	draConsumableCapacityEnable bool
+	// dryRun skips the metrics of what-if and simulated sessions
+	dryRun bool
 }
 
 type queueAttr struct {
@@ -436,6 +438,7 @@ func (cp *capacityPlugin) Name() string {
 
 func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 	cp.parseArguments()
+	cp.dryRun = ssn.DryRun()
 
 	// Prepare scheduling data for this session.
 	cp.totalResource.Add(ssn.TotalResource)
@@ -940,7 +943,9 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 			if cp.dynamicResourceAllocationEnable && attr.dra != nil && event.Task.DRAResreq != nil {
 				addTaskDRAAllocated(attr, event.Task)
 			}
-			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
+			if !cp.dryRun {
+				metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
+			}
 
 			cp.updateShare(attr)
 			if hierarchyEnabled {
@@ -978,7 +983,9 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 			if cp.dynamicResourceAllocationEnable && attr.dra != nil && event.Task.DRAResreq != nil {
 				removeTaskDRAAllocated(attr, event.Task)
 			}
-			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
+			if !cp.dryRun {
+				metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
+			}
 
 			cp.updateShare(attr)
 			if hierarchyEnabled {
@@ -1044,6 +1051,9 @@ func (cp *capacityPlugin) getReclaimeeAncestorToCheck(reclaimerAttr, reclaimeeAt
 
 func (cp *capacityPlugin) OnSessionClose(ssn *framework.Session) {
 	for _, attr := range cp.queueOpts {
+		if cp.dryRun {
+			break
+		}
 		overused := attr.share > 1
 		metrics.UpdateQueueOverused(attr.name, overused)
 	}
@@ -1160,6 +1170,9 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 
 	// Record metrics
 	for queueID, queueInfo := range ssn.Queues {
+		if cp.dryRun {
+			break
+		}
 		queue := ssn.Queues[queueID]
 		if attr, ok := cp.queueOpts[queueID]; ok {
 			metrics.UpdateQueueDeserved(attr.name, attr.deserved.MilliCPU, attr.deserved.Memory, attr.deserved.ScalarResources)
@@ -1353,6 +1366,9 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 
 	// Record metrics
 	for queueID := range ssn.Queues {
+		if cp.dryRun {
+			break
+		}
 		attr := cp.queueOpts[queueID]
 		metrics.UpdateQueueDeserved(attr.name, attr.deserved.MilliCPU, attr.deserved.Memory, attr.deserved.ScalarResources)
 		metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
@@ -1579,7 +1595,9 @@ func (cp *capacityPlugin) compareShareWithDeserved(lattr, rattr *queueAttr) int
 
 func (cp *capacityPlugin) updateShare(attr *queueAttr) {
 	updateQueueAttrShare(attr)
-	metrics.UpdateQueueShare(attr.name, attr.share)
+	if !cp.dryRun {
+		metrics.UpdateQueueShare(attr.name, attr.share)
+	}
 }
 
 func (cp *capacityPlugin) isLeafQueue(queueID api.QueueID) bool {
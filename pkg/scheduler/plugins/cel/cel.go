@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	"github.com/mitchellh/mapstructure"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "cel"
+
+	// PredicatesKey is the argument key of the rules which a node must pass to run a task.
+	PredicatesKey = "cel.predicates"
+	// NodeOrdersKey is the argument key of the rules which score the nodes for a task.
+	NodeOrdersKey = "cel.nodeOrders"
+)
+
+/*
+The plugin filters and scores the nodes by CEL expressions set in its arguments:
+
+	actions: "enqueue, allocate, backfill"
+	tiers:
+	- plugins:
+	  - name: priority
+	  - name: gang
+	- plugins:
+	  - name: predicates
+	  - name: cel
+	    arguments:
+	      cel.predicates:
+	      - name: avoid-spot-for-production
+	        expression: 'queue.name != "production" || !("spot" in node.labels)'
+	      cel.nodeOrders:
+	      - name: prefer-large-gpu-memory
+	        expression: 'node.allocatable["volcano.sh/gpu-memory"] > task.resreq["volcano.sh/gpu-memory"] * 1.5 ? 100 : 0'
+	        weight: 2
+
+A predicate is a bool expression, the node fits the task if all of them are true. A node order is an int or
+double expression, the score of the node is the sum of the weighted values. The expressions see the variables
+task, job, node and queue, whose fields are the views in views.go. They are compiled when the session opens,
+a rule which does not compile is left out of the session.
+*/
+type celPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	predicates []*program
+	nodeOrders []*program
+}
+
+// Rule is a CEL expression set in the arguments.
+type Rule struct {
+	Name       string `mapstructure:"name"`
+	Expression string `mapstructure:"expression"`
+	// Weight of the value of a node order, 1 by default.
+	Weight *float64 `mapstructure:"weight"`
+}
+
+type program struct {
+	name    string
+	weight  float64
+	program celgo.Program
+}
+
+var (
+	envOnce sync.Once
+	env     *celgo.Env
+	envErr  error
+)
+
+// getEnv returns the environment which declares the variables, it is shared by the sessions.
+func getEnv() (*celgo.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = celgo.NewEnv(
+			ext.NativeTypes(
+				reflect.TypeOf(Task{}),
+				reflect.TypeOf(Job{}),
+				reflect.TypeOf(Node{}),
+				reflect.TypeOf(Queue{}),
+				ext.ParseStructTags(true),
+			),
+			celgo.Variable("task", celgo.ObjectType("cel.Task")),
+			celgo.Variable("job", celgo.ObjectType("cel.Job")),
+			celgo.Variable("node", celgo.ObjectType("cel.Node")),
+			celgo.Variable("queue", celgo.ObjectType("cel.Queue")),
+			ext.Strings(),
+		)
+	})
+	return env, envErr
+}
+
+// New return cel plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &celPlugin{pluginArguments: arguments}
+}
+
+func (cp *celPlugin) Name() string {
+	return PluginName
+}
+
+func (cp *celPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	cp.predicates = compileRules(cp.pluginArguments, PredicatesKey, celgo.BoolType)
+	cp.nodeOrders = compileRules(cp.pluginArguments, NodeOrdersKey, celgo.IntType, celgo.DoubleType)
+
+	if len(cp.predicates) > 0 {
+		ssn.AddPredicateFn(cp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+			return cp.predicate(newActivation(ssn, task, node), task, node)
+		})
+	}
+
+	if len(cp.nodeOrders) > 0 {
+		ssn.AddNodeOrderFn(cp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+			return cp.score(newActivation(ssn, task, node), task, node), nil
+		})
+	}
+}
+
+func (cp *celPlugin) OnSessionClose(_ *framework.Session) {}
+
+// compileRules compiles the rules of the argument, whose expressions must result in one of the types.
+func compileRules(arguments framework.Arguments, key string, outputTypes ...*celgo.Type) []*program {
+	value, found := arguments[key]
+	if !found {
+		return nil
+	}
+	var rules []Rule
+	if err := mapstructure.Decode(value, &rules); err != nil {
+		klog.Errorf("Failed to decode %s of plugin %s: %v", key, PluginName, err)
+		return nil
+	}
+
+	env, err := getEnv()
+	if err != nil {
+		klog.Errorf("Failed to create the CEL environment of plugin %s: %v", PluginName, err)
+		return nil
+	}
+
+	programs := make([]*program, 0, len(rules))
+	for i, rule := range rules {
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("%s[%d]", key, i)
+		}
+		prg, err := compile(env, rule.Expression, outputTypes...)
+		if err != nil {
+			klog.Errorf("Failed to compile rule %s of plugin %s, it is ignored: %v", rule.Name, PluginName, err)
+			continue
+		}
+		weight := 1.0
+		if rule.Weight != nil {
+			weight = *rule.Weight
+		}
+		programs = append(programs, &program{name: rule.Name, weight: weight, program: prg})
+	}
+	return programs
+}
+
+func compile(env *celgo.Env, expression string, outputTypes ...*celgo.Type) (celgo.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	outputType := ast.OutputType()
+	valid := outputType.IsExactType(celgo.DynType)
+	for _, t := range outputTypes {
+		valid = valid || outputType.IsExactType(t)
+	}
+	if !valid {
+		return nil, fmt.Errorf("expression results in %s, expected one of %v", outputType, outputTypes)
+	}
+	return env.Program(ast)
+}
+
+func newActivation(ssn *framework.Session, task *api.TaskInfo, node *api.NodeInfo) map[string]interface{} {
+	job := ssn.Jobs[task.Job]
+	var queue *api.QueueInfo
+	if job != nil {
+		queue = ssn.Queues[job.Queue]
+	}
+	return map[string]interface{}{
+		"task":  newTask(task),
+		"job":   newJob(job),
+		"node":  newNode(node),
+		"queue": newQueue(queue),
+	}
+}
+
+// predicate returns the fit error of the first rule which rejects the node.
+func (cp *celPlugin) predicate(activation map[string]interface{}, task *api.TaskInfo, node *api.NodeInfo) error {
+	for _, p := range cp.predicates {
+		val, _, err := p.program.Eval(activation)
+		if err != nil {
+			return api.NewFitErrWithStatus(task, node, &api.Status{
+				Code:   api.Error,
+				Reason: fmt.Sprintf("rule %s failed: %v", p.name, err),
+				Plugin: PluginName,
+			})
+		}
+		if fits, ok := val.(types.Bool); !ok || !bool(fits) {
+			return api.NewFitErrWithStatus(task, node, &api.Status{
+				Code:   api.Unschedulable,
+				Reason: fmt.Sprintf("node(s) rejected by rule %s", p.name),
+				Plugin: PluginName,
+			})
+		}
+	}
+	return nil
+}
+
+// score returns the sum of the weighted values of the node orders, a rule which fails scores 0.
+func (cp *celPlugin) score(activation map[string]interface{}, task *api.TaskInfo, node *api.NodeInfo) float64 {
+	score := 0.0
+	for _, p := range cp.nodeOrders {
+		val, _, err := p.program.Eval(activation)
+		if err != nil {
+			klog.V(4).Infof("Rule %s failed to score node %s for task <%s/%s>: %v", p.name, node.Name, task.Namespace, task.Name, err)
+			continue
+		}
+		value, ok := toFloat64(val)
+		if !ok {
+			klog.V(4).Infof("Rule %s scored node %s for task <%s/%s> with %v, expected a number", p.name, node.Name, task.Namespace, task.Name, val)
+			continue
+		}
+		score += p.weight * value
+	}
+	return score
+}
+
+func toFloat64(val ref.Val) (float64, bool) {
+	switch v := val.(type) {
+	case types.Double:
+		return float64(v), true
+	case types.Int:
+		return float64(v), true
+	case types.Uint:
+		return float64(v), true
+	}
+	return 0, false
+}
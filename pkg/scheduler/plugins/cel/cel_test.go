@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"testing"
+
+	celgo "github.com/google/cel-go/cel"
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const testArguments = `
+cel.predicates:
+- name: avoid-spot-for-production
+  expression: 'queue.name != "production" || !("spot" in node.labels)'
+- name: not-a-bool
+  expression: 'node.tasks'
+- name: broken
+  expression: 'node.unknown == 1'
+cel.nodeOrders:
+- name: prefer-large-gpu-memory
+  expression: 'node.allocatable["volcano.sh/gpu-memory"] > task.resreq["volcano.sh/gpu-memory"] * 1.5 ? 100 : 0'
+  weight: 2
+- expression: 'node.idle["cpu"] / 1000.0'
+`
+
+func TestCEL(t *testing.T) {
+	arguments := framework.Arguments{}
+	if err := yaml.Unmarshal([]byte(testArguments), &arguments); err != nil {
+		t.Fatal(err)
+	}
+	cp := New(arguments).(*celPlugin)
+	cp.predicates = compileRules(arguments, PredicatesKey, celgo.BoolType)
+	cp.nodeOrders = compileRules(arguments, NodeOrdersKey, celgo.IntType, celgo.DoubleType)
+	if len(cp.predicates) != 1 || cp.predicates[0].name != "avoid-spot-for-production" {
+		t.Fatalf("expected the rules which do not compile to be ignored, got %d predicates", len(cp.predicates))
+	}
+	if len(cp.nodeOrders) != 2 || cp.nodeOrders[1].name != "cel.nodeOrders[1]" {
+		t.Fatalf("expected 2 node orders, got %d", len(cp.nodeOrders))
+	}
+
+	gpuMemory := v1.ResourceName("volcano.sh/gpu-memory")
+	request := api.BuildResourceList("1", "1Gi", api.ScalarResource{Name: string(gpuMemory), Value: "10"})
+	buildNode := func(name, gpu string, labels map[string]string) *api.NodeInfo {
+		return api.NewNodeInfo(util.BuildNode(name, api.BuildResourceList("4", "4Gi",
+			api.ScalarResource{Name: "pods", Value: "10"}, api.ScalarResource{Name: string(gpuMemory), Value: gpu}), labels))
+	}
+	large := buildNode("large", "16", nil)
+	small := buildNode("small", "12", nil)
+	spot := buildNode("spot", "16", map[string]string{"spot": "true"})
+
+	ssn := &framework.Session{Jobs: map[api.JobID]*api.JobInfo{}, Queues: map[api.QueueID]*api.QueueInfo{}}
+	for _, name := range []string{"production", "batch"} {
+		queue := api.NewQueueInfo(&scheduling.Queue{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: scheduling.QueueSpec{Weight: 1}})
+		ssn.Queues[queue.UID] = queue
+	}
+	buildTask := func(name, queue string) *api.TaskInfo {
+		task := api.NewTaskInfo(util.BuildPod("ns1", name, "", v1.PodPending, request, name, nil, nil))
+		job := api.NewJobInfo(task.Job, task)
+		job.Queue = api.QueueID(queue)
+		ssn.Jobs[job.UID] = job
+		return task
+	}
+	production := buildTask("p1", "production")
+	batch := buildTask("p2", "batch")
+
+	tests := []struct {
+		name  string
+		task  *api.TaskInfo
+		node  *api.NodeInfo
+		fits  bool
+		score float64
+	}{
+		{name: "production fits a large node", task: production, node: large, fits: true, score: 2*100 + 4},
+		{name: "production avoids spot nodes", task: production, node: spot, fits: false, score: 2*100 + 4},
+		{name: "batch fits spot nodes", task: batch, node: spot, fits: true, score: 2*100 + 4},
+		{name: "small node scores by idle cpu only", task: batch, node: small, fits: true, score: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			activation := newActivation(ssn, test.task, test.node)
+			if err := cp.predicate(activation, test.task, test.node); (err == nil) != test.fits {
+				t.Errorf("expected fits %v, got %v", test.fits, err)
+			}
+			if score := cp.score(activation, test.task, test.node); score != test.score {
+				t.Errorf("expected score %v, got %v", test.score, score)
+			}
+		})
+	}
+}
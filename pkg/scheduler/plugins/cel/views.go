@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// The views are the types of the variables of the expressions, their fields are named by the cel tags.
+// Resources map the resource names to milli cpu, memory in bytes, and the scalar resources in milli units.
+
+// Task is the view of the task to place, the variable task.
+type Task struct {
+	UID         string             `cel:"uid"`
+	Name        string             `cel:"name"`
+	Namespace   string             `cel:"namespace"`
+	Priority    int32              `cel:"priority"`
+	BestEffort  bool               `cel:"bestEffort"`
+	Labels      map[string]string  `cel:"labels"`
+	Annotations map[string]string  `cel:"annotations"`
+	Resreq      map[string]float64 `cel:"resreq"`
+}
+
+// Job is the view of the job of the task, the variable job.
+type Job struct {
+	UID          string             `cel:"uid"`
+	Name         string             `cel:"name"`
+	Namespace    string             `cel:"namespace"`
+	Queue        string             `cel:"queue"`
+	Priority     int32              `cel:"priority"`
+	MinAvailable int32              `cel:"minAvailable"`
+	Labels       map[string]string  `cel:"labels"`
+	Annotations  map[string]string  `cel:"annotations"`
+	Allocated    map[string]float64 `cel:"allocated"`
+	TotalRequest map[string]float64 `cel:"totalRequest"`
+}
+
+// Node is the view of the candidate node, the variable node.
+type Node struct {
+	Name        string             `cel:"name"`
+	Ready       bool               `cel:"ready"`
+	Labels      map[string]string  `cel:"labels"`
+	Annotations map[string]string  `cel:"annotations"`
+	Allocatable map[string]float64 `cel:"allocatable"`
+	Idle        map[string]float64 `cel:"idle"`
+	Used        map[string]float64 `cel:"used"`
+	Tasks       int                `cel:"tasks"`
+}
+
+// Queue is the view of the queue of the job, the variable queue.
+type Queue struct {
+	Name        string             `cel:"name"`
+	Weight      int32              `cel:"weight"`
+	Parent      string             `cel:"parent"`
+	Labels      map[string]string  `cel:"labels"`
+	Annotations map[string]string  `cel:"annotations"`
+	Capability  map[string]float64 `cel:"capability"`
+	Deserved    map[string]float64 `cel:"deserved"`
+}
+
+func newResources(resource *api.Resource) map[string]float64 {
+	resources := map[string]float64{}
+	if resource == nil {
+		return resources
+	}
+	resources[string(corev1.ResourceCPU)] = resource.MilliCPU
+	resources[string(corev1.ResourceMemory)] = resource.Memory
+	for name, quantity := range resource.ScalarResources {
+		resources[string(name)] = quantity
+	}
+	return resources
+}
+
+// newResourceList leaves out the resources which are not in the list, e.g. unlimited in a capability.
+func newResourceList(list corev1.ResourceList) map[string]float64 {
+	resources := make(map[string]float64, len(list))
+	for name, quantity := range list {
+		if name == corev1.ResourceMemory {
+			resources[string(name)] = float64(quantity.Value())
+		} else {
+			resources[string(name)] = float64(quantity.MilliValue())
+		}
+	}
+	return resources
+}
+
+func orEmpty(m map[string]string) map[string]string {
+	if m == nil {
+		return map[string]string{}
+	}
+	return m
+}
+
+func newTask(task *api.TaskInfo) *Task {
+	view := &Task{
+		UID:         string(task.UID),
+		Name:        task.Name,
+		Namespace:   task.Namespace,
+		Priority:    task.Priority,
+		BestEffort:  task.BestEffort,
+		Labels:      map[string]string{},
+		Annotations: map[string]string{},
+		Resreq:      newResources(task.Resreq),
+	}
+	if task.Pod != nil {
+		view.Labels = orEmpty(task.Pod.Labels)
+		view.Annotations = orEmpty(task.Pod.Annotations)
+	}
+	return view
+}
+
+func newJob(job *api.JobInfo) *Job {
+	if job == nil {
+		return &Job{Labels: map[string]string{}, Annotations: map[string]string{}, Allocated: map[string]float64{}, TotalRequest: map[string]float64{}}
+	}
+	view := &Job{
+		UID:          string(job.UID),
+		Name:         job.Name,
+		Namespace:    job.Namespace,
+		Queue:        string(job.Queue),
+		Priority:     job.Priority,
+		MinAvailable: job.MinAvailable,
+		Labels:       map[string]string{},
+		Annotations:  map[string]string{},
+		Allocated:    newResources(job.Allocated),
+		TotalRequest: newResources(job.TotalRequest),
+	}
+	if job.PodGroup != nil {
+		view.Labels = orEmpty(job.PodGroup.Labels)
+		view.Annotations = orEmpty(job.PodGroup.Annotations)
+	}
+	return view
+}
+
+func newNode(node *api.NodeInfo) *Node {
+	view := &Node{
+		Name:        node.Name,
+		Ready:       node.Ready(),
+		Labels:      map[string]string{},
+		Annotations: map[string]string{},
+		Allocatable: newResources(node.Allocatable),
+		Idle:        newResources(node.Idle),
+		Used:        newResources(node.Used),
+		Tasks:       len(node.Tasks),
+	}
+	if node.Node != nil {
+		view.Labels = orEmpty(node.Node.Labels)
+		view.Annotations = orEmpty(node.Node.Annotations)
+	}
+	return view
+}
+
+func newQueue(queue *api.QueueInfo) *Queue {
+	view := &Queue{
+		Labels:      map[string]string{},
+		Annotations: map[string]string{},
+		Capability:  map[string]float64{},
+		Deserved:    map[string]float64{},
+	}
+	if queue == nil {
+		return view
+	}
+	view.Name = queue.Name
+	view.Weight = queue.Weight
+	if queue.Queue != nil {
+		view.Parent = queue.Queue.Spec.Parent
+		view.Labels = orEmpty(queue.Queue.Labels)
+		view.Annotations = orEmpty(queue.Queue.Annotations)
+		view.Capability = newResourceList(queue.Queue.Spec.Capability)
+		view.Deserved = newResourceList(queue.Queue.Spec.Deserved)
+	}
+	return view
+}
@@ -35,6 +35,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
 	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/fairshare"
 )
 
 // PluginName indicates name of volcano scheduler plugin.
@@ -118,6 +119,11 @@ type drfPlugin struct {
 	// hierarchical tree root
 	hierarchicalRoot *hierarchicalNode
 
+	// fairShare blends the decayed historical usage of their namespace into the share of jobs if enabled
+	fairShare fairshare.Config
+	// map[namespaceName]->fair-share factor, a higher factor means a higher priority
+	namespaceFactors map[string]float64
+
 	// Arguments given for the plugin
 	pluginArguments framework.Arguments
 }
@@ -136,6 +142,7 @@ func New(arguments framework.Arguments) framework.Plugin {
 			weight:    1,
 			children:  map[string]*hierarchicalNode{},
 		},
+		fairShare:       fairshare.ParseArguments(arguments),
 		pluginArguments: arguments,
 	}
 }
@@ -191,6 +198,10 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 
 	hierarchyEnabled := drf.HierarchyEnabled(ssn)
 
+	if drf.fairShare.Enabled {
+		drf.updateNamespaceFactors(ssn)
+	}
+
 	for _, job := range ssn.Jobs {
 		attr := &drfAttr{
 			allocated: api.EmptyResource(),
@@ -206,7 +217,7 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 
 		// Calculate the init share of Job
 		drf.updateShare(attr)
-		if !ssn.IsJobTerminated(job.UID) {
+		if !ssn.DryRun() && !ssn.IsJobTerminated(job.UID) {
 			metrics.UpdateJobShare(job.Namespace, job.Name, attr.share)
 		}
 
@@ -374,11 +385,18 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 		klog.V(4).Infof("DRF JobOrderFn: <%v/%v> share state: %v, <%v/%v> share state: %v",
 			lv.Namespace, lv.Name, drf.jobAttrs[lv.UID].share, rv.Namespace, rv.Name, drf.jobAttrs[rv.UID].share)
 
-		if drf.jobAttrs[lv.UID].share == drf.jobAttrs[rv.UID].share {
+		lshare, rshare := drf.jobAttrs[lv.UID].share, drf.jobAttrs[rv.UID].share
+		if drf.fairShare.Enabled {
+			// A higher fair-share factor means less historical usage of the namespace.
+			lshare = fairshare.Blend(lshare, drf.namespaceFactors[lv.Namespace])
+			rshare = fairshare.Blend(rshare, drf.namespaceFactors[rv.Namespace])
+		}
+
+		if lshare == rshare {
 			return 0
 		}
 
-		if drf.jobAttrs[lv.UID].share < drf.jobAttrs[rv.UID].share {
+		if lshare < rshare {
 			return -1
 		}
 
@@ -404,7 +422,7 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 			}
 			attr.allocated.Add(event.Task.Resreq)
 			drf.updateShare(attr)
-			if !ssn.IsJobTerminated(job.UID) {
+			if !ssn.DryRun() && !ssn.IsJobTerminated(job.UID) {
 				metrics.UpdateJobShare(job.Namespace, job.Name, attr.share)
 			}
 
@@ -435,7 +453,7 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 			}
 			attr.allocated.Sub(event.Task.Resreq)
 			drf.updateShare(attr)
-			if !ssn.IsJobTerminated(job.UID) {
+			if !ssn.DryRun() && !ssn.IsJobTerminated(job.UID) {
 				metrics.UpdateJobShare(job.Namespace, job.Name, attr.share)
 			}
 
@@ -582,4 +600,21 @@ func (drf *drfPlugin) OnSessionClose(session *framework.Session) {
 	drf.totalResource = api.EmptyResource()
 	drf.totalAllocated = api.EmptyResource()
 	drf.jobAttrs = map[api.JobID]*drfAttr{}
+	drf.namespaceFactors = nil
+}
+
+// updateNamespaceFactors accumulates the decayed usage of the queues and computes the fair-share factor of
+// every namespace with jobs in the session, namespaces have equal shares.
+func (drf *drfPlugin) updateNamespaceFactors(ssn *framework.Session) {
+	fairshare.UpdateUsage(ssn, drf.fairShare.HalfLife, ssn.StartTime)
+
+	weights := map[string]float64{}
+	for _, job := range ssn.Jobs {
+		weights[job.Namespace] = 1
+	}
+	usage := fairshare.NamespaceUsage(ssn.Queues)
+	drf.namespaceFactors = fairshare.Factors(usage, weights)
+	for ns, factor := range drf.namespaceFactors {
+		klog.V(4).Infof("The fair-share factor of namespace <%s> is %f, decayed usage <%v>", ns, factor, usage[ns])
+	}
 }
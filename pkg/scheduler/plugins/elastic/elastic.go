@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elastic
+
+import (
+	"sort"
+	"strconv"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/controllers/job/helpers"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+// PluginName indicates name of volcano scheduler plugin.
+const PluginName = "elastic"
+
+/*
+The plugin grows and shrinks the jobs annotated with `volcano.sh/elastic: "true"` in the order of the task ranks:
+
+	actions: "enqueue, allocate, preempt, reclaim, backfill"
+	tiers:
+	- plugins:
+	  - name: priority
+	  - name: gang
+	  - name: elastic
+
+The replicas above minAvailable are allocated whenever there are idle resources, lowest rank first. Under pressure,
+preempt and reclaim only evict the tasks of the highest ranks of each role, and never below the minAvailable of the
+job or of the role, so that the tasks left running are always the lowest ranks. The plugin should be in the same tier
+as gang.
+*/
+type elasticPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+}
+
+// New return elastic plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &elasticPlugin{pluginArguments: arguments}
+}
+
+func (ep *elasticPlugin) Name() string {
+	return PluginName
+}
+
+func (ep *elasticPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	taskOrderFn := func(l, r interface{}) int {
+		lv := l.(*api.TaskInfo)
+		rv := r.(*api.TaskInfo)
+		if lv.Job != rv.Job || lv.TaskRole != rv.TaskRole || !isElastic(ssn.Jobs[lv.Job]) {
+			return 0
+		}
+
+		lRank, rRank := rank(lv), rank(rv)
+		if lRank < 0 || rRank < 0 || lRank == rRank {
+			return 0
+		}
+		if lRank < rRank {
+			return -1
+		}
+		return 1
+	}
+	ssn.AddTaskOrderFn(ep.Name(), taskOrderFn)
+
+	shrinkableFn := func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		var victims []*api.TaskInfo
+		hasElastic := false
+		shrinkable := map[api.JobID]map[api.TaskID]bool{}
+
+		for _, preemptee := range preemptees {
+			job := ssn.Jobs[preemptee.Job]
+			if !isElastic(job) {
+				victims = append(victims, preemptee)
+				continue
+			}
+
+			hasElastic = true
+			if _, found := shrinkable[job.UID]; !found {
+				shrinkable[job.UID] = shrinkableTasks(job)
+			}
+			if shrinkable[job.UID][preemptee.UID] {
+				victims = append(victims, preemptee)
+			} else {
+				klog.V(4).Infof("Can not evict task <%v/%v> because it is not among the highest ranks of elastic job %s above its minAvailable",
+					preemptee.Namespace, preemptee.Name, job.Name)
+			}
+		}
+
+		if !hasElastic {
+			return nil, util.Abstain
+		}
+
+		klog.V(4).Infof("Victims from elastic plugin, victims=%+v preemptor=%s", victims, preemptor)
+		return victims, util.Permit
+	}
+	ssn.AddPreemptableFn(ep.Name(), shrinkableFn)
+	ssn.AddReclaimableFn(ep.Name(), shrinkableFn)
+}
+
+func (ep *elasticPlugin) OnSessionClose(_ *framework.Session) {}
+
+// isElastic returns whether the podgroup of the job is annotated as elastic.
+func isElastic(job *api.JobInfo) bool {
+	return job != nil && job.PodGroup != nil && job.PodGroup.Annotations[api.JobElastic] == "true"
+}
+
+// rank returns the index of the task in its role, -1 if the name of its pod has none.
+func rank(task *api.TaskInfo) int {
+	if task.Pod == nil {
+		return -1
+	}
+	index, err := strconv.Atoi(helpers.GetPodIndexUnderTask(task.Pod))
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+// shrinkableTasks returns the occupied tasks of the job which can be given up without shrinking the job below its
+// minAvailable, or a role below its own: the highest ranks of each role, the highest ranks of all the roles first.
+func shrinkableTasks(job *api.JobInfo) map[api.TaskID]bool {
+	roles := map[string][]*api.TaskInfo{}
+	occupied := 0
+	for status, tasks := range job.TaskStatusIndex {
+		if !api.AllocatedStatus(status) {
+			continue
+		}
+		for _, task := range tasks {
+			roles[task.TaskRole] = append(roles[task.TaskRole], task)
+			occupied++
+		}
+	}
+
+	var candidates []*api.TaskInfo
+	for role, tasks := range roles {
+		sort.Slice(tasks, func(i, j int) bool {
+			return rank(tasks[i]) > rank(tasks[j])
+		})
+		if spare := len(tasks) - int(job.TaskMinAvailable[role]); spare > 0 {
+			candidates = append(candidates, tasks[:spare]...)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if ri, rj := rank(candidates[i]), rank(candidates[j]); ri != rj {
+			return ri > rj
+		}
+		return candidates[i].TaskRole < candidates[j].TaskRole
+	})
+
+	shrinkable := map[api.TaskID]bool{}
+	spare := occupied - int(job.MinAvailable)
+	for i := 0; i < len(candidates) && i < spare; i++ {
+		shrinkable[candidates[i].UID] = true
+	}
+	return shrinkable
+}
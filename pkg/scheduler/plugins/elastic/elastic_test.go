@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elastic
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+var trueValue = true
+
+func buildPod(name, group, role string) *v1.Pod {
+	return util.BuildPod("ns1", name, "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), group,
+		map[string]string{batch.TaskSpecKey: role}, nil)
+}
+
+func TestElastic(t *testing.T) {
+	test := uthelper.TestCommonStruct{
+		Name:    "elastic",
+		Plugins: map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: []*vcapisv1.PodGroup{
+			util.BuildPodGroupWithAnno("elastic", "ns1", "q1", 3, map[string]int32{"master": 1, "worker": 1},
+				vcapisv1.PodGroupRunning, map[string]string{api.JobElastic: "true"}),
+			util.BuildPodGroup("rigid", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning),
+		},
+		Pods: []*v1.Pod{
+			buildPod("elastic-master-0", "elastic", "master"),
+			buildPod("elastic-worker-0", "elastic", "worker"),
+			buildPod("elastic-worker-1", "elastic", "worker"),
+			buildPod("elastic-worker-2", "elastic", "worker"),
+			buildPod("elastic-worker-10", "elastic", "worker"),
+			buildPod("rigid-worker-0", "rigid", "worker"),
+			buildPod("rigid-worker-1", "rigid", "worker"),
+		},
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{
+			util.BuildQueue("q1", 1, nil),
+		},
+	}
+	tiers := []conf.Tier{{Plugins: []conf.PluginOption{{
+		Name:               PluginName,
+		EnabledTaskOrder:   &trueValue,
+		EnabledPreemptable: &trueValue,
+		EnabledReclaimable: &trueValue,
+	}}}}
+	ssn := test.RegisterSession(tiers, nil)
+	defer test.Close()
+
+	tasks := map[string]*api.TaskInfo{}
+	for _, job := range ssn.Jobs {
+		for _, task := range job.Tasks {
+			tasks[task.Name] = task
+		}
+	}
+	names := func(tasks []*api.TaskInfo) []string {
+		var names []string
+		for _, task := range tasks {
+			names = append(names, task.Name)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	t.Run("tasks of an elastic job are ordered by rank", func(t *testing.T) {
+		assert.Equal(t, -1, ssn.TaskCompareFns(tasks["elastic-worker-2"], tasks["elastic-worker-10"]))
+		assert.Equal(t, 1, ssn.TaskCompareFns(tasks["elastic-worker-10"], tasks["elastic-worker-2"]))
+		assert.Equal(t, 0, ssn.TaskCompareFns(tasks["elastic-master-0"], tasks["elastic-worker-0"]))
+		assert.Equal(t, 0, ssn.TaskCompareFns(tasks["rigid-worker-0"], tasks["rigid-worker-1"]))
+	})
+
+	preemptor := buildPod("preemptor", "other", "worker")
+	tests := []struct {
+		name       string
+		preemptees []string
+		expected   []string
+	}{
+		{
+			name:       "only the highest ranks above minAvailable are evicted",
+			preemptees: []string{"elastic-master-0", "elastic-worker-0", "elastic-worker-1", "elastic-worker-2", "elastic-worker-10"},
+			expected:   []string{"elastic-worker-10", "elastic-worker-2"},
+		},
+		{
+			name:       "lower ranks are kept even if the highest ranks are not candidates",
+			preemptees: []string{"elastic-worker-0", "elastic-worker-1"},
+			expected:   nil,
+		},
+		{
+			name:       "tasks of other jobs are left to the other plugins",
+			preemptees: []string{"elastic-worker-1", "elastic-worker-2", "rigid-worker-0"},
+			expected:   []string{"elastic-worker-2", "rigid-worker-0"},
+		},
+		{
+			name:       "the plugin abstains without elastic jobs",
+			preemptees: []string{"rigid-worker-0", "rigid-worker-1"},
+			expected:   nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var preemptees []*api.TaskInfo
+			for _, name := range tc.preemptees {
+				preemptees = append(preemptees, tasks[name])
+			}
+			assert.Equal(t, tc.expected, names(ssn.Preemptable(api.NewTaskInfo(preemptor), preemptees)))
+			assert.Equal(t, tc.expected, names(ssn.Reclaimable(api.NewTaskInfo(preemptor), preemptees)))
+		})
+	}
+}
@@ -101,3 +101,32 @@ type EventHandlerRequest struct {
 type EventHandlerResponse struct {
 	ErrorMessage string `json:"errorMessage"`
 }
+
+type HyperNodeOrderRequest struct {
+	SubJob     *api.SubJobInfo            `json:"subJob"`
+	HyperNodes map[string][]*api.NodeInfo `json:"hyperNodes"`
+}
+
+type HyperNodeOrderResponse struct {
+	HyperNodeScore map[string]float64 `json:"hyperNodeScore"`
+	ErrorMessage   string             `json:"errorMessage"`
+}
+
+type VictimTasksRequest struct {
+	Tasks []*api.TaskInfo `json:"tasks"`
+}
+
+type VictimTasksResponse struct {
+	Victims []*api.TaskInfo `json:"victims"`
+}
+
+type PreBindRequest struct {
+	Task *api.TaskInfo `json:"task"`
+}
+
+type PreBindResponse struct {
+	ErrorMessage string `json:"errorMessage"`
+}
+
+type PreBindRollBackRequest PreBindRequest
+type PreBindRollBackResponse struct{}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	extenderv1 "volcano.sh/volcano/pkg/scheduler/plugins/extender/proto/v1"
+)
+
+// The conversions from the scheduler cache to the v1 schema keep the order of repeated fields stable, so that
+// an object which did not change marshals to the same bytes and is left out of the session open delta.
+
+func convertResource(resource *api.Resource) *extenderv1.Resource {
+	if resource == nil {
+		return nil
+	}
+	quantities := map[string]float64{
+		string(corev1.ResourceCPU):    resource.MilliCPU,
+		string(corev1.ResourceMemory): resource.Memory,
+	}
+	for name, quantity := range resource.ScalarResources {
+		quantities[string(name)] = quantity
+	}
+	return &extenderv1.Resource{Quantities: quantities}
+}
+
+func convertTask(task *api.TaskInfo) *extenderv1.Task {
+	if task == nil {
+		return nil
+	}
+	msg := &extenderv1.Task{
+		Uid:       string(task.UID),
+		Name:      task.Name,
+		Namespace: task.Namespace,
+		Job:       string(task.Job),
+		NodeName:  task.NodeName,
+		Status:    task.Status.String(),
+		Priority:  task.Priority,
+		Resreq:    convertResource(task.Resreq),
+	}
+	if task.Pod != nil {
+		msg.Labels = task.Pod.Labels
+		msg.Annotations = task.Pod.Annotations
+	}
+	return msg
+}
+
+func convertTasks(tasks []*api.TaskInfo) []*extenderv1.Task {
+	msgs := make([]*extenderv1.Task, 0, len(tasks))
+	for _, task := range tasks {
+		msgs = append(msgs, convertTask(task))
+	}
+	return msgs
+}
+
+func convertNode(node *api.NodeInfo) *extenderv1.Node {
+	msg := &extenderv1.Node{
+		Name:        node.Name,
+		Ready:       node.Ready(),
+		Allocatable: convertResource(node.Allocatable),
+		Idle:        convertResource(node.Idle),
+		Used:        convertResource(node.Used),
+		Releasing:   convertResource(node.Releasing),
+		Pipelined:   convertResource(node.Pipelined),
+		Tasks:       make([]string, 0, len(node.Tasks)),
+	}
+	if node.Node != nil {
+		msg.Labels = node.Node.Labels
+	}
+	for _, task := range node.Tasks {
+		msg.Tasks = append(msg.Tasks, string(task.UID))
+	}
+	sort.Strings(msg.Tasks)
+	return msg
+}
+
+func convertJob(job *api.JobInfo) *extenderv1.Job {
+	if job == nil {
+		return nil
+	}
+	msg := &extenderv1.Job{
+		Uid:          string(job.UID),
+		Name:         job.Name,
+		Namespace:    job.Namespace,
+		Queue:        string(job.Queue),
+		Priority:     job.Priority,
+		MinAvailable: job.MinAvailable,
+		Allocated:    convertResource(job.Allocated),
+		TotalRequest: convertResource(job.TotalRequest),
+		Tasks:        make([]*extenderv1.Task, 0, len(job.Tasks)),
+	}
+	if job.PodGroup != nil {
+		msg.Phase = string(job.PodGroup.Status.Phase)
+	}
+	for _, task := range job.Tasks {
+		msg.Tasks = append(msg.Tasks, convertTask(task))
+	}
+	sort.Slice(msg.Tasks, func(i, j int) bool {
+		return msg.Tasks[i].Uid < msg.Tasks[j].Uid
+	})
+	return msg
+}
+
+func convertQueue(queue *api.QueueInfo) *extenderv1.Queue {
+	if queue == nil {
+		return nil
+	}
+	msg := &extenderv1.Queue{
+		Uid:       string(queue.UID),
+		Name:      queue.Name,
+		Weight:    queue.Weight,
+		Hierarchy: queue.Hierarchy,
+		Weights:   queue.Weights,
+	}
+	if queue.Queue != nil && queue.Queue.Spec.Capability != nil {
+		msg.Capability = convertResource(api.NewResource(queue.Queue.Spec.Capability))
+	}
+	return msg
+}
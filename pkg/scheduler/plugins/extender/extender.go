@@ -18,6 +18,7 @@ package extender
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/plugins/util"
 )
@@ -40,7 +42,10 @@ const (
 
 	// ExtenderURLPrefix is the key for providing extender endpoint address
 	ExtenderURLPrefix = "extender.urlPrefix"
-	// ExtenderHTTPTimeout is the timeout for extender http calls
+	// ExtenderGRPCAddress is the key for providing the address of an extender serving the v1 gRPC schema,
+	// it takes the place of the url prefix
+	ExtenderGRPCAddress = "extender.grpcAddress"
+	// ExtenderHTTPTimeout is the timeout for extender http and gRPC calls
 	ExtenderHTTPTimeout = "extender.httpTimeout"
 	// ExtenderOnSessionOpenVerb is the verb of OnSessionOpen method
 	ExtenderOnSessionOpenVerb = "extender.onSessionOpenVerb"
@@ -66,6 +71,14 @@ const (
 	ExtenderAllocateFuncVerb = "extender.allocateFuncVerb"
 	// ExtenderDeallocateFuncVerb is the verb of DeallocateFunc method
 	ExtenderDeallocateFuncVerb = "extender.deallocateFuncVerb"
+	// ExtenderHyperNodeOrderVerb is the verb of HyperNodeOrder method
+	ExtenderHyperNodeOrderVerb = "extender.hyperNodeOrderVerb"
+	// ExtenderVictimTasksVerb is the verb of VictimTasks method
+	ExtenderVictimTasksVerb = "extender.victimTasksVerb"
+	// ExtenderPreBindVerb is the verb of PreBind method
+	ExtenderPreBindVerb = "extender.preBindVerb"
+	// ExtenderPreBindRollBackVerb is the verb of PreBindRollBack method
+	ExtenderPreBindRollBackVerb = "extender.preBindRollBackVerb"
 	// ExtenderIgnorable indicates whether the extender can ignore unexpected errors
 	ExtenderIgnorable = "extender.ignorable"
 
@@ -76,27 +89,36 @@ const (
 )
 
 type extenderConfig struct {
-	urlPrefix          string
-	httpTimeout        time.Duration
-	onSessionOpenVerb  string
-	onSessionCloseVerb string
-	predicateVerb      string
-	prioritizeVerb     string
-	preemptableVerb    string
-	reclaimableVerb    string
-	queueOverusedVerb  string
-	jobEnqueueableVerb string
-	jobEnqueuedVerb    string
-	jobReadyVerb       string
-	allocateFuncVerb   string
-	deallocateFuncVerb string
-	ignorable          bool
-	managedResources   sets.Set[string]
+	urlPrefix           string
+	grpcAddress         string
+	httpTimeout         time.Duration
+	onSessionOpenVerb   string
+	onSessionCloseVerb  string
+	predicateVerb       string
+	prioritizeVerb      string
+	preemptableVerb     string
+	reclaimableVerb     string
+	queueOverusedVerb   string
+	jobEnqueueableVerb  string
+	jobEnqueuedVerb     string
+	jobReadyVerb        string
+	allocateFuncVerb    string
+	deallocateFuncVerb  string
+	hyperNodeOrderVerb  string
+	victimTasksVerb     string
+	preBindVerb         string
+	preBindRollBackVerb string
+	ignorable           bool
+	managedResources    sets.Set[string]
 }
 
 type extenderPlugin struct {
 	client http.Client
 	config *extenderConfig
+	// grpc is the client of the gRPC extender, nil if the extender is called over http.
+	grpc *grpcClient
+	// grpcErr is the error connecting to the gRPC extender, every call fails with it instead of falling back to http.
+	grpcErr error
 }
 
 func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
@@ -122,15 +144,24 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 				   extender.reclaimableVerb: reclaimable
 				   extender.queueOverusedVerb: queueOverused
 				   extender.jobEnqueueableVerb: jobEnqueueable
+				   extender.hyperNodeOrderVerb: hyperNodeOrder
+				   extender.victimTasksVerb: victimTasks
+				   extender.preBindVerb: preBind
+				   extender.preBindRollBackVerb: preBindRollBack
 				   extender.ignorable: true
 				   extender.managedResources:
 				   - nvidia.com/gpu
 				   - nvidia.com/gpumem
 		     - name: proportion
 		     - name: nodeorder
+
+		The extender is called over gRPC if extender.grpcAddress is set, e.g. 127.0.0.1:8714. The verbs then
+		only choose which calls are made, the methods are the ones of the v1 schema in proto/v1. If the extender
+		can not be connected, the calls fail as if the extender failed them, extender.urlPrefix is not used.
 	*/
 	ec := &extenderConfig{}
 	ec.urlPrefix, _ = arguments[ExtenderURLPrefix].(string)
+	ec.grpcAddress, _ = arguments[ExtenderGRPCAddress].(string)
 	ec.onSessionOpenVerb, _ = arguments[ExtenderOnSessionOpenVerb].(string)
 	ec.onSessionCloseVerb, _ = arguments[ExtenderOnSessionCloseVerb].(string)
 	ec.predicateVerb, _ = arguments[ExtenderPredicateVerb].(string)
@@ -143,6 +174,10 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 	ec.jobReadyVerb, _ = arguments[ExtenderJobReadyVerb].(string)
 	ec.allocateFuncVerb, _ = arguments[ExtenderAllocateFuncVerb].(string)
 	ec.deallocateFuncVerb, _ = arguments[ExtenderDeallocateFuncVerb].(string)
+	ec.hyperNodeOrderVerb, _ = arguments[ExtenderHyperNodeOrderVerb].(string)
+	ec.victimTasksVerb, _ = arguments[ExtenderVictimTasksVerb].(string)
+	ec.preBindVerb, _ = arguments[ExtenderPreBindVerb].(string)
+	ec.preBindRollBackVerb, _ = arguments[ExtenderPreBindRollBackVerb].(string)
 
 	arguments.GetBool(&ec.ignorable, ExtenderIgnorable)
 
@@ -162,8 +197,21 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 
 func New(arguments framework.Arguments) framework.Plugin {
 	cfg := parseExtenderConfig(arguments)
-	klog.V(4).Infof("Initialize extender plugin with endpoint address %s", cfg.urlPrefix)
-	return &extenderPlugin{client: http.Client{Timeout: cfg.httpTimeout}, config: cfg}
+	ep := &extenderPlugin{client: http.Client{Timeout: cfg.httpTimeout}, config: cfg}
+	if cfg.grpcAddress == "" {
+		klog.V(4).Infof("Initialize extender plugin with endpoint address %s", cfg.urlPrefix)
+		return ep
+	}
+
+	klog.V(4).Infof("Initialize extender plugin with gRPC address %s", cfg.grpcAddress)
+	gc, err := newGRPCClient(cfg)
+	if err != nil {
+		klog.Errorf("Failed to connect to gRPC extender %s: %v", cfg.grpcAddress, err)
+		ep.grpcErr = fmt.Errorf("failed to connect to gRPC extender %s: %v", cfg.grpcAddress, err)
+		return ep
+	}
+	ep.grpc = gc
+	return ep
 }
 
 func (ep *extenderPlugin) Name() string {
@@ -172,13 +220,18 @@ func (ep *extenderPlugin) Name() string {
 
 func (ep *extenderPlugin) OnSessionOpen(ssn *framework.Session) {
 	if ep.config.onSessionOpenVerb != "" {
-		err := ep.send(ep.config.onSessionOpenVerb, &OnSessionOpenRequest{
-			Jobs:           ssn.Jobs,
-			Nodes:          ssn.Nodes,
-			Queues:         ssn.Queues,
-			NamespaceInfo:  ssn.NamespaceInfo,
-			RevocableNodes: ssn.RevocableNodes,
-		}, nil)
+		var err error
+		if ep.grpc != nil {
+			err = ep.grpc.onSessionOpen(ssn)
+		} else {
+			err = ep.send(ep.config.onSessionOpenVerb, &OnSessionOpenRequest{
+				Jobs:           ssn.Jobs,
+				Nodes:          ssn.Nodes,
+				Queues:         ssn.Queues,
+				NamespaceInfo:  ssn.NamespaceInfo,
+				RevocableNodes: ssn.RevocableNodes,
+			}, nil)
+		}
 		if err != nil {
 			klog.Warningf("OnSessionClose failed with error %v", err)
 		}
@@ -187,6 +240,27 @@ func (ep *extenderPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 	}
 
+	if ep.config.predicateVerb != "" && ep.grpc != nil {
+		// The gRPC extender checks the task against all the nodes at once, before the predicate of each node.
+		ssn.AddPrePredicateFn(ep.Name(), func(task *api.TaskInfo) error {
+			if !ep.IsInterested(task) {
+				return nil
+			}
+			if err := ep.grpc.prefetchPredicates(task, ssn.NodeList); err != nil {
+				klog.Warningf("Batched predicate failed with error %v", err)
+			}
+			return nil
+		})
+		ssn.AddEventHandler(&framework.EventHandler{
+			AllocateFunc: func(_ *framework.Event) {
+				ep.grpc.resetPredicates()
+			},
+			DeallocateFunc: func(_ *framework.Event) {
+				ep.grpc.resetPredicates()
+			},
+		})
+	}
+
 	if ep.config.predicateVerb != "" {
 		ssn.AddPredicateFn(ep.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
 			if !ep.IsInterested(task) {
@@ -340,9 +414,85 @@ func (ep *extenderPlugin) OnSessionOpen(ssn *framework.Session) {
 		})
 	}
 
+	if ep.config.hyperNodeOrderVerb != "" {
+		ssn.AddHyperNodeOrderFn(ep.Name(), func(subJob *api.SubJobInfo, hyperNodes map[string][]*api.NodeInfo) (map[string]float64, error) {
+			resp := &HyperNodeOrderResponse{}
+			err := ep.send(ep.config.hyperNodeOrderVerb, &HyperNodeOrderRequest{SubJob: subJob, HyperNodes: hyperNodes}, resp)
+			if err != nil {
+				klog.Warningf("HyperNodeOrder failed with error %v", err)
+
+				if ep.config.ignorable {
+					return nil, nil
+				}
+				return nil, err
+			}
+
+			if resp.ErrorMessage == "" && resp.HyperNodeScore != nil {
+				return resp.HyperNodeScore, nil
+			}
+			return nil, errors.New(resp.ErrorMessage)
+		})
+	}
+
+	if ep.config.victimTasksVerb != "" {
+		ssn.AddVictimTasksFns(ep.Name(), []api.VictimTasksFn{func(tasks []*api.TaskInfo) []*api.TaskInfo {
+			resp := &VictimTasksResponse{}
+			err := ep.send(ep.config.victimTasksVerb, &VictimTasksRequest{Tasks: tasks}, resp)
+			if err != nil {
+				klog.Warningf("VictimTasks failed with error %v", err)
+				return nil
+			}
+
+			// The victims are decoded from the response, return the tasks of the session instead.
+			uids := make([]string, 0, len(resp.Victims))
+			for _, victim := range resp.Victims {
+				uids = append(uids, string(victim.UID))
+			}
+			return victims(tasks, uids)
+		}})
+	}
+
+	// The binder is registered even without the verb, so that it replaces the one of a previous configuration.
+	ssn.RegisterBinder(ep.Name(), ep)
+
 	addEventHandler(ssn, ep)
 }
 
+// PreBind lets the extender veto the allocation of the task before it is bound.
+func (ep *extenderPlugin) PreBind(_ context.Context, bindCtx *cache.BindContext) error {
+	if ep.config.preBindVerb == "" || !ep.IsInterested(bindCtx.TaskInfo) {
+		return nil
+	}
+
+	resp := &PreBindResponse{}
+	if err := ep.send(ep.config.preBindVerb, &PreBindRequest{Task: bindCtx.TaskInfo}, resp); err != nil {
+		klog.Warningf("PreBind failed with error %v", err)
+
+		if ep.config.ignorable {
+			return nil
+		}
+		return err
+	}
+
+	if resp.ErrorMessage != "" {
+		return fmt.Errorf("extender vetoed binding task %s/%s to node %s: %s",
+			bindCtx.TaskInfo.Namespace, bindCtx.TaskInfo.Name, bindCtx.TaskInfo.NodeName, resp.ErrorMessage)
+	}
+	return nil
+}
+
+// PreBindRollBack notifies the extender that the task was not bound after the pre-bind.
+func (ep *extenderPlugin) PreBindRollBack(_ context.Context, bindCtx *cache.BindContext) {
+	if ep.config.preBindRollBackVerb == "" || !ep.IsInterested(bindCtx.TaskInfo) {
+		return
+	}
+
+	resp := &PreBindRollBackResponse{}
+	if err := ep.send(ep.config.preBindRollBackVerb, &PreBindRollBackRequest{Task: bindCtx.TaskInfo}, resp); err != nil {
+		klog.Warningf("PreBindRollBack failed with error %v", err)
+	}
+}
+
 func (ep *extenderPlugin) OnSessionClose(ssn *framework.Session) {
 	if ep.config.onSessionCloseVerb != "" {
 		if err := ep.send(ep.config.onSessionCloseVerb, &OnSessionCloseRequest{}, nil); err != nil {
@@ -352,6 +502,13 @@ func (ep *extenderPlugin) OnSessionClose(ssn *framework.Session) {
 }
 
 func (ep *extenderPlugin) send(action string, args interface{}, result interface{}) error {
+	if ep.grpc != nil {
+		return ep.grpc.send(action, args, result)
+	}
+	if ep.grpcErr != nil {
+		return ep.grpcErr
+	}
+
 	out, err := json.Marshal(args)
 	if err != nil {
 		return err
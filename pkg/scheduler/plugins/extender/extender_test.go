@@ -17,16 +17,21 @@ limitations under the License.
 package extender
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
 func TestMaxBodySizeLimit2(t *testing.T) {
@@ -422,3 +427,52 @@ func TestHasResourcesInList(t *testing.T) {
 		})
 	}
 }
+
+func TestPreBind(t *testing.T) {
+	var rolledBack []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &PreBindRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/preBind":
+			resp := &PreBindResponse{}
+			if req.Task.NodeName == "n2" {
+				resp.ErrorMessage = "node n2 is drained"
+			}
+			json.NewEncoder(w).Encode(resp)
+		case "/preBindRollBack":
+			rolledBack = append(rolledBack, req.Task.Name)
+			json.NewEncoder(w).Encode(&PreBindRollBackResponse{})
+		}
+	}))
+	defer server.Close()
+
+	plugin := &extenderPlugin{
+		client: http.Client{Timeout: time.Second},
+		config: &extenderConfig{
+			urlPrefix:           server.URL,
+			preBindVerb:         "preBind",
+			preBindRollBackVerb: "preBindRollBack",
+		},
+	}
+
+	buildBindContext := func(name, node string) *cache.BindContext {
+		task := api.NewTaskInfo(util.BuildPod("ns1", name, node, corev1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil))
+		return &cache.BindContext{TaskInfo: task}
+	}
+
+	if err := plugin.PreBind(context.TODO(), buildBindContext("p1", "n1")); err != nil {
+		t.Errorf("expected p1 to be bound to n1, got %v", err)
+	}
+	vetoed := buildBindContext("p2", "n2")
+	if err := plugin.PreBind(context.TODO(), vetoed); err == nil || !strings.Contains(err.Error(), "node n2 is drained") {
+		t.Errorf("expected p2 to be vetoed by the extender, got %v", err)
+	}
+	plugin.PreBindRollBack(context.TODO(), vetoed)
+	if len(rolledBack) != 1 || rolledBack[0] != "p2" {
+		t.Errorf("expected the roll back of p2, got %v", rolledBack)
+	}
+}
@@ -0,0 +1,400 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	extenderv1 "volcano.sh/volcano/pkg/scheduler/plugins/extender/proto/v1"
+)
+
+var (
+	// grpcDial connects to an extender, it is replaced in tests.
+	grpcDial = func(address string) (*grpc.ClientConn, error) {
+		return grpc.NewClient(address,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxBodySize)))
+	}
+
+	// The connections and the states last sent to the extenders by address, they outlive the
+	// plugin which is created again at every session.
+	grpcMutex     sync.Mutex
+	grpcConns     = map[string]*grpc.ClientConn{}
+	sessionStates = map[string]*sessionState{}
+)
+
+// sessionState is the state of the cluster an extender holds, as the hashes of the objects sent to it.
+type sessionState struct {
+	generation uint64
+	jobs       map[string]uint64
+	nodes      map[string]uint64
+	queues     map[string]uint64
+}
+
+// grpcClient calls an extender serving the v1 gRPC schema.
+type grpcClient struct {
+	address string
+	config  *extenderConfig
+	client  extenderv1.ExtenderClient
+	session string
+
+	// The results of the batched predicates by task, nodes which are left out fit. Jobs are planned in
+	// parallel, so the results of several tasks are kept at the same time.
+	predicateMutex   sync.RWMutex
+	predicateResults map[api.TaskID]map[string]*extenderv1.PredicateResult
+}
+
+func newGRPCClient(config *extenderConfig) (*grpcClient, error) {
+	grpcMutex.Lock()
+	defer grpcMutex.Unlock()
+
+	conn, found := grpcConns[config.grpcAddress]
+	if !found {
+		var err error
+		if conn, err = grpcDial(config.grpcAddress); err != nil {
+			return nil, err
+		}
+		grpcConns[config.grpcAddress] = conn
+	}
+	return &grpcClient{
+		address:          config.grpcAddress,
+		config:           config,
+		client:           extenderv1.NewExtenderClient(conn),
+		predicateResults: map[api.TaskID]map[string]*extenderv1.PredicateResult{},
+	}, nil
+}
+
+func (gc *grpcClient) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), gc.config.httpTimeout)
+}
+
+// onSessionOpen sends the jobs, nodes and queues which changed since the state the extender holds, or all
+// of them if it holds none or asks for a resync.
+func (gc *grpcClient) onSessionOpen(ssn *framework.Session) error {
+	gc.session = string(ssn.UID)
+
+	grpcMutex.Lock()
+	base := sessionStates[gc.address]
+	grpcMutex.Unlock()
+
+	resp, state, err := gc.sendSessionOpen(ssn, base)
+	if err == nil && resp.Resync && base != nil {
+		klog.V(3).Infof("Extender %s asked for a resync at generation %d", gc.address, base.generation)
+		resp, state, err = gc.sendSessionOpen(ssn, nil)
+	}
+
+	grpcMutex.Lock()
+	defer grpcMutex.Unlock()
+	if err != nil || resp.Resync {
+		delete(sessionStates, gc.address)
+		return err
+	}
+	sessionStates[gc.address] = state
+	return nil
+}
+
+func (gc *grpcClient) sendSessionOpen(ssn *framework.Session, base *sessionState) (*extenderv1.OnSessionOpenResponse, *sessionState, error) {
+	state := &sessionState{
+		generation: 1,
+		jobs:       map[string]uint64{},
+		nodes:      map[string]uint64{},
+		queues:     map[string]uint64{},
+	}
+	req := &extenderv1.OnSessionOpenRequest{Session: gc.session}
+	var baseJobs, baseNodes, baseQueues map[string]uint64
+	if base != nil {
+		req.BaseGeneration = base.generation
+		state.generation = base.generation + 1
+		baseJobs, baseNodes, baseQueues = base.jobs, base.nodes, base.queues
+	}
+	req.Generation = state.generation
+
+	for _, job := range ssn.Jobs {
+		msg := convertJob(job)
+		if changed(state.jobs, baseJobs, msg.Uid, msg) {
+			req.Jobs = append(req.Jobs, msg)
+		}
+	}
+	for _, node := range ssn.Nodes {
+		msg := convertNode(node)
+		if changed(state.nodes, baseNodes, msg.Name, msg) {
+			req.Nodes = append(req.Nodes, msg)
+		}
+	}
+	for _, queue := range ssn.Queues {
+		msg := convertQueue(queue)
+		if changed(state.queues, baseQueues, msg.Uid, msg) {
+			req.Queues = append(req.Queues, msg)
+		}
+	}
+	req.RemovedJobs = removed(state.jobs, baseJobs)
+	req.RemovedNodes = removed(state.nodes, baseNodes)
+	req.RemovedQueues = removed(state.queues, baseQueues)
+
+	sort.Slice(req.Jobs, func(i, j int) bool { return req.Jobs[i].Uid < req.Jobs[j].Uid })
+	sort.Slice(req.Nodes, func(i, j int) bool { return req.Nodes[i].Name < req.Nodes[j].Name })
+	sort.Slice(req.Queues, func(i, j int) bool { return req.Queues[i].Uid < req.Queues[j].Uid })
+
+	klog.V(4).Infof("Send session open to extender %s at generation %d: %d jobs, %d nodes, %d queues changed",
+		gc.address, req.Generation, len(req.Jobs), len(req.Nodes), len(req.Queues))
+
+	ctx, cancel := gc.context()
+	defer cancel()
+	resp, err := gc.client.OnSessionOpen(ctx, req)
+	return resp, state, err
+}
+
+// changed records the hash of the object in the state, and returns whether it differs from the base.
+func changed(state, base map[string]uint64, key string, msg proto.Message) bool {
+	out, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		klog.Errorf("Failed to marshal %s for the extender: %v", key, err)
+		return true
+	}
+	hash := fnv.New64a()
+	hash.Write(out)
+	state[key] = hash.Sum64()
+	previous, found := base[key]
+	return !found || previous != state[key]
+}
+
+func removed(state, base map[string]uint64) []string {
+	var keys []string
+	for key := range base {
+		if _, found := state[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// prefetchPredicates checks the task against all the nodes in one call, the predicate of each node then
+// reads the result instead of calling the extender.
+func (gc *grpcClient) prefetchPredicates(task *api.TaskInfo, nodes []*api.NodeInfo) error {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+
+	gc.predicateMutex.Lock()
+	delete(gc.predicateResults, task.UID)
+	gc.predicateMutex.Unlock()
+
+	results, err := gc.predicate(task, names)
+	if err != nil {
+		return err
+	}
+	if results == nil {
+		results = map[string]*extenderv1.PredicateResult{}
+	}
+
+	gc.predicateMutex.Lock()
+	defer gc.predicateMutex.Unlock()
+	gc.predicateResults[task.UID] = results
+	return nil
+}
+
+// resetPredicates drops the results of all the tasks, they are stale once a task is allocated or deallocated.
+func (gc *grpcClient) resetPredicates() {
+	gc.predicateMutex.Lock()
+	defer gc.predicateMutex.Unlock()
+	gc.predicateResults = map[api.TaskID]map[string]*extenderv1.PredicateResult{}
+}
+
+func (gc *grpcClient) predicate(task *api.TaskInfo, nodes []string) (map[string]*extenderv1.PredicateResult, error) {
+	ctx, cancel := gc.context()
+	defer cancel()
+	resp, err := gc.client.Predicate(ctx, &extenderv1.PredicateRequest{Task: convertTask(task), Nodes: nodes})
+	if err != nil {
+		return nil, err
+	}
+	return resp.FailedNodes, nil
+}
+
+func (gc *grpcClient) predicateNode(task *api.TaskInfo, node string) (*extenderv1.PredicateResult, error) {
+	gc.predicateMutex.RLock()
+	results, found := gc.predicateResults[task.UID]
+	gc.predicateMutex.RUnlock()
+	if found {
+		return results[node], nil
+	}
+
+	results, err := gc.predicate(task, []string{node})
+	if err != nil {
+		return nil, err
+	}
+	return results[node], nil
+}
+
+// send calls the extender with the request of the HTTP verb, and fills the HTTP response from the reply.
+func (gc *grpcClient) send(action string, args interface{}, result interface{}) error {
+	ctx, cancel := gc.context()
+	defer cancel()
+
+	switch req := args.(type) {
+	case *OnSessionCloseRequest:
+		_, err := gc.client.OnSessionClose(ctx, &extenderv1.OnSessionCloseRequest{Session: gc.session})
+		return err
+	case *PredicateRequest:
+		res, err := gc.predicateNode(req.Task, req.Node.Name)
+		if err != nil {
+			return err
+		}
+		if res != nil {
+			resp := result.(*PredicateResponse)
+			resp.Code, resp.ErrorMessage = int(res.Code), res.Reason
+		}
+		return nil
+	case *PrioritizeRequest:
+		nodes := make([]string, 0, len(req.Nodes))
+		for _, node := range req.Nodes {
+			nodes = append(nodes, node.Name)
+		}
+		res, err := gc.client.Prioritize(ctx, &extenderv1.PrioritizeRequest{Task: convertTask(req.Task), Nodes: nodes})
+		if err != nil {
+			return err
+		}
+		resp := result.(*PrioritizeResponse)
+		resp.NodeScore = res.NodeScores
+		if resp.NodeScore == nil {
+			resp.NodeScore = map[string]float64{}
+		}
+		return nil
+	case *PreemptableRequest:
+		res, err := gc.client.Preemptable(ctx, &extenderv1.EvictableRequest{Evictor: convertTask(req.Evictor), Evictees: convertTasks(req.Evictees)})
+		if err != nil {
+			return err
+		}
+		resp := result.(*PreemptableResponse)
+		resp.Status, resp.Victims = int(res.Status), victims(req.Evictees, res.Victims)
+		return nil
+	case *ReclaimableRequest:
+		res, err := gc.client.Reclaimable(ctx, &extenderv1.EvictableRequest{Evictor: convertTask(req.Evictor), Evictees: convertTasks(req.Evictees)})
+		if err != nil {
+			return err
+		}
+		resp := result.(*ReclaimableResponse)
+		resp.Status, resp.Victims = int(res.Status), victims(req.Evictees, res.Victims)
+		return nil
+	case *JobEnqueueableRequest:
+		res, err := gc.client.JobEnqueueable(ctx, &extenderv1.JobRequest{Job: convertJob(req.Job)})
+		if err != nil {
+			return err
+		}
+		result.(*JobEnqueueableResponse).Status = int(res.Status)
+		return nil
+	case *JobEnqueuedRequest:
+		_, err := gc.client.JobEnqueued(ctx, &extenderv1.JobRequest{Job: convertJob(req.Job)})
+		return err
+	case *QueueOverusedRequest:
+		res, err := gc.client.QueueOverused(ctx, &extenderv1.QueueOverusedRequest{Queue: convertQueue(req.Queue)})
+		if err != nil {
+			return err
+		}
+		result.(*QueueOverusedResponse).Overused = res.Overused
+		return nil
+	case *JobReadyRequest:
+		res, err := gc.client.JobReady(ctx, &extenderv1.JobRequest{Job: convertJob(req.Job)})
+		if err != nil {
+			return err
+		}
+		result.(*JobReadyResponse).Status = res.Ready
+		return nil
+	case *EventHandlerRequest:
+		call := gc.client.Allocate
+		if action == gc.config.deallocateFuncVerb {
+			call = gc.client.Deallocate
+		}
+		res, err := call(ctx, &extenderv1.TaskEventRequest{Task: convertTask(req.Task)})
+		if err != nil {
+			return err
+		}
+		result.(*EventHandlerResponse).ErrorMessage = res.ErrorMessage
+		return nil
+	case *HyperNodeOrderRequest:
+		msg := &extenderv1.HyperNodeOrderRequest{HyperNodes: make(map[string]*extenderv1.NodeNames, len(req.HyperNodes))}
+		if req.SubJob != nil {
+			msg.Job, msg.SubJob = string(req.SubJob.Job), string(req.SubJob.UID)
+			for _, task := range req.SubJob.Tasks {
+				msg.Tasks = append(msg.Tasks, convertTask(task))
+			}
+			sort.Slice(msg.Tasks, func(i, j int) bool { return msg.Tasks[i].Uid < msg.Tasks[j].Uid })
+		}
+		for hyperNode, nodes := range req.HyperNodes {
+			names := &extenderv1.NodeNames{Nodes: make([]string, 0, len(nodes))}
+			for _, node := range nodes {
+				names.Nodes = append(names.Nodes, node.Name)
+			}
+			msg.HyperNodes[hyperNode] = names
+		}
+		res, err := gc.client.HyperNodeOrder(ctx, msg)
+		if err != nil {
+			return err
+		}
+		resp := result.(*HyperNodeOrderResponse)
+		resp.HyperNodeScore = res.HyperNodeScores
+		if resp.HyperNodeScore == nil {
+			resp.HyperNodeScore = map[string]float64{}
+		}
+		return nil
+	case *VictimTasksRequest:
+		res, err := gc.client.VictimTasks(ctx, &extenderv1.VictimTasksRequest{Tasks: convertTasks(req.Tasks)})
+		if err != nil {
+			return err
+		}
+		result.(*VictimTasksResponse).Victims = victims(req.Tasks, res.Victims)
+		return nil
+	case *PreBindRequest:
+		res, err := gc.client.PreBind(ctx, &extenderv1.PreBindRequest{Task: convertTask(req.Task)})
+		if err != nil {
+			return err
+		}
+		result.(*PreBindResponse).ErrorMessage = res.ErrorMessage
+		return nil
+	case *PreBindRollBackRequest:
+		_, err := gc.client.PreBindRollBack(ctx, &extenderv1.PreBindRequest{Task: convertTask(req.Task)})
+		return err
+	}
+	return fmt.Errorf("unsupported request %T of %s for gRPC extender %s", args, action, gc.address)
+}
+
+// victims returns the evictees selected by uid.
+func victims(evictees []*api.TaskInfo, uids []string) []*api.TaskInfo {
+	selected := make(map[string]bool, len(uids))
+	for _, uid := range uids {
+		selected[uid] = true
+	}
+	var tasks []*api.TaskInfo
+	for _, evictee := range evictees {
+		if selected[string(evictee.UID)] {
+			tasks = append(tasks, evictee)
+		}
+	}
+	return tasks
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	v1 "k8s.io/api/core/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	extenderv1 "volcano.sh/volcano/pkg/scheduler/plugins/extender/proto/v1"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+type fakeExtender struct {
+	extenderv1.UnimplementedExtenderServer
+
+	mutex       sync.Mutex
+	resync      bool
+	opens       []*extenderv1.OnSessionOpenRequest
+	predicates  []*extenderv1.PredicateRequest
+	failedNodes map[string]*extenderv1.PredicateResult
+}
+
+func (fe *fakeExtender) OnSessionOpen(_ context.Context, req *extenderv1.OnSessionOpenRequest) (*extenderv1.OnSessionOpenResponse, error) {
+	fe.mutex.Lock()
+	defer fe.mutex.Unlock()
+	fe.opens = append(fe.opens, req)
+	resync := fe.resync && req.BaseGeneration != 0
+	return &extenderv1.OnSessionOpenResponse{Resync: resync}, nil
+}
+
+func (fe *fakeExtender) HyperNodeOrder(_ context.Context, req *extenderv1.HyperNodeOrderRequest) (*extenderv1.HyperNodeOrderResponse, error) {
+	// Prefer the smallest hyperNode which holds all the tasks.
+	scores := map[string]float64{}
+	for hyperNode, names := range req.HyperNodes {
+		if len(names.Nodes) >= len(req.Tasks) {
+			scores[hyperNode] = 100 / float64(len(names.Nodes))
+		}
+	}
+	return &extenderv1.HyperNodeOrderResponse{HyperNodeScores: scores}, nil
+}
+
+func (fe *fakeExtender) VictimTasks(_ context.Context, req *extenderv1.VictimTasksRequest) (*extenderv1.VictimTasksResponse, error) {
+	resp := &extenderv1.VictimTasksResponse{}
+	for _, task := range req.Tasks {
+		if task.Labels["preemptible"] == "true" {
+			resp.Victims = append(resp.Victims, task.Uid)
+		}
+	}
+	return resp, nil
+}
+
+func (fe *fakeExtender) Predicate(_ context.Context, req *extenderv1.PredicateRequest) (*extenderv1.PredicateResponse, error) {
+	fe.mutex.Lock()
+	defer fe.mutex.Unlock()
+	fe.predicates = append(fe.predicates, req)
+	resp := &extenderv1.PredicateResponse{FailedNodes: map[string]*extenderv1.PredicateResult{}}
+	for _, node := range req.Nodes {
+		if result, found := fe.failedNodes[node]; found {
+			resp.FailedNodes[node] = result
+		}
+	}
+	return resp, nil
+}
+
+// startFakeExtender serves the fake extender in memory, and returns the config of an extender plugin calling it.
+func startFakeExtender(t *testing.T, fe *fakeExtender) *extenderConfig {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	extenderv1.RegisterExtenderServer(server, fe)
+	go server.Serve(listener)
+
+	dial := grpcDial
+	grpcDial = func(address string) (*grpc.ClientConn, error) {
+		return grpc.NewClient("passthrough:///"+address,
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	t.Cleanup(func() {
+		grpcDial = dial
+		grpcMutex.Lock()
+		for _, conn := range grpcConns {
+			conn.Close()
+		}
+		grpcConns = map[string]*grpc.ClientConn{}
+		sessionStates = map[string]*sessionState{}
+		grpcMutex.Unlock()
+		server.Stop()
+	})
+
+	return &extenderConfig{grpcAddress: "bufnet", httpTimeout: 5 * time.Second}
+}
+
+func TestGRPCSessionOpenDelta(t *testing.T) {
+	fe := &fakeExtender{}
+	config := startFakeExtender(t, fe)
+
+	node1 := api.NewNodeInfo(util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+	node2 := api.NewNodeInfo(util.BuildNode("n2", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+	pod1 := util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	pod2 := util.BuildPod("ns1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2", nil, nil)
+	job1 := api.NewJobInfo("ns1/pg1", api.NewTaskInfo(pod1))
+	job2 := api.NewJobInfo("ns1/pg2", api.NewTaskInfo(pod2))
+	queue := &api.QueueInfo{UID: "q1", Name: "q1", Weight: 1}
+
+	ssn := &framework.Session{
+		UID:    "s1",
+		Jobs:   map[api.JobID]*api.JobInfo{job1.UID: job1, job2.UID: job2},
+		Nodes:  map[string]*api.NodeInfo{node1.Name: node1, node2.Name: node2},
+		Queues: map[api.QueueID]*api.QueueInfo{queue.UID: queue},
+	}
+	openSession := func() *extenderv1.OnSessionOpenRequest {
+		gc, err := newGRPCClient(config)
+		assert.NoError(t, err)
+		assert.NoError(t, gc.onSessionOpen(ssn))
+		return fe.opens[len(fe.opens)-1]
+	}
+
+	// The first session sends the full snapshot.
+	full := openSession()
+	assert.Equal(t, uint64(0), full.BaseGeneration)
+	assert.Equal(t, uint64(1), full.Generation)
+	assert.Len(t, full.Jobs, 2)
+	assert.Len(t, full.Nodes, 2)
+	assert.Len(t, full.Queues, 1)
+
+	// The next one sends only the node which got a task and the job which is gone.
+	task := api.NewTaskInfo(util.BuildPod("ns1", "p3", "n2", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg3", nil, nil))
+	assert.NoError(t, node2.AddTask(task))
+	delete(ssn.Jobs, job2.UID)
+	delta := openSession()
+	assert.Equal(t, uint64(1), delta.BaseGeneration)
+	assert.Equal(t, uint64(2), delta.Generation)
+	assert.Empty(t, delta.Jobs)
+	assert.Equal(t, []string{string(job2.UID)}, delta.RemovedJobs)
+	if assert.Len(t, delta.Nodes, 1) {
+		assert.Equal(t, "n2", delta.Nodes[0].Name)
+		assert.Equal(t, []string{string(task.UID)}, delta.Nodes[0].Tasks)
+	}
+	assert.Empty(t, delta.Queues)
+
+	// Nothing changed, nothing is sent.
+	empty := openSession()
+	assert.Equal(t, uint64(2), empty.BaseGeneration)
+	assert.Empty(t, empty.Jobs)
+	assert.Empty(t, empty.Nodes)
+	assert.Empty(t, empty.Queues)
+
+	// The extender lost its state, the full snapshot is sent again in the same session.
+	fe.resync = true
+	resync := openSession()
+	assert.Len(t, fe.opens, 5)
+	assert.Equal(t, uint64(0), resync.BaseGeneration)
+	assert.Equal(t, uint64(1), resync.Generation)
+	assert.Len(t, resync.Jobs, 1)
+	assert.Len(t, resync.Nodes, 2)
+	assert.Len(t, resync.Queues, 1)
+}
+
+func TestGRPCDialFailure(t *testing.T) {
+	var httpCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		httpCalls.Add(1)
+	}))
+	defer server.Close()
+
+	dial := grpcDial
+	grpcDial = func(address string) (*grpc.ClientConn, error) {
+		return nil, errors.New("invalid target")
+	}
+	defer func() {
+		grpcDial = dial
+	}()
+
+	ep := New(framework.Arguments{
+		ExtenderGRPCAddress:   "bufnet",
+		ExtenderURLPrefix:     server.URL,
+		ExtenderPredicateVerb: "predicate",
+	}).(*extenderPlugin)
+	task := api.NewTaskInfo(util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil))
+	node := api.NewNodeInfo(util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+
+	// The calls fail with the dial error instead of falling back to http.
+	err := ep.send(ep.config.predicateVerb, &PredicateRequest{Task: task, Node: node}, &PredicateResponse{})
+	assert.ErrorContains(t, err, "invalid target")
+	assert.Equal(t, int32(0), httpCalls.Load())
+}
+
+func TestGRPCBatchedPredicate(t *testing.T) {
+	unschedulable := &extenderv1.PredicateResult{Code: int32(api.Unschedulable), Reason: "no device"}
+	fe := &fakeExtender{failedNodes: map[string]*extenderv1.PredicateResult{"n2": unschedulable}}
+	config := startFakeExtender(t, fe)
+	config.predicateVerb = "predicate"
+
+	gc, err := newGRPCClient(config)
+	assert.NoError(t, err)
+	ep := &extenderPlugin{config: config, grpc: gc}
+
+	var nodes []*api.NodeInfo
+	for _, name := range []string{"n1", "n2", "n3"} {
+		nodes = append(nodes, api.NewNodeInfo(util.BuildNode(name, api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)))
+	}
+	task1 := api.NewTaskInfo(util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil))
+	task2 := api.NewTaskInfo(util.BuildPod("ns1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil))
+
+	// The task is checked against all the nodes in one call, the predicates of the nodes read the results.
+	assert.NoError(t, gc.prefetchPredicates(task1, nodes))
+	for _, node := range nodes {
+		resp := &PredicateResponse{}
+		assert.NoError(t, ep.send(config.predicateVerb, &PredicateRequest{Task: task1, Node: node}, resp))
+		if node.Name == "n2" {
+			assert.Equal(t, PredicateResponse{Code: api.Unschedulable, ErrorMessage: "no device"}, *resp)
+		} else {
+			assert.Equal(t, PredicateResponse{}, *resp)
+		}
+	}
+	if assert.Len(t, fe.predicates, 1) {
+		assert.Equal(t, []string{"n1", "n2", "n3"}, fe.predicates[0].Nodes)
+		assert.True(t, proto.Equal(convertTask(task1), fe.predicates[0].Task))
+	}
+
+	// Another task is checked node by node.
+	resp := &PredicateResponse{}
+	assert.NoError(t, ep.send(config.predicateVerb, &PredicateRequest{Task: task2, Node: nodes[1]}, resp))
+	assert.Equal(t, api.Unschedulable, resp.Code)
+	if assert.Len(t, fe.predicates, 2) {
+		assert.Equal(t, []string{"n2"}, fe.predicates[1].Nodes)
+	}
+
+	// Tasks of jobs planned in parallel keep their own results.
+	assert.NoError(t, gc.prefetchPredicates(task2, nodes))
+	var wg sync.WaitGroup
+	for _, task := range []*api.TaskInfo{task1, task2} {
+		for _, node := range nodes {
+			wg.Add(1)
+			go func(task *api.TaskInfo, node *api.NodeInfo) {
+				defer wg.Done()
+				resp := &PredicateResponse{}
+				assert.NoError(t, ep.send(config.predicateVerb, &PredicateRequest{Task: task, Node: node}, resp))
+				assert.Equal(t, node.Name == "n2", resp.Code == api.Unschedulable, "task %s on node %s", task.Name, node.Name)
+			}(task, node)
+		}
+	}
+	wg.Wait()
+	assert.Len(t, fe.predicates, 3)
+
+	// An allocation drops the results of all the tasks.
+	gc.resetPredicates()
+	assert.NoError(t, ep.send(config.predicateVerb, &PredicateRequest{Task: task1, Node: nodes[0]}, &PredicateResponse{}))
+	assert.Len(t, fe.predicates, 4)
+}
+
+func TestGRPCHyperNodeOrderAndVictimTasks(t *testing.T) {
+	config := startFakeExtender(t, &fakeExtender{})
+	gc, err := newGRPCClient(config)
+	assert.NoError(t, err)
+	ep := &extenderPlugin{config: config, grpc: gc}
+
+	buildNodes := func(names ...string) []*api.NodeInfo {
+		var nodes []*api.NodeInfo
+		for _, name := range names {
+			nodes = append(nodes, api.NewNodeInfo(util.BuildNode(name, api.BuildResourceList("4", "4Gi"), nil)))
+		}
+		return nodes
+	}
+	pod1 := util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	pod2 := util.BuildPod("ns1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", map[string]string{"preemptible": "true"}, nil)
+	task1, task2 := api.NewTaskInfo(pod1), api.NewTaskInfo(pod2)
+	subJob := &api.SubJobInfo{UID: "ns1/pg1-0", Job: "ns1/pg1", Tasks: map[api.TaskID]*api.TaskInfo{task1.UID: task1, task2.UID: task2}}
+
+	hyperNodeResp := &HyperNodeOrderResponse{}
+	assert.NoError(t, ep.send("hyperNodeOrder", &HyperNodeOrderRequest{
+		SubJob: subJob,
+		HyperNodes: map[string][]*api.NodeInfo{
+			"s0": buildNodes("n1"),
+			"s1": buildNodes("n2", "n3"),
+			"s2": buildNodes("n4", "n5", "n6", "n7"),
+		},
+	}, hyperNodeResp))
+	assert.Equal(t, map[string]float64{"s1": 50, "s2": 25}, hyperNodeResp.HyperNodeScore)
+
+	victimsResp := &VictimTasksResponse{}
+	assert.NoError(t, ep.send("victimTasks", &VictimTasksRequest{Tasks: []*api.TaskInfo{task1, task2}}, victimsResp))
+	if assert.Len(t, victimsResp.Victims, 1) {
+		assert.Same(t, task2, victimsResp.Victims[0])
+	}
+}
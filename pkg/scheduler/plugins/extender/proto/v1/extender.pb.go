@@ -0,0 +1,2139 @@
+// Copyright 2026 The Volcano Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: extender.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Resource is a set of resource quantities: milli cpu, memory in bytes, and the scalar
+// resources in milli units.
+type Resource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quantities    map[string]float64     `protobuf:"bytes,1,rep,name=quantities,proto3" json:"quantities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_extender_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Resource) GetQuantities() map[string]float64 {
+	if x != nil {
+		return x.Quantities
+	}
+	return nil
+}
+
+// Task is a pod of a job.
+type Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Job           string                 `protobuf:"bytes,4,opt,name=job,proto3" json:"job,omitempty"`
+	NodeName      string                 `protobuf:"bytes,5,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Priority      int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Resreq        *Resource              `protobuf:"bytes,8,opt,name=resreq,proto3" json:"resreq,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,10,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_extender_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Task) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Task) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *Task) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Task) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Task) GetResreq() *Resource {
+	if x != nil {
+		return x.Resreq
+	}
+	return nil
+}
+
+func (x *Task) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Task) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// Node is a node of the cluster, tasks refer to the tasks on it by uid.
+type Node struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ready         bool                   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Allocatable   *Resource              `protobuf:"bytes,4,opt,name=allocatable,proto3" json:"allocatable,omitempty"`
+	Idle          *Resource              `protobuf:"bytes,5,opt,name=idle,proto3" json:"idle,omitempty"`
+	Used          *Resource              `protobuf:"bytes,6,opt,name=used,proto3" json:"used,omitempty"`
+	Releasing     *Resource              `protobuf:"bytes,7,opt,name=releasing,proto3" json:"releasing,omitempty"`
+	Pipelined     *Resource              `protobuf:"bytes,8,opt,name=pipelined,proto3" json:"pipelined,omitempty"`
+	Tasks         []string               `protobuf:"bytes,9,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_extender_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Node) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Node) GetAllocatable() *Resource {
+	if x != nil {
+		return x.Allocatable
+	}
+	return nil
+}
+
+func (x *Node) GetIdle() *Resource {
+	if x != nil {
+		return x.Idle
+	}
+	return nil
+}
+
+func (x *Node) GetUsed() *Resource {
+	if x != nil {
+		return x.Used
+	}
+	return nil
+}
+
+func (x *Node) GetReleasing() *Resource {
+	if x != nil {
+		return x.Releasing
+	}
+	return nil
+}
+
+func (x *Node) GetPipelined() *Resource {
+	if x != nil {
+		return x.Pipelined
+	}
+	return nil
+}
+
+func (x *Node) GetTasks() []string {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// Job is a pod group and its tasks.
+type Job struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Queue         string                 `protobuf:"bytes,4,opt,name=queue,proto3" json:"queue,omitempty"`
+	Priority      int32                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	MinAvailable  int32                  `protobuf:"varint,6,opt,name=min_available,json=minAvailable,proto3" json:"min_available,omitempty"`
+	Phase         string                 `protobuf:"bytes,7,opt,name=phase,proto3" json:"phase,omitempty"`
+	Allocated     *Resource              `protobuf:"bytes,8,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	TotalRequest  *Resource              `protobuf:"bytes,9,opt,name=total_request,json=totalRequest,proto3" json:"total_request,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,10,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_extender_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Job) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Job) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *Job) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Job) GetMinAvailable() int32 {
+	if x != nil {
+		return x.MinAvailable
+	}
+	return 0
+}
+
+func (x *Job) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Job) GetAllocated() *Resource {
+	if x != nil {
+		return x.Allocated
+	}
+	return nil
+}
+
+func (x *Job) GetTotalRequest() *Resource {
+	if x != nil {
+		return x.TotalRequest
+	}
+	return nil
+}
+
+func (x *Job) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// Queue is a queue with its weight and capability, hierarchy and weights are the slash separated path
+// and weights of a hierarchical queue.
+type Queue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        int32                  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Capability    *Resource              `protobuf:"bytes,4,opt,name=capability,proto3" json:"capability,omitempty"`
+	Hierarchy     string                 `protobuf:"bytes,5,opt,name=hierarchy,proto3" json:"hierarchy,omitempty"`
+	Weights       string                 `protobuf:"bytes,6,opt,name=weights,proto3" json:"weights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Queue) Reset() {
+	*x = Queue{}
+	mi := &file_extender_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Queue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Queue) ProtoMessage() {}
+
+func (x *Queue) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Queue.ProtoReflect.Descriptor instead.
+func (*Queue) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Queue) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Queue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Queue) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Queue) GetCapability() *Resource {
+	if x != nil {
+		return x.Capability
+	}
+	return nil
+}
+
+func (x *Queue) GetHierarchy() string {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return ""
+}
+
+func (x *Queue) GetWeights() string {
+	if x != nil {
+		return x.Weights
+	}
+	return ""
+}
+
+// OnSessionOpenRequest carries the objects added or changed, and the names of the objects removed,
+// since the state of base_generation. A full snapshot has base_generation 0 and replaces the state.
+type OnSessionOpenRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Session        string                 `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	BaseGeneration uint64                 `protobuf:"varint,2,opt,name=base_generation,json=baseGeneration,proto3" json:"base_generation,omitempty"`
+	Generation     uint64                 `protobuf:"varint,3,opt,name=generation,proto3" json:"generation,omitempty"`
+	Jobs           []*Job                 `protobuf:"bytes,4,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	RemovedJobs    []string               `protobuf:"bytes,5,rep,name=removed_jobs,json=removedJobs,proto3" json:"removed_jobs,omitempty"`
+	Nodes          []*Node                `protobuf:"bytes,6,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	RemovedNodes   []string               `protobuf:"bytes,7,rep,name=removed_nodes,json=removedNodes,proto3" json:"removed_nodes,omitempty"`
+	Queues         []*Queue               `protobuf:"bytes,8,rep,name=queues,proto3" json:"queues,omitempty"`
+	RemovedQueues  []string               `protobuf:"bytes,9,rep,name=removed_queues,json=removedQueues,proto3" json:"removed_queues,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OnSessionOpenRequest) Reset() {
+	*x = OnSessionOpenRequest{}
+	mi := &file_extender_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnSessionOpenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnSessionOpenRequest) ProtoMessage() {}
+
+func (x *OnSessionOpenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnSessionOpenRequest.ProtoReflect.Descriptor instead.
+func (*OnSessionOpenRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OnSessionOpenRequest) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *OnSessionOpenRequest) GetBaseGeneration() uint64 {
+	if x != nil {
+		return x.BaseGeneration
+	}
+	return 0
+}
+
+func (x *OnSessionOpenRequest) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *OnSessionOpenRequest) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *OnSessionOpenRequest) GetRemovedJobs() []string {
+	if x != nil {
+		return x.RemovedJobs
+	}
+	return nil
+}
+
+func (x *OnSessionOpenRequest) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *OnSessionOpenRequest) GetRemovedNodes() []string {
+	if x != nil {
+		return x.RemovedNodes
+	}
+	return nil
+}
+
+func (x *OnSessionOpenRequest) GetQueues() []*Queue {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+func (x *OnSessionOpenRequest) GetRemovedQueues() []string {
+	if x != nil {
+		return x.RemovedQueues
+	}
+	return nil
+}
+
+// OnSessionOpenResponse asks for a full snapshot at the next session open if the extender does not
+// hold the state of base_generation, e.g. after it restarted.
+type OnSessionOpenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resync        bool                   `protobuf:"varint,1,opt,name=resync,proto3" json:"resync,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnSessionOpenResponse) Reset() {
+	*x = OnSessionOpenResponse{}
+	mi := &file_extender_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnSessionOpenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnSessionOpenResponse) ProtoMessage() {}
+
+func (x *OnSessionOpenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnSessionOpenResponse.ProtoReflect.Descriptor instead.
+func (*OnSessionOpenResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OnSessionOpenResponse) GetResync() bool {
+	if x != nil {
+		return x.Resync
+	}
+	return false
+}
+
+type OnSessionCloseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       string                 `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnSessionCloseRequest) Reset() {
+	*x = OnSessionCloseRequest{}
+	mi := &file_extender_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnSessionCloseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnSessionCloseRequest) ProtoMessage() {}
+
+func (x *OnSessionCloseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnSessionCloseRequest.ProtoReflect.Descriptor instead.
+func (*OnSessionCloseRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OnSessionCloseRequest) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+type OnSessionCloseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnSessionCloseResponse) Reset() {
+	*x = OnSessionCloseResponse{}
+	mi := &file_extender_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnSessionCloseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnSessionCloseResponse) ProtoMessage() {}
+
+func (x *OnSessionCloseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnSessionCloseResponse.ProtoReflect.Descriptor instead.
+func (*OnSessionCloseResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{8}
+}
+
+// PredicateRequest checks the task against a batch of nodes.
+type PredicateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Nodes         []string               `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PredicateRequest) Reset() {
+	*x = PredicateRequest{}
+	mi := &file_extender_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PredicateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredicateRequest) ProtoMessage() {}
+
+func (x *PredicateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredicateRequest.ProtoReflect.Descriptor instead.
+func (*PredicateRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PredicateRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *PredicateRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// PredicateResult is the result of a node which does not fit, the code is one of the status codes
+// of the scheduler, e.g. 2 for Unschedulable.
+type PredicateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PredicateResult) Reset() {
+	*x = PredicateResult{}
+	mi := &file_extender_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PredicateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredicateResult) ProtoMessage() {}
+
+func (x *PredicateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredicateResult.ProtoReflect.Descriptor instead.
+func (*PredicateResult) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PredicateResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PredicateResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// PredicateResponse holds the results of the nodes which do not fit, the nodes left out fit.
+type PredicateResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	FailedNodes   map[string]*PredicateResult `protobuf:"bytes,1,rep,name=failed_nodes,json=failedNodes,proto3" json:"failed_nodes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PredicateResponse) Reset() {
+	*x = PredicateResponse{}
+	mi := &file_extender_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PredicateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredicateResponse) ProtoMessage() {}
+
+func (x *PredicateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredicateResponse.ProtoReflect.Descriptor instead.
+func (*PredicateResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PredicateResponse) GetFailedNodes() map[string]*PredicateResult {
+	if x != nil {
+		return x.FailedNodes
+	}
+	return nil
+}
+
+type PrioritizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Nodes         []string               `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrioritizeRequest) Reset() {
+	*x = PrioritizeRequest{}
+	mi := &file_extender_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrioritizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrioritizeRequest) ProtoMessage() {}
+
+func (x *PrioritizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrioritizeRequest.ProtoReflect.Descriptor instead.
+func (*PrioritizeRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PrioritizeRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *PrioritizeRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type PrioritizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeScores    map[string]float64     `protobuf:"bytes,1,rep,name=node_scores,json=nodeScores,proto3" json:"node_scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrioritizeResponse) Reset() {
+	*x = PrioritizeResponse{}
+	mi := &file_extender_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrioritizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrioritizeResponse) ProtoMessage() {}
+
+func (x *PrioritizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrioritizeResponse.ProtoReflect.Descriptor instead.
+func (*PrioritizeResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PrioritizeResponse) GetNodeScores() map[string]float64 {
+	if x != nil {
+		return x.NodeScores
+	}
+	return nil
+}
+
+type EvictableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Evictor       *Task                  `protobuf:"bytes,1,opt,name=evictor,proto3" json:"evictor,omitempty"`
+	Evictees      []*Task                `protobuf:"bytes,2,rep,name=evictees,proto3" json:"evictees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvictableRequest) Reset() {
+	*x = EvictableRequest{}
+	mi := &file_extender_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvictableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictableRequest) ProtoMessage() {}
+
+func (x *EvictableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictableRequest.ProtoReflect.Descriptor instead.
+func (*EvictableRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EvictableRequest) GetEvictor() *Task {
+	if x != nil {
+		return x.Evictor
+	}
+	return nil
+}
+
+func (x *EvictableRequest) GetEvictees() []*Task {
+	if x != nil {
+		return x.Evictees
+	}
+	return nil
+}
+
+// EvictableResponse holds the uids of the victims and the vote of the extender: 1 to permit,
+// -1 to reject, 0 to abstain.
+type EvictableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Victims       []string               `protobuf:"bytes,2,rep,name=victims,proto3" json:"victims,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvictableResponse) Reset() {
+	*x = EvictableResponse{}
+	mi := &file_extender_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvictableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictableResponse) ProtoMessage() {}
+
+func (x *EvictableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictableResponse.ProtoReflect.Descriptor instead.
+func (*EvictableResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EvictableResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *EvictableResponse) GetVictims() []string {
+	if x != nil {
+		return x.Victims
+	}
+	return nil
+}
+
+type QueueOverusedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *Queue                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueOverusedRequest) Reset() {
+	*x = QueueOverusedRequest{}
+	mi := &file_extender_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueOverusedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueOverusedRequest) ProtoMessage() {}
+
+func (x *QueueOverusedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueOverusedRequest.ProtoReflect.Descriptor instead.
+func (*QueueOverusedRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueueOverusedRequest) GetQueue() *Queue {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type QueueOverusedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overused      bool                   `protobuf:"varint,1,opt,name=overused,proto3" json:"overused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueOverusedResponse) Reset() {
+	*x = QueueOverusedResponse{}
+	mi := &file_extender_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueOverusedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueOverusedResponse) ProtoMessage() {}
+
+func (x *QueueOverusedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueOverusedResponse.ProtoReflect.Descriptor instead.
+func (*QueueOverusedResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueueOverusedResponse) GetOverused() bool {
+	if x != nil {
+		return x.Overused
+	}
+	return false
+}
+
+type JobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRequest) Reset() {
+	*x = JobRequest{}
+	mi := &file_extender_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRequest) ProtoMessage() {}
+
+func (x *JobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRequest.ProtoReflect.Descriptor instead.
+func (*JobRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *JobRequest) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// JobEnqueueableResponse holds the vote of the extender: 1 to permit, -1 to reject, 0 to abstain.
+type JobEnqueueableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobEnqueueableResponse) Reset() {
+	*x = JobEnqueueableResponse{}
+	mi := &file_extender_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobEnqueueableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEnqueueableResponse) ProtoMessage() {}
+
+func (x *JobEnqueueableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEnqueueableResponse.ProtoReflect.Descriptor instead.
+func (*JobEnqueueableResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *JobEnqueueableResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type JobEnqueuedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobEnqueuedResponse) Reset() {
+	*x = JobEnqueuedResponse{}
+	mi := &file_extender_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobEnqueuedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEnqueuedResponse) ProtoMessage() {}
+
+func (x *JobEnqueuedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEnqueuedResponse.ProtoReflect.Descriptor instead.
+func (*JobEnqueuedResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{20}
+}
+
+type JobReadyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ready         bool                   `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobReadyResponse) Reset() {
+	*x = JobReadyResponse{}
+	mi := &file_extender_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobReadyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobReadyResponse) ProtoMessage() {}
+
+func (x *JobReadyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobReadyResponse.ProtoReflect.Descriptor instead.
+func (*JobReadyResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *JobReadyResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type TaskEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEventRequest) Reset() {
+	*x = TaskEventRequest{}
+	mi := &file_extender_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEventRequest) ProtoMessage() {}
+
+func (x *TaskEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEventRequest.ProtoReflect.Descriptor instead.
+func (*TaskEventRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TaskEventRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// TaskEventResponse holds an error message if the event is refused, which rolls the operation back.
+type TaskEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEventResponse) Reset() {
+	*x = TaskEventResponse{}
+	mi := &file_extender_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEventResponse) ProtoMessage() {}
+
+func (x *TaskEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEventResponse.ProtoReflect.Descriptor instead.
+func (*TaskEventResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TaskEventResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// HyperNodeOrderRequest holds the tasks of the sub job, and the names of the nodes of each hyperNode.
+type HyperNodeOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           string                 `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	SubJob        string                 `protobuf:"bytes,2,opt,name=sub_job,json=subJob,proto3" json:"sub_job,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	HyperNodes    map[string]*NodeNames  `protobuf:"bytes,4,rep,name=hyper_nodes,json=hyperNodes,proto3" json:"hyper_nodes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HyperNodeOrderRequest) Reset() {
+	*x = HyperNodeOrderRequest{}
+	mi := &file_extender_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HyperNodeOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyperNodeOrderRequest) ProtoMessage() {}
+
+func (x *HyperNodeOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyperNodeOrderRequest.ProtoReflect.Descriptor instead.
+func (*HyperNodeOrderRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *HyperNodeOrderRequest) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *HyperNodeOrderRequest) GetSubJob() string {
+	if x != nil {
+		return x.SubJob
+	}
+	return ""
+}
+
+func (x *HyperNodeOrderRequest) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *HyperNodeOrderRequest) GetHyperNodes() map[string]*NodeNames {
+	if x != nil {
+		return x.HyperNodes
+	}
+	return nil
+}
+
+type NodeNames struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []string               `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeNames) Reset() {
+	*x = NodeNames{}
+	mi := &file_extender_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeNames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeNames) ProtoMessage() {}
+
+func (x *NodeNames) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeNames.ProtoReflect.Descriptor instead.
+func (*NodeNames) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NodeNames) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type HyperNodeOrderResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HyperNodeScores map[string]float64     `protobuf:"bytes,1,rep,name=hyper_node_scores,json=hyperNodeScores,proto3" json:"hyper_node_scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HyperNodeOrderResponse) Reset() {
+	*x = HyperNodeOrderResponse{}
+	mi := &file_extender_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HyperNodeOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyperNodeOrderResponse) ProtoMessage() {}
+
+func (x *HyperNodeOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyperNodeOrderResponse.ProtoReflect.Descriptor instead.
+func (*HyperNodeOrderResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HyperNodeOrderResponse) GetHyperNodeScores() map[string]float64 {
+	if x != nil {
+		return x.HyperNodeScores
+	}
+	return nil
+}
+
+type VictimTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VictimTasksRequest) Reset() {
+	*x = VictimTasksRequest{}
+	mi := &file_extender_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VictimTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VictimTasksRequest) ProtoMessage() {}
+
+func (x *VictimTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VictimTasksRequest.ProtoReflect.Descriptor instead.
+func (*VictimTasksRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VictimTasksRequest) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// VictimTasksResponse holds the uids of the victims.
+type VictimTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Victims       []string               `protobuf:"bytes,1,rep,name=victims,proto3" json:"victims,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VictimTasksResponse) Reset() {
+	*x = VictimTasksResponse{}
+	mi := &file_extender_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VictimTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VictimTasksResponse) ProtoMessage() {}
+
+func (x *VictimTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VictimTasksResponse.ProtoReflect.Descriptor instead.
+func (*VictimTasksResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *VictimTasksResponse) GetVictims() []string {
+	if x != nil {
+		return x.Victims
+	}
+	return nil
+}
+
+// PreBindRequest holds the task with the node it is allocated to.
+type PreBindRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreBindRequest) Reset() {
+	*x = PreBindRequest{}
+	mi := &file_extender_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreBindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreBindRequest) ProtoMessage() {}
+
+func (x *PreBindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreBindRequest.ProtoReflect.Descriptor instead.
+func (*PreBindRequest) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PreBindRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// PreBindResponse holds an error message if the allocation is vetoed, the task is then not bound.
+type PreBindResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorMessage  string                 `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreBindResponse) Reset() {
+	*x = PreBindResponse{}
+	mi := &file_extender_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreBindResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreBindResponse) ProtoMessage() {}
+
+func (x *PreBindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreBindResponse.ProtoReflect.Descriptor instead.
+func (*PreBindResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PreBindResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type PreBindRollBackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreBindRollBackResponse) Reset() {
+	*x = PreBindRollBackResponse{}
+	mi := &file_extender_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreBindRollBackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreBindRollBackResponse) ProtoMessage() {}
+
+func (x *PreBindRollBackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreBindRollBackResponse.ProtoReflect.Descriptor instead.
+func (*PreBindRollBackResponse) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{31}
+}
+
+var File_extender_proto protoreflect.FileDescriptor
+
+const file_extender_proto_rawDesc = "" +
+	"\n" +
+	"\x0eextender.proto\x12\x1dvolcano.scheduler.extender.v1\"\xa2\x01\n" +
+	"\bResource\x12W\n" +
+	"\n" +
+	"quantities\x18\x01 \x03(\v27.volcano.scheduler.extender.v1.Resource.QuantitiesEntryR\n" +
+	"quantities\x1a=\n" +
+	"\x0fQuantitiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x8a\x04\n" +
+	"\x04Task\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x10\n" +
+	"\x03job\x18\x04 \x01(\tR\x03job\x12\x1b\n" +
+	"\tnode_name\x18\x05 \x01(\tR\bnodeName\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\x12?\n" +
+	"\x06resreq\x18\b \x01(\v2'.volcano.scheduler.extender.v1.ResourceR\x06resreq\x12G\n" +
+	"\x06labels\x18\t \x03(\v2/.volcano.scheduler.extender.v1.Task.LabelsEntryR\x06labels\x12V\n" +
+	"\vannotations\x18\n" +
+	" \x03(\v24.volcano.scheduler.extender.v1.Task.AnnotationsEntryR\vannotations\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9d\x04\n" +
+	"\x04Node\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12G\n" +
+	"\x06labels\x18\x02 \x03(\v2/.volcano.scheduler.extender.v1.Node.LabelsEntryR\x06labels\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\bR\x05ready\x12I\n" +
+	"\vallocatable\x18\x04 \x01(\v2'.volcano.scheduler.extender.v1.ResourceR\vallocatable\x12;\n" +
+	"\x04idle\x18\x05 \x01(\v2'.volcano.scheduler.extender.v1.ResourceR\x04idle\x12;\n" +
+	"\x04used\x18\x06 \x01(\v2'.volcano.scheduler.extender.v1.ResourceR\x04used\x12E\n" +
+	"\treleasing\x18\a \x01(\v2'.volcano.scheduler.extender.v1.ResourceR\treleasing\x12E\n" +
+	"\tpipelined\x18\b \x01(\v2'.volcano.scheduler.extender.v1.ResourceR\tpipelined\x12\x14\n" +
+	"\x05tasks\x18\t \x03(\tR\x05tasks\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x03\n" +
+	"\x03Job\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05queue\x18\x04 \x01(\tR\x05queue\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12#\n" +
+	"\rmin_available\x18\x06 \x01(\x05R\fminAvailable\x12\x14\n" +
+	"\x05phase\x18\a \x01(\tR\x05phase\x12E\n" +
+	"\tallocated\x18\b \x01(\v2'.volcano.scheduler.extender.v1.ResourceR\tallocated\x12L\n" +
+	"\rtotal_request\x18\t \x01(\v2'.volcano.scheduler.extender.v1.ResourceR\ftotalRequest\x129\n" +
+	"\x05tasks\x18\n" +
+	" \x03(\v2#.volcano.scheduler.extender.v1.TaskR\x05tasks\"\xc6\x01\n" +
+	"\x05Queue\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\x12G\n" +
+	"\n" +
+	"capability\x18\x04 \x01(\v2'.volcano.scheduler.extender.v1.ResourceR\n" +
+	"capability\x12\x1c\n" +
+	"\thierarchy\x18\x05 \x01(\tR\thierarchy\x12\x18\n" +
+	"\aweights\x18\x06 \x01(\tR\aweights\"\x99\x03\n" +
+	"\x14OnSessionOpenRequest\x12\x18\n" +
+	"\asession\x18\x01 \x01(\tR\asession\x12'\n" +
+	"\x0fbase_generation\x18\x02 \x01(\x04R\x0ebaseGeneration\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x03 \x01(\x04R\n" +
+	"generation\x126\n" +
+	"\x04jobs\x18\x04 \x03(\v2\".volcano.scheduler.extender.v1.JobR\x04jobs\x12!\n" +
+	"\fremoved_jobs\x18\x05 \x03(\tR\vremovedJobs\x129\n" +
+	"\x05nodes\x18\x06 \x03(\v2#.volcano.scheduler.extender.v1.NodeR\x05nodes\x12#\n" +
+	"\rremoved_nodes\x18\a \x03(\tR\fremovedNodes\x12<\n" +
+	"\x06queues\x18\b \x03(\v2$.volcano.scheduler.extender.v1.QueueR\x06queues\x12%\n" +
+	"\x0eremoved_queues\x18\t \x03(\tR\rremovedQueues\"/\n" +
+	"\x15OnSessionOpenResponse\x12\x16\n" +
+	"\x06resync\x18\x01 \x01(\bR\x06resync\"1\n" +
+	"\x15OnSessionCloseRequest\x12\x18\n" +
+	"\asession\x18\x01 \x01(\tR\asession\"\x18\n" +
+	"\x16OnSessionCloseResponse\"a\n" +
+	"\x10PredicateRequest\x127\n" +
+	"\x04task\x18\x01 \x01(\v2#.volcano.scheduler.extender.v1.TaskR\x04task\x12\x14\n" +
+	"\x05nodes\x18\x02 \x03(\tR\x05nodes\"=\n" +
+	"\x0fPredicateResult\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xe9\x01\n" +
+	"\x11PredicateResponse\x12d\n" +
+	"\ffailed_nodes\x18\x01 \x03(\v2A.volcano.scheduler.extender.v1.PredicateResponse.FailedNodesEntryR\vfailedNodes\x1an\n" +
+	"\x10FailedNodesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12D\n" +
+	"\x05value\x18\x02 \x01(\v2..volcano.scheduler.extender.v1.PredicateResultR\x05value:\x028\x01\"b\n" +
+	"\x11PrioritizeRequest\x127\n" +
+	"\x04task\x18\x01 \x01(\v2#.volcano.scheduler.extender.v1.TaskR\x04task\x12\x14\n" +
+	"\x05nodes\x18\x02 \x03(\tR\x05nodes\"\xb7\x01\n" +
+	"\x12PrioritizeResponse\x12b\n" +
+	"\vnode_scores\x18\x01 \x03(\v2A.volcano.scheduler.extender.v1.PrioritizeResponse.NodeScoresEntryR\n" +
+	"nodeScores\x1a=\n" +
+	"\x0fNodeScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x92\x01\n" +
+	"\x10EvictableRequest\x12=\n" +
+	"\aevictor\x18\x01 \x01(\v2#.volcano.scheduler.extender.v1.TaskR\aevictor\x12?\n" +
+	"\bevictees\x18\x02 \x03(\v2#.volcano.scheduler.extender.v1.TaskR\bevictees\"E\n" +
+	"\x11EvictableResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\avictims\x18\x02 \x03(\tR\avictims\"R\n" +
+	"\x14QueueOverusedRequest\x12:\n" +
+	"\x05queue\x18\x01 \x01(\v2$.volcano.scheduler.extender.v1.QueueR\x05queue\"3\n" +
+	"\x15QueueOverusedResponse\x12\x1a\n" +
+	"\boverused\x18\x01 \x01(\bR\boverused\"B\n" +
+	"\n" +
+	"JobRequest\x124\n" +
+	"\x03job\x18\x01 \x01(\v2\".volcano.scheduler.extender.v1.JobR\x03job\"0\n" +
+	"\x16JobEnqueueableResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\"\x15\n" +
+	"\x13JobEnqueuedResponse\"(\n" +
+	"\x10JobReadyResponse\x12\x14\n" +
+	"\x05ready\x18\x01 \x01(\bR\x05ready\"K\n" +
+	"\x10TaskEventRequest\x127\n" +
+	"\x04task\x18\x01 \x01(\v2#.volcano.scheduler.extender.v1.TaskR\x04task\"8\n" +
+	"\x11TaskEventResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\xcd\x02\n" +
+	"\x15HyperNodeOrderRequest\x12\x10\n" +
+	"\x03job\x18\x01 \x01(\tR\x03job\x12\x17\n" +
+	"\asub_job\x18\x02 \x01(\tR\x06subJob\x129\n" +
+	"\x05tasks\x18\x03 \x03(\v2#.volcano.scheduler.extender.v1.TaskR\x05tasks\x12e\n" +
+	"\vhyper_nodes\x18\x04 \x03(\v2D.volcano.scheduler.extender.v1.HyperNodeOrderRequest.HyperNodesEntryR\n" +
+	"hyperNodes\x1ag\n" +
+	"\x0fHyperNodesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.volcano.scheduler.extender.v1.NodeNamesR\x05value:\x028\x01\"!\n" +
+	"\tNodeNames\x12\x14\n" +
+	"\x05nodes\x18\x01 \x03(\tR\x05nodes\"\xd4\x01\n" +
+	"\x16HyperNodeOrderResponse\x12v\n" +
+	"\x11hyper_node_scores\x18\x01 \x03(\v2J.volcano.scheduler.extender.v1.HyperNodeOrderResponse.HyperNodeScoresEntryR\x0fhyperNodeScores\x1aB\n" +
+	"\x14HyperNodeScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"O\n" +
+	"\x12VictimTasksRequest\x129\n" +
+	"\x05tasks\x18\x01 \x03(\v2#.volcano.scheduler.extender.v1.TaskR\x05tasks\"/\n" +
+	"\x13VictimTasksResponse\x12\x18\n" +
+	"\avictims\x18\x01 \x03(\tR\avictims\"I\n" +
+	"\x0ePreBindRequest\x127\n" +
+	"\x04task\x18\x01 \x01(\v2#.volcano.scheduler.extender.v1.TaskR\x04task\"6\n" +
+	"\x0fPreBindResponse\x12#\n" +
+	"\rerror_message\x18\x01 \x01(\tR\ferrorMessage\"\x19\n" +
+	"\x17PreBindRollBackResponse2\xcb\x0e\n" +
+	"\bExtender\x12z\n" +
+	"\rOnSessionOpen\x123.volcano.scheduler.extender.v1.OnSessionOpenRequest\x1a4.volcano.scheduler.extender.v1.OnSessionOpenResponse\x12}\n" +
+	"\x0eOnSessionClose\x124.volcano.scheduler.extender.v1.OnSessionCloseRequest\x1a5.volcano.scheduler.extender.v1.OnSessionCloseResponse\x12n\n" +
+	"\tPredicate\x12/.volcano.scheduler.extender.v1.PredicateRequest\x1a0.volcano.scheduler.extender.v1.PredicateResponse\x12q\n" +
+	"\n" +
+	"Prioritize\x120.volcano.scheduler.extender.v1.PrioritizeRequest\x1a1.volcano.scheduler.extender.v1.PrioritizeResponse\x12p\n" +
+	"\vPreemptable\x12/.volcano.scheduler.extender.v1.EvictableRequest\x1a0.volcano.scheduler.extender.v1.EvictableResponse\x12p\n" +
+	"\vReclaimable\x12/.volcano.scheduler.extender.v1.EvictableRequest\x1a0.volcano.scheduler.extender.v1.EvictableResponse\x12z\n" +
+	"\rQueueOverused\x123.volcano.scheduler.extender.v1.QueueOverusedRequest\x1a4.volcano.scheduler.extender.v1.QueueOverusedResponse\x12r\n" +
+	"\x0eJobEnqueueable\x12).volcano.scheduler.extender.v1.JobRequest\x1a5.volcano.scheduler.extender.v1.JobEnqueueableResponse\x12l\n" +
+	"\vJobEnqueued\x12).volcano.scheduler.extender.v1.JobRequest\x1a2.volcano.scheduler.extender.v1.JobEnqueuedResponse\x12f\n" +
+	"\bJobReady\x12).volcano.scheduler.extender.v1.JobRequest\x1a/.volcano.scheduler.extender.v1.JobReadyResponse\x12m\n" +
+	"\bAllocate\x12/.volcano.scheduler.extender.v1.TaskEventRequest\x1a0.volcano.scheduler.extender.v1.TaskEventResponse\x12o\n" +
+	"\n" +
+	"Deallocate\x12/.volcano.scheduler.extender.v1.TaskEventRequest\x1a0.volcano.scheduler.extender.v1.TaskEventResponse\x12}\n" +
+	"\x0eHyperNodeOrder\x124.volcano.scheduler.extender.v1.HyperNodeOrderRequest\x1a5.volcano.scheduler.extender.v1.HyperNodeOrderResponse\x12t\n" +
+	"\vVictimTasks\x121.volcano.scheduler.extender.v1.VictimTasksRequest\x1a2.volcano.scheduler.extender.v1.VictimTasksResponse\x12h\n" +
+	"\aPreBind\x12-.volcano.scheduler.extender.v1.PreBindRequest\x1a..volcano.scheduler.extender.v1.PreBindResponse\x12x\n" +
+	"\x0fPreBindRollBack\x12-.volcano.scheduler.extender.v1.PreBindRequest\x1a6.volcano.scheduler.extender.v1.PreBindRollBackResponseB?Z=volcano.sh/volcano/pkg/scheduler/plugins/extender/proto/v1;v1b\x06proto3"
+
+var (
+	file_extender_proto_rawDescOnce sync.Once
+	file_extender_proto_rawDescData []byte
+)
+
+func file_extender_proto_rawDescGZIP() []byte {
+	file_extender_proto_rawDescOnce.Do(func() {
+		file_extender_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_extender_proto_rawDesc), len(file_extender_proto_rawDesc)))
+	})
+	return file_extender_proto_rawDescData
+}
+
+var file_extender_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_extender_proto_goTypes = []any{
+	(*Resource)(nil),                // 0: volcano.scheduler.extender.v1.Resource
+	(*Task)(nil),                    // 1: volcano.scheduler.extender.v1.Task
+	(*Node)(nil),                    // 2: volcano.scheduler.extender.v1.Node
+	(*Job)(nil),                     // 3: volcano.scheduler.extender.v1.Job
+	(*Queue)(nil),                   // 4: volcano.scheduler.extender.v1.Queue
+	(*OnSessionOpenRequest)(nil),    // 5: volcano.scheduler.extender.v1.OnSessionOpenRequest
+	(*OnSessionOpenResponse)(nil),   // 6: volcano.scheduler.extender.v1.OnSessionOpenResponse
+	(*OnSessionCloseRequest)(nil),   // 7: volcano.scheduler.extender.v1.OnSessionCloseRequest
+	(*OnSessionCloseResponse)(nil),  // 8: volcano.scheduler.extender.v1.OnSessionCloseResponse
+	(*PredicateRequest)(nil),        // 9: volcano.scheduler.extender.v1.PredicateRequest
+	(*PredicateResult)(nil),         // 10: volcano.scheduler.extender.v1.PredicateResult
+	(*PredicateResponse)(nil),       // 11: volcano.scheduler.extender.v1.PredicateResponse
+	(*PrioritizeRequest)(nil),       // 12: volcano.scheduler.extender.v1.PrioritizeRequest
+	(*PrioritizeResponse)(nil),      // 13: volcano.scheduler.extender.v1.PrioritizeResponse
+	(*EvictableRequest)(nil),        // 14: volcano.scheduler.extender.v1.EvictableRequest
+	(*EvictableResponse)(nil),       // 15: volcano.scheduler.extender.v1.EvictableResponse
+	(*QueueOverusedRequest)(nil),    // 16: volcano.scheduler.extender.v1.QueueOverusedRequest
+	(*QueueOverusedResponse)(nil),   // 17: volcano.scheduler.extender.v1.QueueOverusedResponse
+	(*JobRequest)(nil),              // 18: volcano.scheduler.extender.v1.JobRequest
+	(*JobEnqueueableResponse)(nil),  // 19: volcano.scheduler.extender.v1.JobEnqueueableResponse
+	(*JobEnqueuedResponse)(nil),     // 20: volcano.scheduler.extender.v1.JobEnqueuedResponse
+	(*JobReadyResponse)(nil),        // 21: volcano.scheduler.extender.v1.JobReadyResponse
+	(*TaskEventRequest)(nil),        // 22: volcano.scheduler.extender.v1.TaskEventRequest
+	(*TaskEventResponse)(nil),       // 23: volcano.scheduler.extender.v1.TaskEventResponse
+	(*HyperNodeOrderRequest)(nil),   // 24: volcano.scheduler.extender.v1.HyperNodeOrderRequest
+	(*NodeNames)(nil),               // 25: volcano.scheduler.extender.v1.NodeNames
+	(*HyperNodeOrderResponse)(nil),  // 26: volcano.scheduler.extender.v1.HyperNodeOrderResponse
+	(*VictimTasksRequest)(nil),      // 27: volcano.scheduler.extender.v1.VictimTasksRequest
+	(*VictimTasksResponse)(nil),     // 28: volcano.scheduler.extender.v1.VictimTasksResponse
+	(*PreBindRequest)(nil),          // 29: volcano.scheduler.extender.v1.PreBindRequest
+	(*PreBindResponse)(nil),         // 30: volcano.scheduler.extender.v1.PreBindResponse
+	(*PreBindRollBackResponse)(nil), // 31: volcano.scheduler.extender.v1.PreBindRollBackResponse
+	nil,                             // 32: volcano.scheduler.extender.v1.Resource.QuantitiesEntry
+	nil,                             // 33: volcano.scheduler.extender.v1.Task.LabelsEntry
+	nil,                             // 34: volcano.scheduler.extender.v1.Task.AnnotationsEntry
+	nil,                             // 35: volcano.scheduler.extender.v1.Node.LabelsEntry
+	nil,                             // 36: volcano.scheduler.extender.v1.PredicateResponse.FailedNodesEntry
+	nil,                             // 37: volcano.scheduler.extender.v1.PrioritizeResponse.NodeScoresEntry
+	nil,                             // 38: volcano.scheduler.extender.v1.HyperNodeOrderRequest.HyperNodesEntry
+	nil,                             // 39: volcano.scheduler.extender.v1.HyperNodeOrderResponse.HyperNodeScoresEntry
+}
+var file_extender_proto_depIdxs = []int32{
+	32, // 0: volcano.scheduler.extender.v1.Resource.quantities:type_name -> volcano.scheduler.extender.v1.Resource.QuantitiesEntry
+	0,  // 1: volcano.scheduler.extender.v1.Task.resreq:type_name -> volcano.scheduler.extender.v1.Resource
+	33, // 2: volcano.scheduler.extender.v1.Task.labels:type_name -> volcano.scheduler.extender.v1.Task.LabelsEntry
+	34, // 3: volcano.scheduler.extender.v1.Task.annotations:type_name -> volcano.scheduler.extender.v1.Task.AnnotationsEntry
+	35, // 4: volcano.scheduler.extender.v1.Node.labels:type_name -> volcano.scheduler.extender.v1.Node.LabelsEntry
+	0,  // 5: volcano.scheduler.extender.v1.Node.allocatable:type_name -> volcano.scheduler.extender.v1.Resource
+	0,  // 6: volcano.scheduler.extender.v1.Node.idle:type_name -> volcano.scheduler.extender.v1.Resource
+	0,  // 7: volcano.scheduler.extender.v1.Node.used:type_name -> volcano.scheduler.extender.v1.Resource
+	0,  // 8: volcano.scheduler.extender.v1.Node.releasing:type_name -> volcano.scheduler.extender.v1.Resource
+	0,  // 9: volcano.scheduler.extender.v1.Node.pipelined:type_name -> volcano.scheduler.extender.v1.Resource
+	0,  // 10: volcano.scheduler.extender.v1.Job.allocated:type_name -> volcano.scheduler.extender.v1.Resource
+	0,  // 11: volcano.scheduler.extender.v1.Job.total_request:type_name -> volcano.scheduler.extender.v1.Resource
+	1,  // 12: volcano.scheduler.extender.v1.Job.tasks:type_name -> volcano.scheduler.extender.v1.Task
+	0,  // 13: volcano.scheduler.extender.v1.Queue.capability:type_name -> volcano.scheduler.extender.v1.Resource
+	3,  // 14: volcano.scheduler.extender.v1.OnSessionOpenRequest.jobs:type_name -> volcano.scheduler.extender.v1.Job
+	2,  // 15: volcano.scheduler.extender.v1.OnSessionOpenRequest.nodes:type_name -> volcano.scheduler.extender.v1.Node
+	4,  // 16: volcano.scheduler.extender.v1.OnSessionOpenRequest.queues:type_name -> volcano.scheduler.extender.v1.Queue
+	1,  // 17: volcano.scheduler.extender.v1.PredicateRequest.task:type_name -> volcano.scheduler.extender.v1.Task
+	36, // 18: volcano.scheduler.extender.v1.PredicateResponse.failed_nodes:type_name -> volcano.scheduler.extender.v1.PredicateResponse.FailedNodesEntry
+	1,  // 19: volcano.scheduler.extender.v1.PrioritizeRequest.task:type_name -> volcano.scheduler.extender.v1.Task
+	37, // 20: volcano.scheduler.extender.v1.PrioritizeResponse.node_scores:type_name -> volcano.scheduler.extender.v1.PrioritizeResponse.NodeScoresEntry
+	1,  // 21: volcano.scheduler.extender.v1.EvictableRequest.evictor:type_name -> volcano.scheduler.extender.v1.Task
+	1,  // 22: volcano.scheduler.extender.v1.EvictableRequest.evictees:type_name -> volcano.scheduler.extender.v1.Task
+	4,  // 23: volcano.scheduler.extender.v1.QueueOverusedRequest.queue:type_name -> volcano.scheduler.extender.v1.Queue
+	3,  // 24: volcano.scheduler.extender.v1.JobRequest.job:type_name -> volcano.scheduler.extender.v1.Job
+	1,  // 25: volcano.scheduler.extender.v1.TaskEventRequest.task:type_name -> volcano.scheduler.extender.v1.Task
+	1,  // 26: volcano.scheduler.extender.v1.HyperNodeOrderRequest.tasks:type_name -> volcano.scheduler.extender.v1.Task
+	38, // 27: volcano.scheduler.extender.v1.HyperNodeOrderRequest.hyper_nodes:type_name -> volcano.scheduler.extender.v1.HyperNodeOrderRequest.HyperNodesEntry
+	39, // 28: volcano.scheduler.extender.v1.HyperNodeOrderResponse.hyper_node_scores:type_name -> volcano.scheduler.extender.v1.HyperNodeOrderResponse.HyperNodeScoresEntry
+	1,  // 29: volcano.scheduler.extender.v1.VictimTasksRequest.tasks:type_name -> volcano.scheduler.extender.v1.Task
+	1,  // 30: volcano.scheduler.extender.v1.PreBindRequest.task:type_name -> volcano.scheduler.extender.v1.Task
+	10, // 31: volcano.scheduler.extender.v1.PredicateResponse.FailedNodesEntry.value:type_name -> volcano.scheduler.extender.v1.PredicateResult
+	25, // 32: volcano.scheduler.extender.v1.HyperNodeOrderRequest.HyperNodesEntry.value:type_name -> volcano.scheduler.extender.v1.NodeNames
+	5,  // 33: volcano.scheduler.extender.v1.Extender.OnSessionOpen:input_type -> volcano.scheduler.extender.v1.OnSessionOpenRequest
+	7,  // 34: volcano.scheduler.extender.v1.Extender.OnSessionClose:input_type -> volcano.scheduler.extender.v1.OnSessionCloseRequest
+	9,  // 35: volcano.scheduler.extender.v1.Extender.Predicate:input_type -> volcano.scheduler.extender.v1.PredicateRequest
+	12, // 36: volcano.scheduler.extender.v1.Extender.Prioritize:input_type -> volcano.scheduler.extender.v1.PrioritizeRequest
+	14, // 37: volcano.scheduler.extender.v1.Extender.Preemptable:input_type -> volcano.scheduler.extender.v1.EvictableRequest
+	14, // 38: volcano.scheduler.extender.v1.Extender.Reclaimable:input_type -> volcano.scheduler.extender.v1.EvictableRequest
+	16, // 39: volcano.scheduler.extender.v1.Extender.QueueOverused:input_type -> volcano.scheduler.extender.v1.QueueOverusedRequest
+	18, // 40: volcano.scheduler.extender.v1.Extender.JobEnqueueable:input_type -> volcano.scheduler.extender.v1.JobRequest
+	18, // 41: volcano.scheduler.extender.v1.Extender.JobEnqueued:input_type -> volcano.scheduler.extender.v1.JobRequest
+	18, // 42: volcano.scheduler.extender.v1.Extender.JobReady:input_type -> volcano.scheduler.extender.v1.JobRequest
+	22, // 43: volcano.scheduler.extender.v1.Extender.Allocate:input_type -> volcano.scheduler.extender.v1.TaskEventRequest
+	22, // 44: volcano.scheduler.extender.v1.Extender.Deallocate:input_type -> volcano.scheduler.extender.v1.TaskEventRequest
+	24, // 45: volcano.scheduler.extender.v1.Extender.HyperNodeOrder:input_type -> volcano.scheduler.extender.v1.HyperNodeOrderRequest
+	27, // 46: volcano.scheduler.extender.v1.Extender.VictimTasks:input_type -> volcano.scheduler.extender.v1.VictimTasksRequest
+	29, // 47: volcano.scheduler.extender.v1.Extender.PreBind:input_type -> volcano.scheduler.extender.v1.PreBindRequest
+	29, // 48: volcano.scheduler.extender.v1.Extender.PreBindRollBack:input_type -> volcano.scheduler.extender.v1.PreBindRequest
+	6,  // 49: volcano.scheduler.extender.v1.Extender.OnSessionOpen:output_type -> volcano.scheduler.extender.v1.OnSessionOpenResponse
+	8,  // 50: volcano.scheduler.extender.v1.Extender.OnSessionClose:output_type -> volcano.scheduler.extender.v1.OnSessionCloseResponse
+	11, // 51: volcano.scheduler.extender.v1.Extender.Predicate:output_type -> volcano.scheduler.extender.v1.PredicateResponse
+	13, // 52: volcano.scheduler.extender.v1.Extender.Prioritize:output_type -> volcano.scheduler.extender.v1.PrioritizeResponse
+	15, // 53: volcano.scheduler.extender.v1.Extender.Preemptable:output_type -> volcano.scheduler.extender.v1.EvictableResponse
+	15, // 54: volcano.scheduler.extender.v1.Extender.Reclaimable:output_type -> volcano.scheduler.extender.v1.EvictableResponse
+	17, // 55: volcano.scheduler.extender.v1.Extender.QueueOverused:output_type -> volcano.scheduler.extender.v1.QueueOverusedResponse
+	19, // 56: volcano.scheduler.extender.v1.Extender.JobEnqueueable:output_type -> volcano.scheduler.extender.v1.JobEnqueueableResponse
+	20, // 57: volcano.scheduler.extender.v1.Extender.JobEnqueued:output_type -> volcano.scheduler.extender.v1.JobEnqueuedResponse
+	21, // 58: volcano.scheduler.extender.v1.Extender.JobReady:output_type -> volcano.scheduler.extender.v1.JobReadyResponse
+	23, // 59: volcano.scheduler.extender.v1.Extender.Allocate:output_type -> volcano.scheduler.extender.v1.TaskEventResponse
+	23, // 60: volcano.scheduler.extender.v1.Extender.Deallocate:output_type -> volcano.scheduler.extender.v1.TaskEventResponse
+	26, // 61: volcano.scheduler.extender.v1.Extender.HyperNodeOrder:output_type -> volcano.scheduler.extender.v1.HyperNodeOrderResponse
+	28, // 62: volcano.scheduler.extender.v1.Extender.VictimTasks:output_type -> volcano.scheduler.extender.v1.VictimTasksResponse
+	30, // 63: volcano.scheduler.extender.v1.Extender.PreBind:output_type -> volcano.scheduler.extender.v1.PreBindResponse
+	31, // 64: volcano.scheduler.extender.v1.Extender.PreBindRollBack:output_type -> volcano.scheduler.extender.v1.PreBindRollBackResponse
+	49, // [49:65] is the sub-list for method output_type
+	33, // [33:49] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_extender_proto_init() }
+func file_extender_proto_init() {
+	if File_extender_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_extender_proto_rawDesc), len(file_extender_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   40,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_extender_proto_goTypes,
+		DependencyIndexes: file_extender_proto_depIdxs,
+		MessageInfos:      file_extender_proto_msgTypes,
+	}.Build()
+	File_extender_proto = out.File
+	file_extender_proto_goTypes = nil
+	file_extender_proto_depIdxs = nil
+}
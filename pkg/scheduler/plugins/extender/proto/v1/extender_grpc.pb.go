@@ -0,0 +1,745 @@
+// Copyright 2026 The Volcano Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v5.29.3
+// source: extender.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Extender_OnSessionOpen_FullMethodName   = "/volcano.scheduler.extender.v1.Extender/OnSessionOpen"
+	Extender_OnSessionClose_FullMethodName  = "/volcano.scheduler.extender.v1.Extender/OnSessionClose"
+	Extender_Predicate_FullMethodName       = "/volcano.scheduler.extender.v1.Extender/Predicate"
+	Extender_Prioritize_FullMethodName      = "/volcano.scheduler.extender.v1.Extender/Prioritize"
+	Extender_Preemptable_FullMethodName     = "/volcano.scheduler.extender.v1.Extender/Preemptable"
+	Extender_Reclaimable_FullMethodName     = "/volcano.scheduler.extender.v1.Extender/Reclaimable"
+	Extender_QueueOverused_FullMethodName   = "/volcano.scheduler.extender.v1.Extender/QueueOverused"
+	Extender_JobEnqueueable_FullMethodName  = "/volcano.scheduler.extender.v1.Extender/JobEnqueueable"
+	Extender_JobEnqueued_FullMethodName     = "/volcano.scheduler.extender.v1.Extender/JobEnqueued"
+	Extender_JobReady_FullMethodName        = "/volcano.scheduler.extender.v1.Extender/JobReady"
+	Extender_Allocate_FullMethodName        = "/volcano.scheduler.extender.v1.Extender/Allocate"
+	Extender_Deallocate_FullMethodName      = "/volcano.scheduler.extender.v1.Extender/Deallocate"
+	Extender_HyperNodeOrder_FullMethodName  = "/volcano.scheduler.extender.v1.Extender/HyperNodeOrder"
+	Extender_VictimTasks_FullMethodName     = "/volcano.scheduler.extender.v1.Extender/VictimTasks"
+	Extender_PreBind_FullMethodName         = "/volcano.scheduler.extender.v1.Extender/PreBind"
+	Extender_PreBindRollBack_FullMethodName = "/volcano.scheduler.extender.v1.Extender/PreBindRollBack"
+)
+
+// ExtenderClient is the client API for Extender service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Extender is the gRPC service of a scheduler extender. The scheduler keeps the extender up to date
+// with the cluster state by sending the changes at every session open, so that the other calls only
+// carry the objects they are about and refer to the rest by name.
+type ExtenderClient interface {
+	// OnSessionOpen sends the changes of the cluster state since the previous session.
+	OnSessionOpen(ctx context.Context, in *OnSessionOpenRequest, opts ...grpc.CallOption) (*OnSessionOpenResponse, error)
+	// OnSessionClose notifies the end of the session.
+	OnSessionClose(ctx context.Context, in *OnSessionCloseRequest, opts ...grpc.CallOption) (*OnSessionCloseResponse, error)
+	// Predicate checks whether the task fits each of the nodes.
+	Predicate(ctx context.Context, in *PredicateRequest, opts ...grpc.CallOption) (*PredicateResponse, error)
+	// Prioritize scores the nodes for the task.
+	Prioritize(ctx context.Context, in *PrioritizeRequest, opts ...grpc.CallOption) (*PrioritizeResponse, error)
+	// Preemptable selects the victims the evictor may preempt.
+	Preemptable(ctx context.Context, in *EvictableRequest, opts ...grpc.CallOption) (*EvictableResponse, error)
+	// Reclaimable selects the victims the evictor may reclaim.
+	Reclaimable(ctx context.Context, in *EvictableRequest, opts ...grpc.CallOption) (*EvictableResponse, error)
+	// QueueOverused checks whether the queue uses more than it deserves.
+	QueueOverused(ctx context.Context, in *QueueOverusedRequest, opts ...grpc.CallOption) (*QueueOverusedResponse, error)
+	// JobEnqueueable votes whether the job may be enqueued.
+	JobEnqueueable(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobEnqueueableResponse, error)
+	// JobEnqueued notifies that the job was enqueued.
+	JobEnqueued(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobEnqueuedResponse, error)
+	// JobReady checks whether the job is ready to run.
+	JobReady(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobReadyResponse, error)
+	// Allocate notifies that the task was allocated in the session.
+	Allocate(ctx context.Context, in *TaskEventRequest, opts ...grpc.CallOption) (*TaskEventResponse, error)
+	// Deallocate notifies that the allocation of the task was rolled back in the session.
+	Deallocate(ctx context.Context, in *TaskEventRequest, opts ...grpc.CallOption) (*TaskEventResponse, error)
+	// HyperNodeOrder scores the hyperNodes for the sub job.
+	HyperNodeOrder(ctx context.Context, in *HyperNodeOrderRequest, opts ...grpc.CallOption) (*HyperNodeOrderResponse, error)
+	// VictimTasks selects the tasks to evict among the running tasks.
+	VictimTasks(ctx context.Context, in *VictimTasksRequest, opts ...grpc.CallOption) (*VictimTasksResponse, error)
+	// PreBind checks the final allocation of the task before it is bound to its node.
+	PreBind(ctx context.Context, in *PreBindRequest, opts ...grpc.CallOption) (*PreBindResponse, error)
+	// PreBindRollBack notifies that the binding of the task failed after the pre-bind.
+	PreBindRollBack(ctx context.Context, in *PreBindRequest, opts ...grpc.CallOption) (*PreBindRollBackResponse, error)
+}
+
+type extenderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExtenderClient(cc grpc.ClientConnInterface) ExtenderClient {
+	return &extenderClient{cc}
+}
+
+func (c *extenderClient) OnSessionOpen(ctx context.Context, in *OnSessionOpenRequest, opts ...grpc.CallOption) (*OnSessionOpenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnSessionOpenResponse)
+	err := c.cc.Invoke(ctx, Extender_OnSessionOpen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) OnSessionClose(ctx context.Context, in *OnSessionCloseRequest, opts ...grpc.CallOption) (*OnSessionCloseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnSessionCloseResponse)
+	err := c.cc.Invoke(ctx, Extender_OnSessionClose_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) Predicate(ctx context.Context, in *PredicateRequest, opts ...grpc.CallOption) (*PredicateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PredicateResponse)
+	err := c.cc.Invoke(ctx, Extender_Predicate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) Prioritize(ctx context.Context, in *PrioritizeRequest, opts ...grpc.CallOption) (*PrioritizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrioritizeResponse)
+	err := c.cc.Invoke(ctx, Extender_Prioritize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) Preemptable(ctx context.Context, in *EvictableRequest, opts ...grpc.CallOption) (*EvictableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvictableResponse)
+	err := c.cc.Invoke(ctx, Extender_Preemptable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) Reclaimable(ctx context.Context, in *EvictableRequest, opts ...grpc.CallOption) (*EvictableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvictableResponse)
+	err := c.cc.Invoke(ctx, Extender_Reclaimable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) QueueOverused(ctx context.Context, in *QueueOverusedRequest, opts ...grpc.CallOption) (*QueueOverusedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueOverusedResponse)
+	err := c.cc.Invoke(ctx, Extender_QueueOverused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) JobEnqueueable(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobEnqueueableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobEnqueueableResponse)
+	err := c.cc.Invoke(ctx, Extender_JobEnqueueable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) JobEnqueued(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobEnqueuedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobEnqueuedResponse)
+	err := c.cc.Invoke(ctx, Extender_JobEnqueued_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) JobReady(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobReadyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobReadyResponse)
+	err := c.cc.Invoke(ctx, Extender_JobReady_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) Allocate(ctx context.Context, in *TaskEventRequest, opts ...grpc.CallOption) (*TaskEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskEventResponse)
+	err := c.cc.Invoke(ctx, Extender_Allocate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) Deallocate(ctx context.Context, in *TaskEventRequest, opts ...grpc.CallOption) (*TaskEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskEventResponse)
+	err := c.cc.Invoke(ctx, Extender_Deallocate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) HyperNodeOrder(ctx context.Context, in *HyperNodeOrderRequest, opts ...grpc.CallOption) (*HyperNodeOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HyperNodeOrderResponse)
+	err := c.cc.Invoke(ctx, Extender_HyperNodeOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) VictimTasks(ctx context.Context, in *VictimTasksRequest, opts ...grpc.CallOption) (*VictimTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VictimTasksResponse)
+	err := c.cc.Invoke(ctx, Extender_VictimTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) PreBind(ctx context.Context, in *PreBindRequest, opts ...grpc.CallOption) (*PreBindResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreBindResponse)
+	err := c.cc.Invoke(ctx, Extender_PreBind_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extenderClient) PreBindRollBack(ctx context.Context, in *PreBindRequest, opts ...grpc.CallOption) (*PreBindRollBackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreBindRollBackResponse)
+	err := c.cc.Invoke(ctx, Extender_PreBindRollBack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExtenderServer is the server API for Extender service.
+// All implementations must embed UnimplementedExtenderServer
+// for forward compatibility.
+//
+// Extender is the gRPC service of a scheduler extender. The scheduler keeps the extender up to date
+// with the cluster state by sending the changes at every session open, so that the other calls only
+// carry the objects they are about and refer to the rest by name.
+type ExtenderServer interface {
+	// OnSessionOpen sends the changes of the cluster state since the previous session.
+	OnSessionOpen(context.Context, *OnSessionOpenRequest) (*OnSessionOpenResponse, error)
+	// OnSessionClose notifies the end of the session.
+	OnSessionClose(context.Context, *OnSessionCloseRequest) (*OnSessionCloseResponse, error)
+	// Predicate checks whether the task fits each of the nodes.
+	Predicate(context.Context, *PredicateRequest) (*PredicateResponse, error)
+	// Prioritize scores the nodes for the task.
+	Prioritize(context.Context, *PrioritizeRequest) (*PrioritizeResponse, error)
+	// Preemptable selects the victims the evictor may preempt.
+	Preemptable(context.Context, *EvictableRequest) (*EvictableResponse, error)
+	// Reclaimable selects the victims the evictor may reclaim.
+	Reclaimable(context.Context, *EvictableRequest) (*EvictableResponse, error)
+	// QueueOverused checks whether the queue uses more than it deserves.
+	QueueOverused(context.Context, *QueueOverusedRequest) (*QueueOverusedResponse, error)
+	// JobEnqueueable votes whether the job may be enqueued.
+	JobEnqueueable(context.Context, *JobRequest) (*JobEnqueueableResponse, error)
+	// JobEnqueued notifies that the job was enqueued.
+	JobEnqueued(context.Context, *JobRequest) (*JobEnqueuedResponse, error)
+	// JobReady checks whether the job is ready to run.
+	JobReady(context.Context, *JobRequest) (*JobReadyResponse, error)
+	// Allocate notifies that the task was allocated in the session.
+	Allocate(context.Context, *TaskEventRequest) (*TaskEventResponse, error)
+	// Deallocate notifies that the allocation of the task was rolled back in the session.
+	Deallocate(context.Context, *TaskEventRequest) (*TaskEventResponse, error)
+	// HyperNodeOrder scores the hyperNodes for the sub job.
+	HyperNodeOrder(context.Context, *HyperNodeOrderRequest) (*HyperNodeOrderResponse, error)
+	// VictimTasks selects the tasks to evict among the running tasks.
+	VictimTasks(context.Context, *VictimTasksRequest) (*VictimTasksResponse, error)
+	// PreBind checks the final allocation of the task before it is bound to its node.
+	PreBind(context.Context, *PreBindRequest) (*PreBindResponse, error)
+	// PreBindRollBack notifies that the binding of the task failed after the pre-bind.
+	PreBindRollBack(context.Context, *PreBindRequest) (*PreBindRollBackResponse, error)
+	mustEmbedUnimplementedExtenderServer()
+}
+
+// UnimplementedExtenderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExtenderServer struct{}
+
+func (UnimplementedExtenderServer) OnSessionOpen(context.Context, *OnSessionOpenRequest) (*OnSessionOpenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OnSessionOpen not implemented")
+}
+func (UnimplementedExtenderServer) OnSessionClose(context.Context, *OnSessionCloseRequest) (*OnSessionCloseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OnSessionClose not implemented")
+}
+func (UnimplementedExtenderServer) Predicate(context.Context, *PredicateRequest) (*PredicateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Predicate not implemented")
+}
+func (UnimplementedExtenderServer) Prioritize(context.Context, *PrioritizeRequest) (*PrioritizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Prioritize not implemented")
+}
+func (UnimplementedExtenderServer) Preemptable(context.Context, *EvictableRequest) (*EvictableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Preemptable not implemented")
+}
+func (UnimplementedExtenderServer) Reclaimable(context.Context, *EvictableRequest) (*EvictableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Reclaimable not implemented")
+}
+func (UnimplementedExtenderServer) QueueOverused(context.Context, *QueueOverusedRequest) (*QueueOverusedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QueueOverused not implemented")
+}
+func (UnimplementedExtenderServer) JobEnqueueable(context.Context, *JobRequest) (*JobEnqueueableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JobEnqueueable not implemented")
+}
+func (UnimplementedExtenderServer) JobEnqueued(context.Context, *JobRequest) (*JobEnqueuedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JobEnqueued not implemented")
+}
+func (UnimplementedExtenderServer) JobReady(context.Context, *JobRequest) (*JobReadyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JobReady not implemented")
+}
+func (UnimplementedExtenderServer) Allocate(context.Context, *TaskEventRequest) (*TaskEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Allocate not implemented")
+}
+func (UnimplementedExtenderServer) Deallocate(context.Context, *TaskEventRequest) (*TaskEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Deallocate not implemented")
+}
+func (UnimplementedExtenderServer) HyperNodeOrder(context.Context, *HyperNodeOrderRequest) (*HyperNodeOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HyperNodeOrder not implemented")
+}
+func (UnimplementedExtenderServer) VictimTasks(context.Context, *VictimTasksRequest) (*VictimTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VictimTasks not implemented")
+}
+func (UnimplementedExtenderServer) PreBind(context.Context, *PreBindRequest) (*PreBindResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreBind not implemented")
+}
+func (UnimplementedExtenderServer) PreBindRollBack(context.Context, *PreBindRequest) (*PreBindRollBackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreBindRollBack not implemented")
+}
+func (UnimplementedExtenderServer) mustEmbedUnimplementedExtenderServer() {}
+func (UnimplementedExtenderServer) testEmbeddedByValue()                  {}
+
+// UnsafeExtenderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExtenderServer will
+// result in compilation errors.
+type UnsafeExtenderServer interface {
+	mustEmbedUnimplementedExtenderServer()
+}
+
+func RegisterExtenderServer(s grpc.ServiceRegistrar, srv ExtenderServer) {
+	// If the following call panics, it indicates UnimplementedExtenderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Extender_ServiceDesc, srv)
+}
+
+func _Extender_OnSessionOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSessionOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).OnSessionOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_OnSessionOpen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).OnSessionOpen(ctx, req.(*OnSessionOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_OnSessionClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSessionCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).OnSessionClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_OnSessionClose_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).OnSessionClose(ctx, req.(*OnSessionCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_Predicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PredicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).Predicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_Predicate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).Predicate(ctx, req.(*PredicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_Prioritize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrioritizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).Prioritize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_Prioritize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).Prioritize(ctx, req.(*PrioritizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_Preemptable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).Preemptable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_Preemptable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).Preemptable(ctx, req.(*EvictableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_Reclaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).Reclaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_Reclaimable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).Reclaimable(ctx, req.(*EvictableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_QueueOverused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueOverusedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).QueueOverused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_QueueOverused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).QueueOverused(ctx, req.(*QueueOverusedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_JobEnqueueable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).JobEnqueueable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_JobEnqueueable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).JobEnqueueable(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_JobEnqueued_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).JobEnqueued(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_JobEnqueued_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).JobEnqueued(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_JobReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).JobReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_JobReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).JobReady(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_Allocate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).Allocate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_Allocate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).Allocate(ctx, req.(*TaskEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_Deallocate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).Deallocate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_Deallocate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).Deallocate(ctx, req.(*TaskEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_HyperNodeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HyperNodeOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).HyperNodeOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_HyperNodeOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).HyperNodeOrder(ctx, req.(*HyperNodeOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_VictimTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VictimTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).VictimTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_VictimTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).VictimTasks(ctx, req.(*VictimTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_PreBind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreBindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).PreBind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_PreBind_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).PreBind(ctx, req.(*PreBindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Extender_PreBindRollBack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreBindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtenderServer).PreBindRollBack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Extender_PreBindRollBack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtenderServer).PreBindRollBack(ctx, req.(*PreBindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Extender_ServiceDesc is the grpc.ServiceDesc for Extender service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Extender_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "volcano.scheduler.extender.v1.Extender",
+	HandlerType: (*ExtenderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OnSessionOpen",
+			Handler:    _Extender_OnSessionOpen_Handler,
+		},
+		{
+			MethodName: "OnSessionClose",
+			Handler:    _Extender_OnSessionClose_Handler,
+		},
+		{
+			MethodName: "Predicate",
+			Handler:    _Extender_Predicate_Handler,
+		},
+		{
+			MethodName: "Prioritize",
+			Handler:    _Extender_Prioritize_Handler,
+		},
+		{
+			MethodName: "Preemptable",
+			Handler:    _Extender_Preemptable_Handler,
+		},
+		{
+			MethodName: "Reclaimable",
+			Handler:    _Extender_Reclaimable_Handler,
+		},
+		{
+			MethodName: "QueueOverused",
+			Handler:    _Extender_QueueOverused_Handler,
+		},
+		{
+			MethodName: "JobEnqueueable",
+			Handler:    _Extender_JobEnqueueable_Handler,
+		},
+		{
+			MethodName: "JobEnqueued",
+			Handler:    _Extender_JobEnqueued_Handler,
+		},
+		{
+			MethodName: "JobReady",
+			Handler:    _Extender_JobReady_Handler,
+		},
+		{
+			MethodName: "Allocate",
+			Handler:    _Extender_Allocate_Handler,
+		},
+		{
+			MethodName: "Deallocate",
+			Handler:    _Extender_Deallocate_Handler,
+		},
+		{
+			MethodName: "HyperNodeOrder",
+			Handler:    _Extender_HyperNodeOrder_Handler,
+		},
+		{
+			MethodName: "VictimTasks",
+			Handler:    _Extender_VictimTasks_Handler,
+		},
+		{
+			MethodName: "PreBind",
+			Handler:    _Extender_PreBind_Handler,
+		},
+		{
+			MethodName: "PreBindRollBack",
+			Handler:    _Extender_PreBindRollBack_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "extender.proto",
+}
@@ -252,7 +252,7 @@ func (gp *gangPlugin) OnSessionClose(ssn *framework.Session) {
 				unreadyTaskCount, len(job.Tasks), job.FitError())
 
 			unScheduleJobCount++
-			if !ssn.IsJobTerminated(job.UID) {
+			if !ssn.DryRun() && !ssn.IsJobTerminated(job.UID) {
 				metrics.RegisterJobRetries(job.Name)
 			}
 
@@ -287,11 +287,13 @@ func (gp *gangPlugin) OnSessionClose(ssn *framework.Session) {
 					job.Namespace, job.Name, err)
 			}
 		}
-		if !ssn.IsJobTerminated(job.UID) {
+		if !ssn.DryRun() && !ssn.IsJobTerminated(job.UID) {
 			metrics.UpdateUnscheduleTaskCount(job.Name, int(unreadyTaskCount))
 		}
 		unreadyTaskCount = 0
 	}
 
-	metrics.UpdateUnscheduleJobCount(unScheduleJobCount)
+	if !ssn.DryRun() {
+		metrics.UpdateUnscheduleJobCount(unScheduleJobCount)
+	}
 }
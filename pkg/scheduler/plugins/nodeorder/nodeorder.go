@@ -22,6 +22,7 @@ package nodeorder
 
 import (
 	"context"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	utilFeature "k8s.io/apiserver/pkg/util/feature"
@@ -42,6 +43,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/plugins/util/k8s"
 	"volcano.sh/volcano/pkg/scheduler/plugins/util/nodescore"
+	commonutil "volcano.sh/volcano/pkg/util"
 )
 
 const (
@@ -64,6 +66,12 @@ const (
 	ImageLocalityWeight = "imagelocality.weight"
 	// PodTopologySpreadWeight is the key for providing Pod Topology Spread Priority Weight in YAML
 	PodTopologySpreadWeight = "podtopologyspread.weight"
+	// NodeSuspicionWeight is the key for providing Node Suspicion Priority Weight in YAML
+	NodeSuspicionWeight = "nodesuspicion.weight"
+
+	// nodeSuspicionScore is the score a node loses for each job which stopped scheduling its pods to the node
+	// for the failures on it, up to the max node score.
+	nodeSuspicionScore = 10
 )
 
 type NodeOrderPlugin struct {
@@ -107,6 +115,7 @@ type priorityWeight struct {
 	taintTolerationWeight   int
 	imageLocalityWeight     int
 	podTopologySpreadWeight int
+	nodeSuspicionWeight     int
 }
 
 // calculateWeight from the provided arguments.
@@ -136,6 +145,7 @@ type priorityWeight struct {
 //	      tainttoleration.weight: 3
 //	      imagelocality.weight: 1
 //	      podtopologyspread.weight: 2
+//	      nodesuspicion.weight: 1
 func calculateWeight(args framework.Arguments) priorityWeight {
 	// Initial values for weights.
 	// By default, for backward compatibility and for reasonable scores,
@@ -149,6 +159,7 @@ func calculateWeight(args framework.Arguments) priorityWeight {
 		taintTolerationWeight:   3,
 		imageLocalityWeight:     1,
 		podTopologySpreadWeight: 2, // be consistent with kubernetes default setting.
+		nodeSuspicionWeight:     0,
 	}
 
 	// Checks whether nodeaffinity.weight is provided or not, if given, modifies the value in weight struct.
@@ -175,6 +186,9 @@ func calculateWeight(args framework.Arguments) priorityWeight {
 	// Checks whether podtopologyspread.weight is provided or not, if given, modifies the value in weight struct.
 	args.GetInt(&weight.podTopologySpreadWeight, PodTopologySpreadWeight)
 
+	// Checks whether nodesuspicion.weight is provided or not, if given, modifies the value in weight struct.
+	args.GetInt(&weight.nodeSuspicionWeight, NodeSuspicionWeight)
+
 	return weight
 }
 
@@ -364,10 +378,24 @@ func (pp *NodeOrderPlugin) NodeOrderFn(task *api.TaskInfo, node *api.NodeInfo, k
 		nodeScore += weightedScore
 		klog.V(5).Infof("Node: %s, task<%s/%s> plugin %s weight %d, raw score: %d, weighted score: %f", node.Name, task.Namespace, task.Name, name, p.weight, score, weightedScore)
 	}
+	if penalty := pp.nodeSuspicionPenalty(node); penalty != 0 {
+		nodeScore -= penalty
+		klog.V(5).Infof("Node: %s, task<%s/%s> is suspected to be faulty, penalty: %f", node.Name, task.Namespace, task.Name, penalty)
+	}
 	klog.V(4).Infof("Nodeorder Total Score for task<%s/%s> on node %s is: %f", task.Namespace, task.Name, node.Name, nodeScore)
 	return nodeScore, nil
 }
 
+// nodeSuspicionPenalty returns the score the node loses for the jobs which stopped scheduling their pods to it for the
+// failures on it, see the node-suspicion-enabled flag of the job controller.
+func (pp *NodeOrderPlugin) nodeSuspicionPenalty(node *api.NodeInfo) float64 {
+	if pp.weight.nodeSuspicionWeight == 0 || node.Node == nil {
+		return 0
+	}
+	suspicion := commonutil.GetNodeSuspicion(node.Node, time.Now())
+	return float64(min(int64(suspicion*nodeSuspicionScore), fwk.MaxNodeScore) * int64(pp.weight.nodeSuspicionWeight))
+}
+
 // BatchNodeOrderFn scores all candidate nodes for the given task in one call.
 func (pp *NodeOrderPlugin) BatchNodeOrderFn(task *api.TaskInfo, nodes []*api.NodeInfo, nodeMap map[string]fwk.NodeInfo, state *k8sframework.CycleState) (map[string]float64, error) {
 	nodeInfos := nodescore.NodeInfosForCandidateNodes(nodes, nodeMap)
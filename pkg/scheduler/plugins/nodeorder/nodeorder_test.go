@@ -19,6 +19,7 @@ package nodeorder
 import (
 	"os"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,6 +44,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/plugins/util/k8s"
 	"volcano.sh/volcano/pkg/scheduler/uthelper"
 	"volcano.sh/volcano/pkg/scheduler/util"
+	commonutil "volcano.sh/volcano/pkg/util"
 )
 
 func TestMain(m *testing.M) {
@@ -443,3 +445,56 @@ func TestInitPlugin(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeSuspicionPenalty(t *testing.T) {
+	tests := []struct {
+		name            string
+		arguments       framework.Arguments
+		suspicion       string
+		raised          time.Duration
+		expectedPenalty float64
+	}{
+		{
+			name:      "suspicion is ignored by default",
+			suspicion: "3",
+		},
+		{
+			name:            "penalty grows with the suspicion",
+			arguments:       framework.Arguments{NodeSuspicionWeight: 2},
+			suspicion:       "3",
+			expectedPenalty: 60,
+		},
+		{
+			name:            "penalty is capped by the max node score",
+			arguments:       framework.Arguments{NodeSuspicionWeight: 1},
+			suspicion:       "20",
+			expectedPenalty: 100,
+		},
+		{
+			name:      "expired suspicion has no penalty",
+			arguments: framework.Arguments{NodeSuspicionWeight: 1},
+			suspicion: "3",
+			raised:    commonutil.NodeSuspicionExpiry,
+		},
+		{
+			name:      "unsuspected node has no penalty",
+			arguments: framework.Arguments{NodeSuspicionWeight: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pp := New(tt.arguments).(*NodeOrderPlugin)
+			node := util.BuildNode("n1", api.BuildResourceList("2", "4Gi"), make(map[string]string))
+			if tt.suspicion != "" {
+				node.Annotations = map[string]string{
+					commonutil.NodeSuspicionKey:     tt.suspicion,
+					commonutil.NodeSuspicionTimeKey: time.Now().Add(-tt.raised).Format(time.RFC3339),
+				}
+			}
+			if penalty := pp.nodeSuspicionPenalty(api.NewNodeInfo(node)); penalty != tt.expectedPenalty {
+				t.Errorf("expected penalty %v, got %v", tt.expectedPenalty, penalty)
+			}
+		})
+	}
+}
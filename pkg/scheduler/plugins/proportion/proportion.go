@@ -38,6 +38,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
 	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/fairshare"
 )
 
 // PluginName indicates name of volcano scheduler plugin.
@@ -50,8 +51,14 @@ type proportionPlugin struct {
 	totalResource  *api.Resource
 	totalGuarantee *api.Resource
 	queueOpts      map[api.QueueID]*queueAttr
+	// fairShare blends the decayed historical usage of queues into their share if enabled
+	fairShare fairshare.Config
+	// fairShareFactors is the fair-share factor of each queue, a higher factor means a higher priority
+	fairShareFactors map[api.QueueID]float64
 	// Arguments given for the plugin
 	pluginArguments framework.Arguments
+	// dryRun skips the metrics of what-if and simulated sessions
+	dryRun bool
 }
 
 type queueAttr struct {
@@ -79,6 +86,7 @@ func New(arguments framework.Arguments) framework.Plugin {
 		totalResource:   api.EmptyResource(),
 		totalGuarantee:  api.EmptyResource(),
 		queueOpts:       map[api.QueueID]*queueAttr{},
+		fairShare:       fairshare.ParseArguments(arguments),
 		pluginArguments: arguments,
 	}
 }
@@ -89,6 +97,7 @@ func (pp *proportionPlugin) Name() string {
 
 func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 	// Prepare scheduling data for this session.
+	pp.dryRun = ssn.DryRun()
 	pp.totalResource.Add(ssn.TotalResource)
 
 	klog.V(4).Infof("The total resource is <%v>", pp.totalResource)
@@ -100,6 +109,9 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		pp.totalGuarantee.Add(guarantee)
 	}
 	klog.V(4).Infof("The total guarantee resource is <%v>", pp.totalGuarantee)
+	if pp.fairShare.Enabled {
+		pp.updateFairShareFactors(ssn)
+	}
 	// Build attributes for Queues.
 	for _, job := range ssn.Jobs {
 		klog.V(4).Infof("Considering Job <%s/%s>.", job.Namespace, job.Name)
@@ -184,6 +196,9 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 
 	// Record metrics
 	for queueID, queueInfo := range ssn.Queues {
+		if pp.dryRun {
+			break
+		}
 		if attr, ok := pp.queueOpts[queueID]; ok {
 			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
 			metrics.UpdateQueueRequest(attr.name, attr.request.MilliCPU, attr.request.Memory, attr.request.ScalarResources)
@@ -254,7 +269,9 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 			decreasedDeserved.Add(decreased)
 
 			// Record metrics
-			metrics.UpdateQueueDeserved(attr.name, attr.deserved.MilliCPU, attr.deserved.Memory, attr.deserved.ScalarResources)
+			if !pp.dryRun {
+				metrics.UpdateQueueDeserved(attr.name, attr.deserved.MilliCPU, attr.deserved.Memory, attr.deserved.ScalarResources)
+			}
 		}
 
 		remaining = api.ExceededPart(remaining.Clone().Add(decreasedDeserved), increasedDeserved)
@@ -274,11 +291,18 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 			return int(rv.Queue.Spec.Priority) - int(lv.Queue.Spec.Priority)
 		}
 
-		if pp.queueOpts[lv.UID].share == pp.queueOpts[rv.UID].share {
+		lshare, rshare := pp.queueOpts[lv.UID].share, pp.queueOpts[rv.UID].share
+		if pp.fairShare.Enabled {
+			// A higher fair-share factor means less historical usage relative to the queue weight.
+			lshare = fairshare.Blend(lshare, pp.fairShareFactors[lv.UID])
+			rshare = fairshare.Blend(rshare, pp.fairShareFactors[rv.UID])
+		}
+
+		if lshare == rshare {
 			return 0
 		}
 
-		if pp.queueOpts[lv.UID].share < pp.queueOpts[rv.UID].share {
+		if lshare < rshare {
 			return -1
 		}
 
@@ -323,7 +347,9 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		attr := pp.queueOpts[queue.UID]
 
 		overused := attr.deserved.LessEqual(attr.allocated, api.Zero)
-		metrics.UpdateQueueOverused(attr.name, overused)
+		if !pp.dryRun {
+			metrics.UpdateQueueOverused(attr.name, overused)
+		}
 		if overused {
 			klog.V(3).Infof("Queue <%v> is overused: deserved <%v>, allocated <%v>, share <%v>",
 				queue.Name, attr.deserved, attr.allocated, attr.share)
@@ -506,7 +532,9 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 				return
 			}
 			attr.allocated.Add(event.Task.Resreq)
-			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
+			if !pp.dryRun {
+				metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
+			}
 
 			pp.updateShare(attr)
 
@@ -527,7 +555,9 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 				return
 			}
 			attr.allocated.Sub(event.Task.Resreq)
-			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
+			if !pp.dryRun {
+				metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
+			}
 
 			pp.updateShare(attr)
 
@@ -541,11 +571,31 @@ func (pp *proportionPlugin) OnSessionClose(ssn *framework.Session) {
 	pp.totalResource = nil
 	pp.totalGuarantee = nil
 	pp.queueOpts = nil
+	pp.fairShareFactors = nil
+}
+
+// updateFairShareFactors accumulates the decayed usage of the queues and computes their fair-share factors
+// from the usage and the queue weights.
+func (pp *proportionPlugin) updateFairShareFactors(ssn *framework.Session) {
+	fairshare.UpdateUsage(ssn, pp.fairShare.HalfLife, ssn.StartTime)
+
+	usage := make(map[api.QueueID]*api.Resource, len(ssn.Queues))
+	weights := make(map[api.QueueID]float64, len(ssn.Queues))
+	for queueID, queue := range ssn.Queues {
+		usage[queueID] = fairshare.QueueUsage(queue)
+		weights[queueID] = float64(queue.Weight)
+	}
+	pp.fairShareFactors = fairshare.Factors(usage, weights)
+	for queueID, factor := range pp.fairShareFactors {
+		klog.V(4).Infof("The fair-share factor of queue <%s> is %f, decayed usage <%v>", queueID, factor, usage[queueID])
+	}
 }
 
 func (pp *proportionPlugin) updateShare(attr *queueAttr) {
 	updateQueueAttrShare(attr)
-	metrics.UpdateQueueShare(attr.name, attr.share)
+	if !pp.dryRun {
+		metrics.UpdateQueueShare(attr.name, attr.share)
+	}
 }
 
 type proportionState struct {
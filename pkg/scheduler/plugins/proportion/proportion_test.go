@@ -509,6 +509,87 @@ func TestAllocate(t *testing.T) {
 	}
 }
 
+func TestFairShareQueueOrder(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+	trueValue := true
+	actions := []framework.Action{allocate.New()}
+
+	n1 := util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string))
+
+	// pods are bound by the cases, so each case gets its own.
+	pods := func() []*apiv1.Pod {
+		return []*apiv1.Pod{
+			util.BuildPod("ns1", "p1", "", apiv1.PodPending, api.BuildResourceList("2", "4Gi"), "pg1", make(map[string]string), make(map[string]string)),
+			util.BuildPod("ns2", "p2", "", apiv1.PodPending, api.BuildResourceList("2", "4Gi"), "pg2", make(map[string]string), make(map[string]string)),
+		}
+	}
+
+	pg1 := util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue)
+	pg2 := util.BuildPodGroup("pg2", "ns2", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue)
+
+	// q1 used a week of 2 cpus, q2 used nothing.
+	queue1 := util.BuildQueue("q1", 1, nil)
+	queue1.Status.Usage = &schedulingv1beta1.QueueUsage{
+		Resources:      api.BuildResourceList("1209600", "0"),
+		LastUpdateTime: metav1.Now(),
+	}
+	queue2 := util.BuildQueue("q2", 1, nil)
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "without fair-share, queues with equal share are ordered by creation",
+				Plugins:        plugins,
+				Pods:           pods(),
+				Nodes:          []*apiv1.Node{n1},
+				PodGroups:      []*schedulingv1beta1.PodGroup{pg1, pg2},
+				Queues:         []*schedulingv1beta1.Queue{queue1, queue2},
+				ExpectBindMap:  map[string]string{"ns1/p1": "n1"},
+				ExpectBindsNum: 1,
+			},
+			arguments: framework.Arguments{},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "with fair-share, the queue without historical usage goes first",
+				Plugins:        plugins,
+				Pods:           pods(),
+				Nodes:          []*apiv1.Node{n1},
+				PodGroups:      []*schedulingv1beta1.PodGroup{pg1, pg2},
+				Queues:         []*schedulingv1beta1.Queue{queue1, queue2},
+				ExpectBindMap:  map[string]string{"ns2/p2": "n1"},
+				ExpectBindsNum: 1,
+			},
+			arguments: framework.Arguments{"fairShare.enable": true, "fairShare.halfLife": "168h"},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:              PluginName,
+							EnabledQueueOrder: &trueValue,
+							Arguments:         test.arguments,
+						},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run(actions)
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 // TestNoDoubleCountingForInqueueJobWithBindingTasks is a regression test for the bug where
 // the proportion plugin double-counts queue resources for jobs whose tasks are in Allocated/Binding
 // state while their PodGroup is still in Inqueue phase.
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reservation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+	"k8s.io/utils/clock"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "reservation"
+
+	// GuardPeriodKey is the argument key of the period before a reservation starts, during which
+	// only work expected to finish before the start is placed on the reserved nodes.
+	GuardPeriodKey = "guardPeriod"
+	// ReservationsKey is the argument key of the reservation list.
+	ReservationsKey = "reservations"
+
+	// DefaultGuardPeriod is the default guard period of reservations.
+	DefaultGuardPeriod = time.Hour
+)
+
+// Reservation holds the capacity of a set of nodes for a job or a queue in a future time window.
+type Reservation struct {
+	Name string `mapstructure:"name"`
+	// Nodes and NodeSelector select the reserved nodes, a node is reserved if it matches either of them.
+	Nodes        []string          `mapstructure:"nodes"`
+	NodeSelector map[string]string `mapstructure:"nodeSelector"`
+	// Queue is the queue the reservation is made for.
+	Queue string `mapstructure:"queue"`
+	// Job is the namespace/name of the job or podgroup the reservation is made for, it narrows the queue if both are set.
+	Job string `mapstructure:"job"`
+	// Start and End are the time window of the reservation in RFC3339 format.
+	Start string `mapstructure:"start"`
+	End   string `mapstructure:"end"`
+
+	start time.Time
+	end   time.Time
+}
+
+/*
+Reservations are given by the plugin arguments, the scheduler configuration is reloaded on change:
+
+	actions: "enqueue, allocate, backfill"
+	tiers:
+	- plugins:
+	  - name: reservation
+	    arguments:
+	      guardPeriod: 2h
+	      reservations:
+	      - name: llm-training
+	        nodeSelector:
+	          pool: a100
+	        queue: research
+	        job: research/llm-pretrain
+	        start: "2026-10-19T02:00:00Z"
+	        end: "2026-10-19T06:00:00Z"
+
+Jobs declare their estimated running duration by the podgroup annotation volcano.sh/running-estimate.
+*/
+type reservationPlugin struct {
+	pluginArguments framework.Arguments
+
+	guardPeriod  time.Duration
+	reservations []*Reservation
+	// reservedNodes is the reservation which is in or approaching its window for each reserved node.
+	reservedNodes map[string]*Reservation
+
+	clock clock.PassiveClock
+}
+
+// New returns a reservation plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	return &reservationPlugin{
+		pluginArguments: arguments,
+		guardPeriod:     DefaultGuardPeriod,
+		reservedNodes:   map[string]*Reservation{},
+		clock:           clock.RealClock{},
+	}
+}
+
+func (rp *reservationPlugin) Name() string {
+	return PluginName
+}
+
+func (rp *reservationPlugin) parseArguments() {
+	var guardPeriod string
+	rp.pluginArguments.GetString(&guardPeriod, GuardPeriodKey)
+	if guardPeriod != "" {
+		if period, err := time.ParseDuration(guardPeriod); err != nil || period < 0 {
+			klog.Warningf("Invalid %s %q in reservation plugin, use default %v", GuardPeriodKey, guardPeriod, DefaultGuardPeriod)
+		} else {
+			rp.guardPeriod = period
+		}
+	}
+
+	items, _ := rp.pluginArguments[ReservationsKey].([]interface{})
+	for _, item := range items {
+		r := &Reservation{}
+		if err := mapstructure.Decode(item, r); err != nil {
+			klog.Errorf("Failed to decode reservation %v: %v", item, err)
+			continue
+		}
+		if err := r.parseWindow(); err != nil {
+			klog.Errorf("Ignore reservation %s: %v", r.Name, err)
+			continue
+		}
+		rp.reservations = append(rp.reservations, r)
+	}
+}
+
+func (r *Reservation) parseWindow() error {
+	var err error
+	if r.start, err = time.Parse(time.RFC3339, r.Start); err != nil {
+		return fmt.Errorf("invalid start: %v", err)
+	}
+	if r.end, err = time.Parse(time.RFC3339, r.End); err != nil {
+		return fmt.Errorf("invalid end: %v", err)
+	}
+	if !r.end.After(r.start) {
+		return fmt.Errorf("end %s is not after start %s", r.End, r.Start)
+	}
+	if r.Queue == "" && r.Job == "" {
+		return fmt.Errorf("neither queue nor job is set")
+	}
+	return nil
+}
+
+// selects returns whether the node is reserved by the reservation.
+func (r *Reservation) selects(node *api.NodeInfo) bool {
+	for _, name := range r.Nodes {
+		if name == node.Name {
+			return true
+		}
+	}
+	if len(r.NodeSelector) == 0 || node.Node == nil {
+		return false
+	}
+	return labels.SelectorFromSet(r.NodeSelector).Matches(labels.Set(node.Node.Labels))
+}
+
+// owns returns whether the reservation is made for the job. A reservation with both queue and job
+// set is only made for the job, if the job is in the queue.
+func (r *Reservation) owns(job *api.JobInfo) bool {
+	if r.Queue != "" && string(job.Queue) != r.Queue {
+		return false
+	}
+	if r.Job == "" {
+		return true
+	}
+	if string(job.UID) == r.Job {
+		return true
+	}
+	// Podgroups created by the job controller are named after the job with a suffix.
+	if job.PodGroup != nil {
+		for _, owner := range job.PodGroup.OwnerReferences {
+			if fmt.Sprintf("%s/%s", job.Namespace, owner.Name) == r.Job {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// active returns whether t is inside the window of the reservation.
+func (r *Reservation) active(t time.Time) bool {
+	return !t.Before(r.start) && t.Before(r.end)
+}
+
+func (rp *reservationPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter reservation plugin ...")
+	defer klog.V(5).Infof("Leaving reservation plugin.")
+
+	rp.parseArguments()
+	if len(rp.reservations) == 0 {
+		return
+	}
+
+	ssn.AddReservedNodesFn(rp.Name(), func() {
+		current := rp.clock.Now()
+		for _, r := range rp.reservations {
+			// Reservations which are over or too far in the future do not hold any node yet.
+			if !current.Before(r.end) || current.Before(r.start.Add(-rp.guardPeriod)) {
+				continue
+			}
+			for _, node := range ssn.Nodes {
+				if !r.selects(node) {
+					continue
+				}
+				if other, found := rp.reservedNodes[node.Name]; found {
+					klog.Warningf("Node %s is reserved by both %s and %s, only %s is honored", node.Name, other.Name, r.Name, other.Name)
+					continue
+				}
+				rp.reservedNodes[node.Name] = r
+			}
+			klog.V(4).Infof("Reservation %s holds nodes from %s to %s", r.Name, r.Start, r.End)
+		}
+	})
+
+	ssn.AddPredicateFn(rp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+		r, found := rp.reservedNodes[node.Name]
+		if !found {
+			return nil
+		}
+		job, found := ssn.Jobs[task.Job]
+		if !found || r.owns(job) {
+			return nil
+		}
+
+		current := rp.clock.Now()
+		if r.active(current) {
+			return newFitErr(task, node, fmt.Sprintf("node is reserved by %s until %s", r.Name, r.End))
+		}
+		// Approaching the window, only the work known to finish before the reservation starts is let in.
+		if estimate := job.EstimatedRuntime(); estimate == nil || current.Add(*estimate).After(r.start) {
+			return newFitErr(task, node, fmt.Sprintf("node is reserved by %s from %s, the job may not finish before", r.Name, r.Start))
+		}
+		return nil
+	})
+
+	ssn.AddNodeOrderFn(rp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		r, found := rp.reservedNodes[node.Name]
+		if !found || !r.active(rp.clock.Now()) {
+			return 0, nil
+		}
+		if job, found := ssn.Jobs[task.Job]; found && r.owns(job) {
+			return float64(fwk.MaxNodeScore), nil
+		}
+		return 0, nil
+	})
+}
+
+func (rp *reservationPlugin) OnSessionClose(ssn *framework.Session) {
+	rp.reservations = nil
+	rp.reservedNodes = map[string]*Reservation{}
+}
+
+func newFitErr(task *api.TaskInfo, node *api.NodeInfo, reason string) error {
+	status := &api.Status{
+		Code:   api.UnschedulableAndUnresolvable,
+		Reason: reason,
+	}
+	return api.NewFitErrWithStatus(task, node, status)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reservation
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+	commonutil "volcano.sh/volcano/pkg/util"
+)
+
+const testArguments = `
+guardPeriod: 1h
+reservations:
+- name: training
+  nodeSelector:
+    pool: a100
+  queue: q-owner
+  start: "2026-10-19T02:00:00Z"
+  end: "2026-10-19T06:00:00Z"
+`
+
+func TestReservation(t *testing.T) {
+	arguments := framework.Arguments{}
+	if err := yaml.Unmarshal([]byte(testArguments), &arguments); err != nil {
+		t.Fatalf("failed to parse arguments: %v", err)
+	}
+
+	n1 := util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"pool": "a100"})
+	buildPodGroup := func(queue, runningEstimate string) *schedulingv1beta1.PodGroup {
+		pg := util.BuildPodGroup("pg1", "c1", queue, 1, nil, schedulingv1beta1.PodGroupInqueue)
+		if runningEstimate != "" {
+			pg.Annotations = map[string]string{commonutil.JobRunningEstimate: runningEstimate}
+		}
+		return pg
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		now string
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:          "reservation too far in the future does not hold the nodes",
+				PodGroups:     []*schedulingv1beta1.PodGroup{buildPodGroup("q1", "")},
+				ExpectBindMap: map[string]string{"c1/p1": "n1"},
+			},
+			now: "2026-10-19T00:30:00Z",
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:          "job without running estimate is kept off in the guard period",
+				PodGroups:     []*schedulingv1beta1.PodGroup{buildPodGroup("q1", "")},
+				ExpectBindMap: map[string]string{},
+			},
+			now: "2026-10-19T01:30:00Z",
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:          "job finishing before the window is backfilled in the guard period",
+				PodGroups:     []*schedulingv1beta1.PodGroup{buildPodGroup("q1", "20m")},
+				ExpectBindMap: map[string]string{"c1/p1": "n1"},
+			},
+			now: "2026-10-19T01:30:00Z",
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:          "job running into the window is kept off in the guard period",
+				PodGroups:     []*schedulingv1beta1.PodGroup{buildPodGroup("q1", "2h")},
+				ExpectBindMap: map[string]string{},
+			},
+			now: "2026-10-19T01:30:00Z",
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:          "other queues can not use the nodes in the window",
+				PodGroups:     []*schedulingv1beta1.PodGroup{buildPodGroup("q1", "20m")},
+				ExpectBindMap: map[string]string{},
+			},
+			now: "2026-10-19T03:00:00Z",
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:          "owner queue uses the nodes in the window",
+				PodGroups:     []*schedulingv1beta1.PodGroup{buildPodGroup("q-owner", "")},
+				ExpectBindMap: map[string]string{"c1/p1": "n1"},
+			},
+			now: "2026-10-19T03:00:00Z",
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:          "nodes are released after the window",
+				PodGroups:     []*schedulingv1beta1.PodGroup{buildPodGroup("q1", "")},
+				ExpectBindMap: map[string]string{"c1/p1": "n1"},
+			},
+			now: "2026-10-19T06:00:00Z",
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                 PluginName,
+					EnabledPredicate:     &trueValue,
+					EnabledNodeOrder:     &trueValue,
+					EnabledReservedNodes: &trueValue,
+					Arguments:            arguments,
+				},
+			},
+		},
+	}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			current, err := time.Parse(time.RFC3339, test.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.Plugins = map[string]framework.PluginBuilder{PluginName: func(arguments framework.Arguments) framework.Plugin {
+				rp := New(arguments).(*reservationPlugin)
+				rp.clock = testingclock.NewFakePassiveClock(current)
+				return rp
+			}}
+			test.Pods = []*v1.Pod{util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)}
+			test.Nodes = []*v1.Node{n1}
+			test.Queues = []*schedulingv1beta1.Queue{util.BuildQueue("q1", 1, nil), util.BuildQueue("q-owner", 1, nil)}
+			test.ExpectBindsNum = len(test.ExpectBindMap)
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestReservationOwns(t *testing.T) {
+	job := &api.JobInfo{UID: "ns1/job-a-1234", Namespace: "ns1", Queue: "q1", PodGroup: &api.PodGroup{}}
+	job.PodGroup.OwnerReferences = append(job.PodGroup.OwnerReferences, metav1.OwnerReference{Name: "job-a"})
+	// job-b is in the same queue as job-a.
+	otherJob := &api.JobInfo{UID: "ns1/job-b-5678", Namespace: "ns1", Queue: "q1", PodGroup: &api.PodGroup{}}
+	otherJob.PodGroup.OwnerReferences = append(otherJob.PodGroup.OwnerReferences, metav1.OwnerReference{Name: "job-b"})
+
+	tests := []struct {
+		name        string
+		job         *api.JobInfo
+		reservation *Reservation
+		expected    bool
+	}{
+		{name: "owned by queue", reservation: &Reservation{Queue: "q1"}, expected: true},
+		{name: "owned by podgroup", reservation: &Reservation{Job: "ns1/job-a-1234"}, expected: true},
+		{name: "owned by job", reservation: &Reservation{Job: "ns1/job-a"}, expected: true},
+		{name: "owned by others", reservation: &Reservation{Queue: "q2", Job: "ns2/job-a"}, expected: false},
+		{name: "owned by job in queue", reservation: &Reservation{Queue: "q1", Job: "ns1/job-a"}, expected: true},
+		{name: "owned by job in other queue", reservation: &Reservation{Queue: "q2", Job: "ns1/job-a"}, expected: false},
+		{name: "queue shared with job", job: otherJob, reservation: &Reservation{Queue: "q1"}, expected: true},
+		{name: "owned by other job in queue", job: otherJob, reservation: &Reservation{Queue: "q1", Job: "ns1/job-a"}, expected: false},
+		{name: "owned by other job", job: otherJob, reservation: &Reservation{Job: "ns1/job-a"}, expected: false},
+	}
+	for _, test := range tests {
+		if test.job == nil {
+			test.job = job
+		}
+		if got := test.reservation.owns(test.job); got != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+}
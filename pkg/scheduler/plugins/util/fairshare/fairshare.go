@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fairshare implements fair-share by decayed historical usage, in the style of the Slurm fair-share
+// factor. The usage of each queue, and of each namespace in the queue, is accumulated in resource-seconds and
+// decayed exponentially with a configurable half-life. It is persisted on the Queue status, so that it
+// survives scheduler restarts.
+package fairshare
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// EnableKey is the plugin argument key whether the historical usage is taken into account.
+	EnableKey = "fairShare.enable"
+	// HalfLifeKey is the plugin argument key of the half-life of the historical usage, e.g. "168h".
+	HalfLifeKey = "fairShare.halfLife"
+	// DefaultHalfLife is the half-life of the historical usage if none is configured.
+	DefaultHalfLife = 7 * 24 * time.Hour
+
+	// updatePeriod is the minimum period between two updates of the usage, so that queue status
+	// is not written on every session.
+	updatePeriod = time.Minute
+)
+
+// Config is the fair-share configuration of a plugin.
+type Config struct {
+	Enabled  bool
+	HalfLife time.Duration
+}
+
+// ParseArguments returns the fair-share configuration from the plugin arguments.
+func ParseArguments(arguments framework.Arguments) Config {
+	config := Config{HalfLife: DefaultHalfLife}
+	arguments.GetBool(&config.Enabled, EnableKey)
+
+	var halfLife string
+	arguments.GetString(&halfLife, HalfLifeKey)
+	if halfLife != "" {
+		d, err := time.ParseDuration(halfLife)
+		if err != nil || d <= 0 {
+			klog.Warningf("Invalid fair-share half-life %q, use default %v", halfLife, DefaultHalfLife)
+		} else {
+			config.HalfLife = d
+		}
+	}
+	return config
+}
+
+// UpdateUsage decays the usage of every queue in the session and accumulates the resources allocated to it
+// since the last update until current. The updated queues are marked dirty so that their status is written on
+// session close. The allocation is assumed to be the one at session open for the whole period since the last update.
+// Dry-run sessions, e.g. of the simulator, do not update the usage.
+func UpdateUsage(ssn *framework.Session, halfLife time.Duration, current time.Time) {
+	if ssn.DryRun() {
+		return
+	}
+
+	allocated := map[api.QueueID]map[string]*api.Resource{}
+	for _, job := range ssn.Jobs {
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				if allocated[job.Queue] == nil {
+					allocated[job.Queue] = map[string]*api.Resource{}
+				}
+				if allocated[job.Queue][job.Namespace] == nil {
+					allocated[job.Queue][job.Namespace] = api.EmptyResource()
+				}
+				allocated[job.Queue][job.Namespace].Add(task.Resreq)
+			}
+		}
+	}
+
+	for queueID, queue := range ssn.Queues {
+		usage := queue.Queue.Status.Usage
+		if usage != nil && current.Sub(usage.LastUpdateTime.Time) < updatePeriod {
+			continue
+		}
+		queue.Queue.Status.Usage = accumulate(usage, allocated[queueID], current, halfLife)
+		ssn.MarkQueueDirty(queueID)
+	}
+}
+
+// accumulate returns the usage decayed to now, plus the allocated resources integrated over the elapsed time.
+func accumulate(usage *scheduling.QueueUsage, allocated map[string]*api.Resource, current time.Time,
+	halfLife time.Duration) *scheduling.QueueUsage {
+	if usage == nil || usage.LastUpdateTime.IsZero() {
+		// Nothing is known before the first update.
+		return &scheduling.QueueUsage{LastUpdateTime: metav1.NewTime(current)}
+	}
+
+	elapsed := current.Sub(usage.LastUpdateTime.Time)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	decay := math.Pow(0.5, elapsed.Seconds()/halfLife.Seconds())
+
+	namespaces := map[string]*api.Resource{}
+	for ns, rl := range usage.Namespaces {
+		namespaces[ns] = FromResourceList(rl).Multi(decay)
+	}
+	for ns, res := range allocated {
+		if namespaces[ns] == nil {
+			namespaces[ns] = api.EmptyResource()
+		}
+		namespaces[ns].Add(res.Clone().Multi(elapsed.Seconds()))
+	}
+
+	total := FromResourceList(usage.Resources).Multi(decay)
+	for _, res := range allocated {
+		total.Add(res.Clone().Multi(elapsed.Seconds()))
+	}
+
+	result := &scheduling.QueueUsage{
+		Resources:      ToResourceList(total),
+		LastUpdateTime: metav1.NewTime(current),
+	}
+	for ns, res := range namespaces {
+		if negligible(res) {
+			continue
+		}
+		if result.Namespaces == nil {
+			result.Namespaces = map[string]v1.ResourceList{}
+		}
+		result.Namespaces[ns] = ToResourceList(res)
+	}
+	return result
+}
+
+// negligible returns whether the usage is below one resource-second in every dimension, namespaces with
+// negligible usage are dropped from the queue status.
+func negligible(r *api.Resource) bool {
+	for _, name := range r.ResourceNames() {
+		if !api.IsIgnoredScalarResource(name) && r.Get(name) >= unitScale(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// QueueUsage returns the decayed usage of the queue, empty if it is unknown.
+func QueueUsage(queue *api.QueueInfo) *api.Resource {
+	if queue.Queue == nil || queue.Queue.Status.Usage == nil {
+		return api.EmptyResource()
+	}
+	return FromResourceList(queue.Queue.Status.Usage.Resources)
+}
+
+// NamespaceUsage returns the decayed usage of each namespace, summed up over all queues.
+func NamespaceUsage(queues map[api.QueueID]*api.QueueInfo) map[string]*api.Resource {
+	usage := map[string]*api.Resource{}
+	for _, queue := range queues {
+		if queue.Queue == nil || queue.Queue.Status.Usage == nil {
+			continue
+		}
+		for ns, rl := range queue.Queue.Status.Usage.Namespaces {
+			if usage[ns] == nil {
+				usage[ns] = api.EmptyResource()
+			}
+			usage[ns].Add(FromResourceList(rl))
+		}
+	}
+	return usage
+}
+
+// Factors returns the fair-share factor of each entity, 2^(-U/S) where U is the normalized usage of the entity
+// and S its normalized share. The usage of an entity is normalized per resource dimension against the usage of
+// all entities, and the dominant dimension is taken. An entity which used nothing has factor 1, an entity which
+// used exactly its share has factor 0.5. A higher factor means a higher priority.
+func Factors[K comparable](usage map[K]*api.Resource, weights map[K]float64) map[K]float64 {
+	totalUsage := api.EmptyResource()
+	for _, u := range usage {
+		totalUsage.Add(u)
+	}
+	totalWeight := 0.0
+	for _, w := range weights {
+		totalWeight += w
+	}
+
+	factors := make(map[K]float64, len(weights))
+	for key, weight := range weights {
+		if weight <= 0 || totalWeight <= 0 {
+			factors[key] = 0
+			continue
+		}
+		normalizedUsage := 0.0
+		if u := usage[key]; u != nil {
+			for _, name := range u.ResourceNames() {
+				if api.IsIgnoredScalarResource(name) {
+					continue
+				}
+				if total := totalUsage.Get(name); total > 0 {
+					normalizedUsage = math.Max(normalizedUsage, u.Get(name)/total)
+				}
+			}
+		}
+		factors[key] = math.Pow(2, -normalizedUsage/(weight/totalWeight))
+	}
+	return factors
+}
+
+// Blend returns the share of an entity raised by its lack of fair-share, i.e. 1 - factor, a lower blended share
+// means a higher priority. Entities with equal historical usage and weight keep the order of their shares.
+func Blend(share, factor float64) float64 {
+	return share + (1 - factor)
+}
+
+// ToResourceList converts a usage in resource-seconds to a resource list, pods are not accounted. Unlike
+// util.ConvertRes2ResList it does not overflow for large usages, e.g. a week of terabytes of memory.
+func ToResourceList(r *api.Resource) v1.ResourceList {
+	rl := v1.ResourceList{}
+	for _, name := range r.ResourceNames() {
+		if api.IsIgnoredScalarResource(name) {
+			continue
+		}
+		scale := unitScale(name)
+		precision := 0
+		if scale > 1 {
+			precision = 3
+		}
+		rl[name] = resource.MustParse(strconv.FormatFloat(r.Get(name)/scale, 'f', precision, 64))
+	}
+	return rl
+}
+
+// FromResourceList converts a resource list written by ToResourceList back to a usage in resource-seconds.
+func FromResourceList(rl v1.ResourceList) *api.Resource {
+	r := api.EmptyResource()
+	for name, quantity := range rl {
+		value := quantity.AsApproximateFloat64() * unitScale(name)
+		switch name {
+		case v1.ResourceCPU:
+			r.MilliCPU = value
+		case v1.ResourceMemory:
+			r.Memory = value
+		default:
+			r.AddScalar(name, value)
+		}
+	}
+	return r
+}
+
+// unitScale returns the scale of the resource in api.Resource relative to its unit in resource list.
+func unitScale(name v1.ResourceName) float64 {
+	if name == v1.ResourceMemory {
+		return 1
+	}
+	return 1000
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairshare
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestParseArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments framework.Arguments
+		expected  Config
+	}{
+		{
+			name:      "default",
+			arguments: framework.Arguments{},
+			expected:  Config{HalfLife: DefaultHalfLife},
+		},
+		{
+			name:      "enabled with half-life",
+			arguments: framework.Arguments{EnableKey: true, HalfLifeKey: "24h"},
+			expected:  Config{Enabled: true, HalfLife: 24 * time.Hour},
+		},
+		{
+			name:      "invalid half-life",
+			arguments: framework.Arguments{EnableKey: true, HalfLifeKey: "-1h"},
+			expected:  Config{Enabled: true, HalfLife: DefaultHalfLife},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ParseArguments(test.arguments); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccumulate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	halfLife := time.Hour
+
+	usage := accumulate(nil, nil, start, halfLife)
+	if !usage.LastUpdateTime.Time.Equal(start) || len(usage.Resources) != 0 {
+		t.Fatalf("expected empty usage at %v, got %v", start, usage)
+	}
+
+	// Two cpus used by ns1 for an hour.
+	allocated := map[string]*api.Resource{"ns1": api.NewResource(api.BuildResourceList("2", "1Gi"))}
+	usage = accumulate(usage, allocated, start.Add(time.Hour), halfLife)
+	if got := usage.Resources.Cpu().AsApproximateFloat64(); got != 7200 {
+		t.Errorf("expected 7200 cpu-seconds, got %v", got)
+	}
+	memory := usage.Namespaces["ns1"][v1.ResourceMemory]
+	if got := memory.AsApproximateFloat64(); got != 3600*1024*1024*1024 {
+		t.Errorf("expected 3600Gi memory-seconds, got %v", got)
+	}
+
+	// Nothing used for one half-life, the usage is halved.
+	usage = accumulate(usage, nil, start.Add(2*time.Hour), halfLife)
+	if got := usage.Resources.Cpu().AsApproximateFloat64(); got != 3600 {
+		t.Errorf("expected 3600 cpu-seconds, got %v", got)
+	}
+
+	// Negligible namespace usage is dropped.
+	usage = accumulate(usage, nil, start.Add(100*time.Hour), halfLife)
+	if _, found := usage.Namespaces["ns1"]; found {
+		t.Errorf("expected usage of ns1 to be dropped, got %v", usage.Namespaces)
+	}
+}
+
+func TestFactors(t *testing.T) {
+	usage := map[string]*api.Resource{
+		"heavy": api.NewResource(api.BuildResourceList("3000", "1Gi")),
+		"light": api.NewResource(api.BuildResourceList("1000", "3Gi")),
+	}
+
+	factors := Factors(usage, map[string]float64{"heavy": 1, "light": 1, "idle": 1})
+	if factors["idle"] != 1 {
+		t.Errorf("expected factor 1 for an idle entity, got %v", factors["idle"])
+	}
+	// Both used 75% of a dominant resource with a third of the shares.
+	expected := math.Pow(2, -0.75*3)
+	if math.Abs(factors["heavy"]-expected) > 1e-9 || math.Abs(factors["light"]-expected) > 1e-9 {
+		t.Errorf("expected factor %v for both entities, got %v", expected, factors)
+	}
+
+	factors = Factors(usage, map[string]float64{"heavy": 3, "light": 1})
+	if factors["heavy"] <= factors["light"] {
+		t.Errorf("expected the entity with the higher weight to have the higher factor, got %v", factors)
+	}
+}
+
+func TestBlend(t *testing.T) {
+	// Equal usage keeps the order of the shares.
+	if Blend(0.2, 0.5) >= Blend(0.4, 0.5) {
+		t.Errorf("expected the lower share to go first with equal factors")
+	}
+	// Less historical usage goes first with equal shares.
+	if Blend(0.2, 0.9) >= Blend(0.2, 0.5) {
+		t.Errorf("expected the higher factor to go first with equal shares")
+	}
+	// An entity without historical usage keeps its share.
+	if Blend(0.2, 1) != 0.2 {
+		t.Errorf("expected share 0.2 for factor 1, got %v", Blend(0.2, 1))
+	}
+}
+
+func TestResourceListRoundTrip(t *testing.T) {
+	// A week of 16Ti memory overflows int64 bytes.
+	r := api.EmptyResource()
+	r.MilliCPU = 1500
+	r.Memory = 16 * math.Pow(2, 40) * 7 * 24 * 3600
+	r.AddScalar("nvidia.com/gpu", 4000)
+	r.AddScalar(v1.ResourcePods, 10)
+
+	rl := ToResourceList(r)
+	if _, found := rl[v1.ResourcePods]; found {
+		t.Errorf("expected pods not to be accounted, got %v", rl)
+	}
+	got := FromResourceList(rl)
+	if got.MilliCPU != r.MilliCPU || got.Get("nvidia.com/gpu") != 4000 || math.Abs(got.Memory-r.Memory)/r.Memory > 1e-9 {
+		t.Errorf("expected %v, got %v", r, got)
+	}
+}
+
+func TestUpdateUsage(t *testing.T) {
+	current := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	recent := &scheduling.QueueUsage{LastUpdateTime: metav1.NewTime(current.Add(-time.Second))}
+	stale := &scheduling.QueueUsage{LastUpdateTime: metav1.NewTime(current.Add(-time.Hour))}
+	ssn := &framework.Session{
+		Jobs: map[api.JobID]*api.JobInfo{},
+		Queues: map[api.QueueID]*api.QueueInfo{
+			"recent": {UID: "recent", Queue: &scheduling.Queue{Status: scheduling.QueueStatus{Usage: recent}}},
+			"stale":  {UID: "stale", Queue: &scheduling.Queue{Status: scheduling.QueueStatus{Usage: stale}}},
+		},
+		DirtyQueues: sets.New[api.QueueID](),
+	}
+
+	UpdateUsage(ssn, time.Hour, current)
+	if ssn.DirtyQueues.Has("recent") || ssn.Queues["recent"].Queue.Status.Usage != recent {
+		t.Errorf("expected usage updated less than %v ago to be kept", updatePeriod)
+	}
+	if !ssn.DirtyQueues.Has("stale") || !ssn.Queues["stale"].Queue.Status.Usage.LastUpdateTime.Time.Equal(current) {
+		t.Errorf("expected stale usage to be updated")
+	}
+}
+
+func TestUpdateUsageDryRun(t *testing.T) {
+	stale := &schedulingv1beta1.QueueUsage{LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Hour))}
+	queue := util.BuildQueue("q1", 1, nil)
+	queue.Status.Usage = stale
+	sc := cache.NewDefaultMockSchedulerCache("volcano")
+	sc.AddQueueV1beta1(queue)
+
+	ssn := framework.OpenDryRunSession(sc, nil, nil)
+	defer framework.CloseSession(ssn)
+	usage := ssn.Queues["q1"].Queue.Status.Usage
+
+	UpdateUsage(ssn, time.Hour, ssn.StartTime)
+	if ssn.DirtyQueues.Has("q1") || ssn.Queues["q1"].Queue.Status.Usage != usage {
+		t.Errorf("expected the usage not to be updated by a dry-run session")
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package victimcost
+
+import (
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "victimcost"
+
+	// RuntimeWeightKey is the argument key of the cost of one hour of work lost by evicting a victim.
+	RuntimeWeightKey = "runtimeWeight"
+	// GangWeightKey is the argument key of the cost of one hour of work lost by the other tasks of a gang
+	// which is broken by evicting a victim.
+	GangWeightKey = "gangWeight"
+	// PriorityWeightKey is the argument key of the cost of one unit of priority of a victim.
+	PriorityWeightKey = "priorityWeight"
+
+	// The factors of the victim cost.
+	runtimeFactor  = "runtime"
+	gangFactor     = "gang"
+	priorityFactor = "priority"
+)
+
+/*
+The plugin estimates how much computation evicting a set of victims destroys, preempt and gangpreempt then
+evict the cheapest set which makes room for the preemptor:
+
+	actions: "enqueue, allocate, preempt, backfill"
+	tiers:
+	- plugins:
+	  - name: priority
+	  - name: gang
+	  - name: victimcost
+	    arguments:
+	      runtimeWeight: 1
+	      gangWeight: 1
+	      priorityWeight: 0.001
+
+The work of a task is counted in hours since it started, or since its last checkpoint if it checkpoints.
+*/
+type victimCostPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	runtimeWeight  float64
+	gangWeight     float64
+	priorityWeight float64
+
+	clock clock.PassiveClock
+}
+
+// New return victimcost plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	vp := &victimCostPlugin{
+		pluginArguments: arguments,
+		runtimeWeight:   1,
+		gangWeight:      1,
+		priorityWeight:  0.001,
+		clock:           clock.RealClock{},
+	}
+	arguments.GetFloat64(&vp.runtimeWeight, RuntimeWeightKey)
+	arguments.GetFloat64(&vp.gangWeight, GangWeightKey)
+	arguments.GetFloat64(&vp.priorityWeight, PriorityWeightKey)
+	return vp
+}
+
+func (vp *victimCostPlugin) Name() string {
+	return PluginName
+}
+
+func (vp *victimCostPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	ssn.AddVictimCostFn(vp.Name(), func(_ *api.EvictionContext, victims []*api.TaskInfo) api.VictimCost {
+		return vp.cost(ssn.Jobs, victims)
+	})
+}
+
+func (vp *victimCostPlugin) OnSessionClose(_ *framework.Session) {}
+
+// cost returns the cost of evicting the victims together: the work they lose, the work lost by the other
+// tasks of the gangs they break, and their priority.
+func (vp *victimCostPlugin) cost(jobs map[api.JobID]*api.JobInfo, victims []*api.TaskInfo) api.VictimCost {
+	current := vp.clock.Now()
+	cost := api.VictimCost{runtimeFactor: 0, gangFactor: 0, priorityFactor: 0}
+
+	evicted := map[api.JobID]map[api.TaskID]bool{}
+	for _, victim := range victims {
+		if evicted[victim.Job] == nil {
+			evicted[victim.Job] = map[api.TaskID]bool{}
+		}
+		evicted[victim.Job][victim.UID] = true
+		cost[runtimeFactor] += vp.runtimeWeight * lostWork(victim, current)
+		cost[priorityFactor] += vp.priorityWeight * float64(max(victim.Priority, 0))
+	}
+
+	for jobID, tasks := range evicted {
+		job, found := jobs[jobID]
+		if !found {
+			continue
+		}
+		ready := job.ReadyTaskNum()
+		if ready < job.MinAvailable || ready-int32(len(tasks)) >= job.MinAvailable {
+			continue
+		}
+		// The gang is broken, the work of its other tasks is lost too.
+		for status, statusTasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range statusTasks {
+				if !tasks[task.UID] {
+					cost[gangFactor] += vp.gangWeight * lostWork(task, current)
+				}
+			}
+		}
+	}
+	return cost
+}
+
+// lostWork returns the hours of work the task loses if it is evicted now.
+func lostWork(task *api.TaskInfo, current time.Time) float64 {
+	if task.Pod == nil || task.Pod.Status.StartTime == nil {
+		return 0
+	}
+	since := task.Pod.Status.StartTime.Time
+	if checkpointed, found := api.GetPodLastCheckpointTime(task.Pod); found && checkpointed.After(since) {
+		since = checkpointed
+	}
+	if !current.After(since) {
+		return 0
+	}
+	return current.Sub(since).Hours()
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package victimcost
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestVictimCost(t *testing.T) {
+	current := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	buildTask := func(name, group string, running time.Duration, annotations map[string]string) *api.TaskInfo {
+		pod := util.BuildPod("ns1", name, "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), group, nil, nil)
+		pod.Status.StartTime = &metav1.Time{Time: current.Add(-running)}
+		for key, value := range annotations {
+			pod.Annotations[key] = value
+		}
+		return api.NewTaskInfo(pod)
+	}
+
+	// pg1 is a gang of three which breaks if any task is evicted, pg2 has a task to spare.
+	gang1, gang2, gang3 := buildTask("gang1", "pg1", 2*time.Hour, nil), buildTask("gang2", "pg1", 2*time.Hour, nil), buildTask("gang3", "pg1", time.Hour, nil)
+	checkpointed := buildTask("checkpointed", "pg2", 10*time.Hour, map[string]string{
+		v1beta1.PodLastCheckpointTime: current.Add(-30 * time.Minute).Format(time.RFC3339),
+	})
+	spare := buildTask("spare", "pg2", 4*time.Hour, nil)
+
+	job1 := api.NewJobInfo("ns1/pg1", gang1, gang2, gang3)
+	job1.MinAvailable = 3
+	job2 := api.NewJobInfo("ns1/pg2", checkpointed, spare)
+	job2.MinAvailable = 1
+	jobs := map[api.JobID]*api.JobInfo{job1.UID: job1, job2.UID: job2}
+
+	vp := New(framework.Arguments{PriorityWeightKey: 0.5}).(*victimCostPlugin)
+	vp.clock = testingclock.NewFakePassiveClock(current)
+
+	tests := []struct {
+		name     string
+		victims  []*api.TaskInfo
+		expected api.VictimCost
+	}{
+		{
+			name:     "work since the last checkpoint is lost",
+			victims:  []*api.TaskInfo{checkpointed},
+			expected: api.VictimCost{runtimeFactor: 0.5, gangFactor: 0, priorityFactor: 0.5},
+		},
+		{
+			name:     "evicting a task of a gang loses the work of the whole gang",
+			victims:  []*api.TaskInfo{gang3},
+			expected: api.VictimCost{runtimeFactor: 1, gangFactor: 4, priorityFactor: 0.5},
+		},
+		{
+			name:     "gang broken twice is lost once",
+			victims:  []*api.TaskInfo{gang1, gang3},
+			expected: api.VictimCost{runtimeFactor: 3, gangFactor: 2, priorityFactor: 1},
+		},
+		{
+			name:     "evicting the whole job loses only its own work",
+			victims:  []*api.TaskInfo{checkpointed, spare},
+			expected: api.VictimCost{runtimeFactor: 4.5, gangFactor: 0, priorityFactor: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := vp.cost(jobs, test.victims)
+			for factor, expected := range test.expected {
+				if math.Abs(got[factor]-expected) > 1e-9 {
+					t.Errorf("expected %s cost %v, got %v", factor, expected, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+/*
+ABI version 1 of the WASM plugins.
+
+The module exports its linear memory as "memory", and the functions:
+
+	volcano_abi_version() -> i32     must return 1
+	alloc(size i32) -> i32           returns a buffer of size bytes for the input of a hook
+
+and optionally:
+
+	free(ptr i32, size i32)          releases a buffer returned by alloc or by a hook
+	configure(ptr i32, len i32) -> i32
+	                                 receives the plugin arguments as a JSON object when the session opens,
+	                                 a non-zero result disables the plugin for the session
+
+Each hook receives a JSON document written at ptr with length len, and is registered only if it is exported:
+
+	job_order(ptr, len) -> i32       {"left": job, "right": job}, negative if left goes first
+	task_order(ptr, len) -> i32      {"left": task, "right": task}
+	queue_order(ptr, len) -> i32     {"left": queue, "right": queue}
+	job_enqueueable(ptr, len) -> i32 {"job": job, "queue": queue}, 1 to permit, -1 to reject, 0 to abstain
+	predicate(ptr, len) -> i64       {"task": task, "node": node}, 0 if the task fits the node, otherwise the
+	                                 buffer of the reason as ptr << 32 | len
+	node_order(ptr, len) -> f64      {"task": task, "node": node}, the score of the node
+
+The documents are the views below. Fields are only added within a version, so modules should ignore unknown ones.
+*/
+
+const (
+	// ABIVersion is the version of the ABI the host implements.
+	ABIVersion = 1
+
+	exportMemory         = "memory"
+	exportABIVersion     = "volcano_abi_version"
+	exportAlloc          = "alloc"
+	exportFree           = "free"
+	exportConfigure      = "configure"
+	exportJobOrder       = "job_order"
+	exportTaskOrder      = "task_order"
+	exportQueueOrder     = "queue_order"
+	exportJobEnqueueable = "job_enqueueable"
+	exportPredicate      = "predicate"
+	exportNodeOrder      = "node_order"
+)
+
+// resourceView holds milli cpu, memory in bytes, and the scalar resources in milli units by resource name.
+type resourceView map[string]float64
+
+type taskView struct {
+	UID         string            `json:"uid"`
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Job         string            `json:"job"`
+	Status      string            `json:"status"`
+	NodeName    string            `json:"nodeName,omitempty"`
+	Priority    int32             `json:"priority"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Resreq      resourceView      `json:"resreq"`
+}
+
+type nodeView struct {
+	Name        string            `json:"name"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Allocatable resourceView      `json:"allocatable"`
+	Idle        resourceView      `json:"idle"`
+	Used        resourceView      `json:"used"`
+	Tasks       int               `json:"tasks"`
+}
+
+type jobView struct {
+	UID          string       `json:"uid"`
+	Name         string       `json:"name"`
+	Namespace    string       `json:"namespace"`
+	Queue        string       `json:"queue"`
+	Priority     int32        `json:"priority"`
+	MinAvailable int32        `json:"minAvailable"`
+	CreationTime int64        `json:"creationTime"`
+	Allocated    resourceView `json:"allocated"`
+	TotalRequest resourceView `json:"totalRequest"`
+}
+
+type queueView struct {
+	UID        string       `json:"uid"`
+	Name       string       `json:"name"`
+	Weight     int32        `json:"weight"`
+	Capability resourceView `json:"capability,omitempty"`
+}
+
+type compareInput[T any] struct {
+	Left  T `json:"left"`
+	Right T `json:"right"`
+}
+
+type enqueueInput struct {
+	Job   jobView    `json:"job"`
+	Queue *queueView `json:"queue,omitempty"`
+}
+
+type nodeInput struct {
+	Task taskView `json:"task"`
+	Node nodeView `json:"node"`
+}
+
+func newResourceView(resource *api.Resource) resourceView {
+	if resource == nil {
+		return resourceView{}
+	}
+	view := resourceView{
+		string(corev1.ResourceCPU):    resource.MilliCPU,
+		string(corev1.ResourceMemory): resource.Memory,
+	}
+	for name, quantity := range resource.ScalarResources {
+		view[string(name)] = quantity
+	}
+	return view
+}
+
+func newTaskView(task *api.TaskInfo) taskView {
+	view := taskView{
+		UID:       string(task.UID),
+		Name:      task.Name,
+		Namespace: task.Namespace,
+		Job:       string(task.Job),
+		Status:    task.Status.String(),
+		NodeName:  task.NodeName,
+		Priority:  task.Priority,
+		Resreq:    newResourceView(task.Resreq),
+	}
+	if task.Pod != nil {
+		view.Labels = task.Pod.Labels
+		view.Annotations = task.Pod.Annotations
+	}
+	return view
+}
+
+func newNodeView(node *api.NodeInfo) nodeView {
+	view := nodeView{
+		Name:        node.Name,
+		Allocatable: newResourceView(node.Allocatable),
+		Idle:        newResourceView(node.Idle),
+		Used:        newResourceView(node.Used),
+		Tasks:       len(node.Tasks),
+	}
+	if node.Node != nil {
+		view.Labels = node.Node.Labels
+		view.Annotations = node.Node.Annotations
+	}
+	return view
+}
+
+func newJobView(job *api.JobInfo) jobView {
+	return jobView{
+		UID:          string(job.UID),
+		Name:         job.Name,
+		Namespace:    job.Namespace,
+		Queue:        string(job.Queue),
+		Priority:     job.Priority,
+		MinAvailable: job.MinAvailable,
+		CreationTime: job.CreationTimestamp.Unix(),
+		Allocated:    newResourceView(job.Allocated),
+		TotalRequest: newResourceView(job.TotalRequest),
+	}
+}
+
+func newQueueView(queue *api.QueueInfo) queueView {
+	view := queueView{
+		UID:    string(queue.UID),
+		Name:   queue.Name,
+		Weight: queue.Weight,
+	}
+	if queue.Queue != nil && queue.Queue.Spec.Capability != nil {
+		view.Capability = newResourceView(api.NewResource(queue.Queue.Spec.Capability))
+	}
+	return view
+}
@@ -0,0 +1,121 @@
+;; plugin.wasm is assembled from this file with `wat2wasm plugin.wat -o plugin.wasm`.
+;;
+;; The module rejects the nodes whose view contains "blocked", scores the nodes by the length of the
+;; input, refuses to be configured with arguments containing "blocked", and never returns from task_order.
+(module
+  (memory (export "memory") 1)
+  (global $heap (mut i32) (i32.const 1024))
+  (data (i32.const 16) "node is blocked")
+  (data (i32.const 32) "blocked")
+
+  (func (export "volcano_abi_version") (result i32)
+    i32.const 1)
+
+  ;; alloc is a bump allocator, the memory is released when the instance is dropped.
+  (func (export "alloc") (param $size i32) (result i32)
+    (local $ptr i32)
+    global.get $heap
+    local.set $ptr
+    global.get $heap
+    local.get $size
+    i32.add
+    global.set $heap
+    block
+      global.get $heap
+      memory.size
+      i32.const 16
+      i32.shl
+      i32.le_u
+      br_if 0
+      global.get $heap
+      memory.size
+      i32.const 16
+      i32.shl
+      i32.sub
+      i32.const 16
+      i32.shr_u
+      i32.const 1
+      i32.add
+      memory.grow
+      i32.const -1
+      i32.ne
+      br_if 0
+      unreachable
+    end
+    local.get $ptr)
+
+  ;; contains returns 1 if the buffer contains "blocked".
+  (func $contains (param $ptr i32) (param $len i32) (result i32)
+    (local $i i32) (local $j i32)
+    block
+      loop
+        local.get $i
+        i32.const 7
+        i32.add
+        local.get $len
+        i32.gt_u
+        br_if 1
+        i32.const 0
+        local.set $j
+        block
+          loop
+            local.get $j
+            i32.const 7
+            i32.eq
+            if
+              i32.const 1
+              return
+            end
+            local.get $ptr
+            local.get $i
+            i32.add
+            local.get $j
+            i32.add
+            i32.load8_u
+            i32.const 32
+            local.get $j
+            i32.add
+            i32.load8_u
+            i32.ne
+            br_if 1
+            local.get $j
+            i32.const 1
+            i32.add
+            local.set $j
+            br 0
+          end
+        end
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br 0
+      end
+    end
+    i32.const 0)
+
+  (func (export "configure") (param $ptr i32) (param $len i32) (result i32)
+    local.get $ptr
+    local.get $len
+    call $contains)
+
+  (func (export "predicate") (param $ptr i32) (param $len i32) (result i64)
+    local.get $ptr
+    local.get $len
+    call $contains
+    if
+      ;; "node is blocked" at 16 with length 15
+      i64.const 68719476751
+      return
+    end
+    i64.const 0)
+
+  (func (export "node_order") (param $ptr i32) (param $len i32) (result f64)
+    local.get $len
+    f64.convert_i32_u)
+
+  (func (export "task_order") (param $ptr i32) (param $len i32) (result i32)
+    loop
+      br 0
+    end
+    i32.const 0))
@@ -0,0 +1,442 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	wazeroapi "github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// Extension is the file extension of the WASM plugins in the plugins directory.
+	Extension = ".wasm"
+
+	// CallTimeoutKey is the argument key of the time limit of each call to the module.
+	CallTimeoutKey = "wasm.callTimeout"
+	// StartupTimeoutKey is the argument key of the time limit of instantiating and configuring the module.
+	StartupTimeoutKey = "wasm.startupTimeout"
+	// MemoryLimitKey is the argument key of the limit of the memory of the module, in MiB.
+	MemoryLimitKey = "wasm.memoryLimit"
+
+	defaultCallTimeout = 10 * time.Millisecond
+	// Instantiating the module runs its start function and configure, which do more than a hook.
+	defaultStartupTimeout = time.Second
+	defaultMemoryLimit    = 64
+	// A memory page of WASM is 64KiB.
+	pagesPerMiB = 16
+)
+
+/*
+A WASM plugin is a module in the plugins directory, named after the file without the extension, e.g.
+the plugins directory holds custom-score.wasm:
+
+	actions: "enqueue, allocate, backfill"
+	tiers:
+	- plugins:
+	  - name: priority
+	  - name: gang
+	- plugins:
+	  - name: predicates
+	  - name: custom-score
+	    arguments:
+	      wasm.callTimeout: 10ms
+	      wasm.startupTimeout: 1s
+	      wasm.memoryLimit: 64
+	      weight: 2
+
+The arguments are passed to the configure function of the module. A call which traps or exceeds its time
+limit fails, and the module is instantiated again with a fresh memory for the next call.
+*/
+type wasmPlugin struct {
+	module *module
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	callTimeout      time.Duration
+	startupTimeout   time.Duration
+	memoryLimitPages uint32
+
+	// The module handles one call at a time.
+	mutex    sync.Mutex
+	runtime  *compiledModule
+	instance wazeroapi.Module
+}
+
+// module is a WASM module loaded from the plugins directory, compiled once for each memory limit.
+type module struct {
+	name   string
+	binary []byte
+
+	mutex    sync.Mutex
+	compiled map[uint32]*compiledModule
+}
+
+type compiledModule struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+}
+
+// Load reads the WASM module of the file, and returns the builder of its plugin.
+func Load(pluginPath string) (framework.PluginBuilder, error) {
+	binary, err := os.ReadFile(pluginPath)
+	if err != nil {
+		return nil, err
+	}
+	m := &module{
+		name:     strings.TrimSuffix(filepath.Base(pluginPath), Extension),
+		binary:   binary,
+		compiled: map[uint32]*compiledModule{},
+	}
+	// Compile with the default limit to report a broken module at start up.
+	if _, err := m.compile(defaultMemoryLimit * pagesPerMiB); err != nil {
+		return nil, fmt.Errorf("failed to compile WASM plugin %s: %v", pluginPath, err)
+	}
+	return m.New, nil
+}
+
+func (m *module) compile(memoryLimitPages uint32) (*compiledModule, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if cm, found := m.compiled[memoryLimitPages]; found {
+		return cm, nil
+	}
+
+	ctx := context.Background()
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(memoryLimitPages).
+		WithCloseOnContextDone(true))
+	// Modules built for WASI import it even if they do not use it.
+	wasi_snapshot_preview1.MustInstantiate(ctx, runtime)
+
+	compiled, err := runtime.CompileModule(ctx, m.binary)
+	if err != nil {
+		runtime.Close(ctx)
+		return nil, err
+	}
+	for _, name := range []string{exportABIVersion, exportAlloc} {
+		if _, found := compiled.ExportedFunctions()[name]; !found {
+			runtime.Close(ctx)
+			return nil, fmt.Errorf("function %s is not exported", name)
+		}
+	}
+
+	cm := &compiledModule{runtime: runtime, compiled: compiled}
+	m.compiled[memoryLimitPages] = cm
+	return cm, nil
+}
+
+// New return the plugin of the module
+func (m *module) New(arguments framework.Arguments) framework.Plugin {
+	wp := &wasmPlugin{
+		module:           m,
+		pluginArguments:  arguments,
+		callTimeout:      defaultCallTimeout,
+		startupTimeout:   defaultStartupTimeout,
+		memoryLimitPages: defaultMemoryLimit * pagesPerMiB,
+	}
+	if callTimeout, _ := arguments[CallTimeoutKey].(string); callTimeout != "" {
+		if duration, err := time.ParseDuration(callTimeout); err == nil && duration > 0 {
+			wp.callTimeout = duration
+		}
+	}
+	if startupTimeout, _ := arguments[StartupTimeoutKey].(string); startupTimeout != "" {
+		if duration, err := time.ParseDuration(startupTimeout); err == nil && duration > 0 {
+			wp.startupTimeout = duration
+		}
+	}
+	memoryLimit := defaultMemoryLimit
+	arguments.GetInt(&memoryLimit, MemoryLimitKey)
+	if memoryLimit > 0 {
+		wp.memoryLimitPages = uint32(memoryLimit) * pagesPerMiB
+	}
+	return wp
+}
+
+func (wp *wasmPlugin) Name() string {
+	return wp.module.name
+}
+
+func (wp *wasmPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", wp.Name())
+	defer klog.V(5).Infof("Leaving %s plugin.", wp.Name())
+
+	runtime, err := wp.module.compile(wp.memoryLimitPages)
+	if err != nil {
+		klog.Errorf("Failed to compile WASM plugin %s: %v", wp.Name(), err)
+		return
+	}
+	wp.runtime = runtime
+	if err := wp.instantiate(); err != nil {
+		klog.Errorf("Failed to instantiate WASM plugin %s, it is disabled in this session: %v", wp.Name(), err)
+		return
+	}
+
+	exports := runtime.compiled.ExportedFunctions()
+	exported := func(name string) bool {
+		_, found := exports[name]
+		return found
+	}
+
+	if exported(exportJobOrder) {
+		ssn.AddJobOrderFn(wp.Name(), func(l, r interface{}) int {
+			return wp.compare(exportJobOrder, compareInput[jobView]{
+				Left:  newJobView(l.(*api.JobInfo)),
+				Right: newJobView(r.(*api.JobInfo)),
+			})
+		})
+	}
+
+	if exported(exportTaskOrder) {
+		ssn.AddTaskOrderFn(wp.Name(), func(l, r interface{}) int {
+			return wp.compare(exportTaskOrder, compareInput[taskView]{
+				Left:  newTaskView(l.(*api.TaskInfo)),
+				Right: newTaskView(r.(*api.TaskInfo)),
+			})
+		})
+	}
+
+	if exported(exportQueueOrder) {
+		ssn.AddQueueOrderFn(wp.Name(), func(l, r interface{}) int {
+			return wp.compare(exportQueueOrder, compareInput[queueView]{
+				Left:  newQueueView(l.(*api.QueueInfo)),
+				Right: newQueueView(r.(*api.QueueInfo)),
+			})
+		})
+	}
+
+	if exported(exportJobEnqueueable) {
+		ssn.AddJobEnqueueableFn(wp.Name(), func(obj interface{}) int {
+			job := obj.(*api.JobInfo)
+			input := enqueueInput{Job: newJobView(job)}
+			if queue, found := ssn.Queues[job.Queue]; found {
+				view := newQueueView(queue)
+				input.Queue = &view
+			}
+			result, err := wp.call(exportJobEnqueueable, input)
+			if err != nil {
+				klog.Warningf("WASM plugin %s failed to vote for job <%s/%s>: %v", wp.Name(), job.Namespace, job.Name, err)
+				return 0
+			}
+			return int(int32(uint32(result)))
+		})
+	}
+
+	if exported(exportPredicate) {
+		ssn.AddPredicateFn(wp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+			reason, err := wp.predicate(newTaskView(task), newNodeView(node))
+			if err != nil {
+				return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Error, Reason: err.Error(), Plugin: wp.Name()})
+			}
+			if reason != "" {
+				return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Unschedulable, Reason: reason, Plugin: wp.Name()})
+			}
+			return nil
+		})
+	}
+
+	if exported(exportNodeOrder) {
+		ssn.AddNodeOrderFn(wp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+			result, err := wp.call(exportNodeOrder, nodeInput{Task: newTaskView(task), Node: newNodeView(node)})
+			if err != nil {
+				klog.Warningf("WASM plugin %s failed to score node %s for task <%s/%s>: %v", wp.Name(), node.Name, task.Namespace, task.Name, err)
+				return 0, nil
+			}
+			return wazeroapi.DecodeF64(result), nil
+		})
+	}
+}
+
+func (wp *wasmPlugin) OnSessionClose(_ *framework.Session) {
+	wp.mutex.Lock()
+	defer wp.mutex.Unlock()
+	wp.close()
+}
+
+// instantiate creates an instance of the module with a fresh memory, and configures it with the arguments.
+// It's limited by the startup timeout rather than the call timeout of the hooks.
+func (wp *wasmPlugin) instantiate() error {
+	ctx, cancel := context.WithTimeout(context.Background(), wp.startupTimeout)
+	defer cancel()
+
+	instance, err := wp.runtime.runtime.InstantiateModule(ctx, wp.runtime.compiled,
+		wazero.NewModuleConfig().WithName("").WithStartFunctions("_initialize"))
+	if err != nil {
+		return err
+	}
+	wp.instance = instance
+
+	results, err := instance.ExportedFunction(exportABIVersion).Call(ctx)
+	if err != nil {
+		wp.close()
+		return err
+	}
+	if version := int32(uint32(results[0])); version != ABIVersion {
+		wp.close()
+		return fmt.Errorf("ABI version %d is not supported, expected %d", version, ABIVersion)
+	}
+
+	if configure := instance.ExportedFunction(exportConfigure); configure != nil {
+		arguments, err := json.Marshal(wp.pluginArguments)
+		if err != nil {
+			wp.close()
+			return err
+		}
+		ptr, err := wp.write(ctx, arguments)
+		if err != nil {
+			wp.close()
+			return err
+		}
+		results, err := configure.Call(ctx, uint64(ptr), uint64(len(arguments)))
+		if err != nil {
+			wp.close()
+			return err
+		}
+		wp.free(ctx, ptr, uint32(len(arguments)))
+		if code := int32(uint32(results[0])); code != 0 {
+			wp.close()
+			return fmt.Errorf("configure returned %d", code)
+		}
+	}
+	return nil
+}
+
+func (wp *wasmPlugin) close() {
+	if wp.instance == nil {
+		return
+	}
+	if err := wp.instance.Close(context.Background()); err != nil {
+		klog.V(4).Infof("Failed to close WASM plugin %s: %v", wp.Name(), err)
+	}
+	wp.instance = nil
+}
+
+// write copies the data into a buffer allocated by the module.
+func (wp *wasmPlugin) write(ctx context.Context, data []byte) (uint32, error) {
+	results, err := wp.instance.ExportedFunction(exportAlloc).Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, err
+	}
+	ptr := uint32(results[0])
+	if !wp.instance.Memory().Write(ptr, data) {
+		return 0, fmt.Errorf("buffer %d of %d bytes is out of the memory", ptr, len(data))
+	}
+	return ptr, nil
+}
+
+func (wp *wasmPlugin) free(ctx context.Context, ptr, size uint32) {
+	if free := wp.instance.ExportedFunction(exportFree); free != nil {
+		if _, err := free.Call(ctx, uint64(ptr), uint64(size)); err != nil {
+			klog.V(4).Infof("Failed to free the buffer of WASM plugin %s: %v", wp.Name(), err)
+		}
+	}
+}
+
+// call passes the input as JSON to the hook, and returns its result. The instance is dropped if the call
+// fails, so that the next call runs in a fresh one.
+func (wp *wasmPlugin) call(hook string, input interface{}) (uint64, error) {
+	result, _, err := wp.callWith(hook, input, nil)
+	return result, err
+}
+
+func (wp *wasmPlugin) callWith(hook string, input interface{}, output func(ctx context.Context, result uint64) (string, error)) (uint64, string, error) {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return 0, "", err
+	}
+
+	wp.mutex.Lock()
+	defer wp.mutex.Unlock()
+
+	if wp.runtime == nil {
+		return 0, "", fmt.Errorf("WASM plugin %s is not compiled", wp.Name())
+	}
+	if wp.instance == nil {
+		if err := wp.instantiate(); err != nil {
+			return 0, "", err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), wp.callTimeout)
+	defer cancel()
+
+	ptr, err := wp.write(ctx, data)
+	if err != nil {
+		wp.close()
+		return 0, "", err
+	}
+	results, err := wp.instance.ExportedFunction(hook).Call(ctx, uint64(ptr), uint64(len(data)))
+	if err != nil {
+		wp.close()
+		return 0, "", err
+	}
+	wp.free(ctx, ptr, uint32(len(data)))
+
+	var out string
+	if output != nil {
+		if out, err = output(ctx, results[0]); err != nil {
+			wp.close()
+			return 0, "", err
+		}
+	}
+	return results[0], out, nil
+}
+
+func (wp *wasmPlugin) compare(hook string, input interface{}) int {
+	result, err := wp.call(hook, input)
+	if err != nil {
+		klog.Warningf("WASM plugin %s failed to compare in %s: %v", wp.Name(), hook, err)
+		return 0
+	}
+	return int(int32(uint32(result)))
+}
+
+// predicate returns the reason why the task does not fit the node, empty if it fits.
+func (wp *wasmPlugin) predicate(task taskView, node nodeView) (string, error) {
+	_, reason, err := wp.callWith(exportPredicate, nodeInput{Task: task, Node: node}, func(ctx context.Context, result uint64) (string, error) {
+		if result == 0 {
+			return "", nil
+		}
+		ptr, size := uint32(result>>32), uint32(result)
+		reason, ok := wp.instance.Memory().Read(ptr, size)
+		if !ok {
+			return "", fmt.Errorf("reason %d of %d bytes is out of the memory", ptr, size)
+		}
+		// The memory may be reused once the buffer is freed.
+		reasonCopy := string(reason)
+		wp.free(ctx, ptr, size)
+		if reasonCopy == "" {
+			reasonCopy = "node(s) rejected by " + wp.Name()
+		}
+		return reasonCopy, nil
+	})
+	return reason, err
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	wazeroapi "github.com/tetratelabs/wazero/api"
+	v1 "k8s.io/api/core/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// newTestPlugin loads testdata/plugin.wasm, see testdata/plugin.wat for what it does.
+func newTestPlugin(t *testing.T, arguments framework.Arguments) *wasmPlugin {
+	builder, err := Load("testdata/plugin.wasm")
+	if err != nil {
+		t.Fatalf("failed to load the plugin: %v", err)
+	}
+	wp := builder(arguments).(*wasmPlugin)
+	if wp.Name() != "plugin" {
+		t.Fatalf("expected the plugin to be named after the file, got %s", wp.Name())
+	}
+	if wp.runtime, err = wp.module.compile(wp.memoryLimitPages); err != nil {
+		t.Fatalf("failed to compile the plugin: %v", err)
+	}
+	return wp
+}
+
+func TestWASMPlugin(t *testing.T) {
+	wp := newTestPlugin(t, framework.Arguments{"weight": 2})
+	if err := wp.instantiate(); err != nil {
+		t.Fatalf("failed to instantiate the plugin: %v", err)
+	}
+	defer wp.OnSessionClose(nil)
+
+	task := api.NewTaskInfo(util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil))
+	node := api.NewNodeInfo(util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+	blocked := api.NewNodeInfo(util.BuildNode("n2", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"state": "blocked"}))
+
+	reason, err := wp.predicate(newTaskView(task), newNodeView(node))
+	if err != nil || reason != "" {
+		t.Errorf("expected the task to fit n1, got %q, %v", reason, err)
+	}
+	reason, err = wp.predicate(newTaskView(task), newNodeView(blocked))
+	if err != nil || reason != "node is blocked" {
+		t.Errorf("expected n2 to be blocked, got %q, %v", reason, err)
+	}
+
+	input := nodeInput{Task: newTaskView(task), Node: newNodeView(node)}
+	data, _ := json.Marshal(input)
+	result, err := wp.call(exportNodeOrder, input)
+	if err != nil {
+		t.Fatalf("failed to score n1: %v", err)
+	}
+	if score := wazeroapi.DecodeF64(result); score != float64(len(data)) {
+		t.Errorf("expected score %d, got %v", len(data), score)
+	}
+}
+
+func TestWASMPluginCallTimeout(t *testing.T) {
+	wp := newTestPlugin(t, framework.Arguments{CallTimeoutKey: "5ms"})
+	if err := wp.instantiate(); err != nil {
+		t.Fatalf("failed to instantiate the plugin: %v", err)
+	}
+	defer wp.OnSessionClose(nil)
+
+	task := newTaskView(api.NewTaskInfo(util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)))
+	if order := wp.compare(exportTaskOrder, compareInput[taskView]{Left: task, Right: task}); order != 0 {
+		t.Errorf("expected a call which times out to abstain, got %d", order)
+	}
+	if wp.instance != nil {
+		t.Errorf("expected the instance to be dropped after the timeout")
+	}
+
+	// The next call runs in a fresh instance.
+	node := newNodeView(api.NewNodeInfo(util.BuildNode("n1", api.BuildResourceList("4", "4Gi"), nil)))
+	if reason, err := wp.predicate(task, node); err != nil || reason != "" {
+		t.Errorf("expected the plugin to recover, got %q, %v", reason, err)
+	}
+}
+
+func TestWASMPluginStartupTimeout(t *testing.T) {
+	// The call timeout doesn't limit instantiating and configuring the module.
+	wp := newTestPlugin(t, framework.Arguments{CallTimeoutKey: "1ns", "weight": 2})
+	if err := wp.instantiate(); err != nil {
+		t.Fatalf("failed to instantiate the plugin: %v", err)
+	}
+	wp.OnSessionClose(nil)
+
+	wp = newTestPlugin(t, framework.Arguments{StartupTimeoutKey: "1ns", "weight": 2})
+	if err := wp.instantiate(); err == nil {
+		wp.OnSessionClose(nil)
+		t.Errorf("expected the startup to exceed its time limit")
+	}
+}
+
+func TestWASMPluginLimits(t *testing.T) {
+	wp := newTestPlugin(t, framework.Arguments{"mode": "blocked"})
+	if err := wp.instantiate(); err == nil {
+		t.Errorf("expected configure to reject the arguments")
+	}
+
+	wp = newTestPlugin(t, framework.Arguments{MemoryLimitKey: 1})
+	if err := wp.instantiate(); err != nil {
+		t.Fatalf("failed to instantiate the plugin: %v", err)
+	}
+	defer wp.OnSessionClose(nil)
+
+	// The input of 2MiB cannot be allocated in the memory of 1MiB.
+	task := newTaskView(api.NewTaskInfo(util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)))
+	task.Annotations = map[string]string{"padding": strings.Repeat("x", 2<<20)}
+	node := newNodeView(api.NewNodeInfo(util.BuildNode("n1", api.BuildResourceList("4", "4Gi"), nil)))
+	if _, err := wp.predicate(task, node); err == nil {
+		t.Errorf("expected the call to exceed the memory limit")
+	}
+}
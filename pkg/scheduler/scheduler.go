@@ -30,6 +30,8 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/rest"
@@ -38,6 +40,7 @@ import (
 	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/features"
 	"volcano.sh/volcano/pkg/filewatcher"
+	"volcano.sh/volcano/pkg/scheduler/api"
 	schedcache "volcano.sh/volcano/pkg/scheduler/cache"
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
@@ -54,8 +57,12 @@ type Scheduler struct {
 	fileWatcher    filewatcher.FileWatcher
 	schedulePeriod time.Duration
 	once           sync.Once
+	// minSessionInterval is the minimum time between the starts of two sessions when a session is triggered.
+	minSessionInterval time.Duration
 
-	mutex              sync.Mutex
+	mutex sync.Mutex
+	// loadedConf is the scheduler configuration the actions, plugins and configurations are loaded from.
+	loadedConf         string
 	actions            []framework.Action
 	plugins            []conf.Tier
 	configurations     []conf.Configuration
@@ -65,6 +72,14 @@ type Scheduler struct {
 
 	// schGateManager is used for async scheduling gate removal.
 	schGateManager *gate.SchGateManager
+
+	// decisionTracer records the scheduling decisions of recent sessions, nil if decision trace is disabled.
+	decisionTracer *framework.DecisionTracer
+	// nodeScoresKept is the number of the highest scores of the nodes kept for the binding decision of a task,
+	// 0 if the decision log is disabled.
+	nodeScoresKept int
+	// enableQueuePosition sets the position of the waiting podgroups in their queue on their status.
+	enableQueuePosition bool
 }
 
 // NewScheduler returns a Scheduler
@@ -81,12 +96,21 @@ func NewScheduler(config *rest.Config, opt *options.ServerOption) (*Scheduler, e
 
 	cache := schedcache.New(config, opt.SchedulerNames, opt.DefaultQueue, opt.NodeSelector, opt.NodeWorkerThreads, opt.IgnoredCSIProvisioners, opt.ResyncPeriod, opt.ResourceSyncTimeout)
 	scheduler := &Scheduler{
-		schedulerConf:      opt.SchedulerConf,
-		fileWatcher:        watcher,
-		cache:              cache,
-		schedulePeriod:     opt.SchedulePeriod,
-		dumper:             schedcache.Dumper{Cache: cache, RootDir: opt.CacheDumpFileDir},
-		disableDefaultConf: opt.DisableDefaultSchedulerConfig,
+		schedulerConf:       opt.SchedulerConf,
+		fileWatcher:         watcher,
+		cache:               cache,
+		schedulePeriod:      opt.SchedulePeriod,
+		minSessionInterval:  opt.MinSessionInterval,
+		dumper:              schedcache.Dumper{Cache: cache, RootDir: opt.CacheDumpFileDir},
+		disableDefaultConf:  opt.DisableDefaultSchedulerConfig,
+		enableQueuePosition: opt.EnableQueuePosition,
+	}
+	if opt.EnableDecisionTrace {
+		scheduler.decisionTracer = framework.NewDecisionTracer(opt.DecisionTraceSessions)
+	}
+	if opt.DecisionLogSink != "" {
+		// One more than the alternatives, which are still enough once the chosen node is taken out.
+		scheduler.nodeScoresKept = opt.DecisionLogTopNodes + 1
 	}
 
 	return scheduler, nil
@@ -112,7 +136,11 @@ func (pc *Scheduler) Run(stopCh <-chan struct{}) {
 	pc.cache.SetMetricsConf(pc.metricsConf)
 	pc.cache.Run(stopCh)
 	klog.V(2).Infof("Scheduler completes Initialization and start to run")
-	go wait.Until(pc.runOnce, pc.schedulePeriod, stopCh)
+	if trigger := pc.cache.SessionTrigger(); trigger != nil {
+		go pc.runTriggered(trigger, stopCh)
+	} else {
+		go wait.Until(pc.runOnce, pc.schedulePeriod, stopCh)
+	}
 	if options.ServerOpts.EnableCacheDumper {
 		pc.dumper.ListenForSignal(stopCh)
 	}
@@ -132,14 +160,11 @@ func (pc *Scheduler) runOnce() {
 	configurations := pc.configurations
 	pc.mutex.Unlock()
 
-	// Load ConfigMap to check which action is enabled.
-	conf.EnabledActionMap = make(map[string]bool)
-	for _, action := range actions {
-		conf.EnabledActionMap[action.Name()] = true
-	}
-
 	ssn := framework.OpenSession(pc.cache, plugins, configurations)
 	ssn.SetSchGateManager(pc.schGateManager)
+	ssn.SetDecisionTracer(pc.decisionTracer)
+	ssn.KeepNodeScores(pc.nodeScoresKept)
+	ssn.EnableQueuePosition(pc.enableQueuePosition)
 	defer func() {
 		framework.CloseSession(ssn)
 		metrics.UpdateE2eDuration(metrics.Duration(scheduleStartTime))
@@ -152,6 +177,80 @@ func (pc *Scheduler) runOnce() {
 	}
 }
 
+// runTriggered executes a scheduling cycle every schedule period, or once the cache triggers a session, but not
+// sooner than the minimum session interval after the start of the previous one.
+func (pc *Scheduler) runTriggered(trigger <-chan struct{}, stopCh <-chan struct{}) {
+	for {
+		start := time.Now()
+		pc.runOnce()
+
+		period := time.NewTimer(pc.schedulePeriod)
+		select {
+		case <-stopCh:
+			period.Stop()
+			return
+		case <-period.C:
+			continue
+		case <-trigger:
+			period.Stop()
+		}
+
+		klog.V(4).Infof("Session is triggered ahead of the schedule period")
+		metrics.RegisterTriggeredSession()
+		interval := time.NewTimer(pc.minSessionInterval - time.Since(start))
+		select {
+		case <-stopCh:
+			interval.Stop()
+			return
+		case <-interval.C:
+		}
+	}
+}
+
+// DecisionTracer returns the tracer of scheduling decisions, nil if decision trace is disabled.
+func (pc *Scheduler) DecisionTracer() *framework.DecisionTracer {
+	return pc.decisionTracer
+}
+
+// Snapshot returns a snapshot of the scheduler cache.
+func (pc *Scheduler) Snapshot() *api.ClusterInfo {
+	return pc.cache.Snapshot()
+}
+
+// ReleaseSnapshot hands back a snapshot returned by Snapshot to the scheduler cache.
+func (pc *Scheduler) ReleaseSnapshot(snapshot *api.ClusterInfo) {
+	pc.cache.ReleaseSnapshot(snapshot)
+}
+
+// PriorityClasses returns the priority classes known by the scheduler cache.
+func (pc *Scheduler) PriorityClasses() []*schedulingv1.PriorityClass {
+	priorityClasses, err := pc.cache.SharedInformerFactory().Scheduling().V1().PriorityClasses().Lister().List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list priority classes: %v", err)
+	}
+	return priorityClasses
+}
+
+// SchedulerConf returns the scheduler configuration currently loaded by the scheduler. The actions keep their
+// state between sessions, so others must not run the actions of the scheduler but their own ones, loaded from
+// the configuration.
+func (pc *Scheduler) SchedulerConf() string {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+	return pc.loadedConf
+}
+
+// enableActions records which actions are configured in conf.EnabledActionMap. It is only called when the
+// configuration is loaded, as the sessions of the what-if requests read it concurrently with the sessions of
+// the scheduler.
+func enableActions(actions []framework.Action) {
+	enabledActions := make(map[string]bool, len(actions))
+	for _, action := range actions {
+		enabledActions[action.Name()] = true
+	}
+	conf.EnabledActionMap = enabledActions
+}
+
 // logLoadedSchedulerConf logs the scheduler configuration that was actually
 // applied, line by line, to facilitate debugging.
 func logLoadedSchedulerConf(confStr string) {
@@ -175,6 +274,8 @@ func (pc *Scheduler) loadSchedulerConf() {
 			if err != nil {
 				klog.Fatalf("Invalid default configuration: unmarshal Scheduler config %s failed: %v", DefaultSchedulerConf, err)
 			}
+			pc.loadedConf = DefaultSchedulerConf
+			enableActions(pc.actions)
 			logLoadedSchedulerConf(DefaultSchedulerConf)
 		})
 	}
@@ -203,10 +304,12 @@ func (pc *Scheduler) loadSchedulerConf() {
 	}
 
 	pc.mutex.Lock()
+	pc.loadedConf = config
 	pc.actions = actions
 	pc.plugins = plugins
 	pc.configurations = configurations
 	pc.metricsConf = metricsConf
+	enableActions(actions)
 	pc.mutex.Unlock()
 	logLoadedSchedulerConf(config)
 }
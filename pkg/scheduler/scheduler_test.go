@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/simulator"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const schedulerConf = `
+actions: "enqueue, allocate, backfill"
+configurations:
+- name: allocate
+  arguments:
+    sessionBudget: 1s
+tiers:
+- plugins:
+  - name: priority
+  - name: gang
+- plugins:
+  - name: predicates
+  - name: proportion
+  - name: nodeorder
+`
+
+// TestWhatIfNextToSession runs what-if requests while the scheduler runs its sessions, the race detector tells
+// if they share any state.
+func TestWhatIfNextToSession(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "scheduler.conf")
+	if err := os.WriteFile(confFile, []byte(schedulerConf), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	sc := cache.NewDefaultMockSchedulerCache("volcano")
+	sc.AddOrUpdateNode(util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+	sc.AddQueueV1beta1(util.BuildQueue("q1", 1, nil))
+	// The job does not fit, so every session allocates it again.
+	sc.AddPodGroupV1beta1(util.BuildPodGroup("pg1", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupInqueue))
+	for _, name := range []string{"p1", "p2"} {
+		sc.AddPod(util.BuildPod("c1", name, "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg1", nil, nil))
+	}
+	pc := scheduler.NewSchedulerForTest(sc, confFile)
+
+	body, err := json.Marshal(&simulator.WhatIfRequest{
+		PodGroup: util.BuildPodGroup("pg2", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupPending),
+		Pods:     []*v1.Pod{util.BuildPod("c1", "p3", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2", nil, nil)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := simulator.NewWhatIfHandler(pc)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 5; i++ {
+			pc.RunOnce()
+		}
+	}()
+	for i := 0; i < 5; i++ {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/debug/whatif", bytes.NewReader(body)))
+		if recorder.Code != http.StatusOK {
+			t.Errorf("unexpected status %d: %s", recorder.Code, recorder.Body.String())
+		}
+	}
+	wg.Wait()
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	schedulingscheme "volcano.sh/apis/pkg/apis/scheduling/scheme"
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
+	"volcano.sh/volcano/pkg/scheduler"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// SchedulerName is the scheduler name used by the simulated scheduler cache.
+const SchedulerName = "volcano-simulator"
+
+func init() {
+	metrics.InitKubeSchedulerRelatedMetrics()
+}
+
+// Decision is a single placement decision made in the simulated session.
+type Decision struct {
+	// Task is the namespace/name of the pod.
+	Task string `json:"task"`
+	// Job is the id of the job the pod belongs to.
+	Job string `json:"job"`
+	// Node is the node the pod is bound, pipelined or evicted from.
+	Node string `json:"node"`
+}
+
+// PendingJob describes a job which still has pending tasks after all actions are executed.
+type PendingJob struct {
+	Job    string `json:"job"`
+	Queue  string `json:"queue"`
+	Phase  string `json:"phase"`
+	Reason string `json:"reason"`
+}
+
+// Result is the outcome of one simulated scheduling session.
+type Result struct {
+	SessionID string       `json:"sessionID"`
+	Binds     []Decision   `json:"binds"`
+	Pipelines []Decision   `json:"pipelines"`
+	Evictions []Decision   `json:"evictions"`
+	Pending   []PendingJob `json:"pending"`
+}
+
+// Simulator replays a cache snapshot through the actions and plugins of a scheduler configuration
+// without talking to an api server.
+type Simulator struct {
+	actions        []framework.Action
+	tiers          []conf.Tier
+	configurations []conf.Configuration
+
+	// ownEnabledActions is false if the simulator shares the process with a running scheduler,
+	// which already owns conf.EnabledActionMap for the same actions.
+	ownEnabledActions bool
+}
+
+// New creates a Simulator from a scheduler configuration in the same format as the scheduler configmap.
+// The default scheduler configuration is used if schedulerConf is empty.
+func New(schedulerConf string) (*Simulator, error) {
+	if schedulerConf == "" {
+		schedulerConf = scheduler.DefaultSchedulerConf
+	}
+	actions, tiers, configurations, _, err := scheduler.UnmarshalSchedulerConf(schedulerConf)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scheduler configuration: %v", err)
+	}
+
+	return &Simulator{
+		actions:           actions,
+		tiers:             tiers,
+		configurations:    configurations,
+		ownEnabledActions: true,
+	}, nil
+}
+
+// NewForScheduler creates a Simulator with the configuration loaded by a running scheduler. The stages of its
+// pipeline are unwrapped, so that every action runs and the simulation does not count as a session of them.
+func NewForScheduler(actions []framework.Action, tiers []conf.Tier, configurations []conf.Configuration) *Simulator {
+	return &Simulator{
+		actions:        framework.UnwrapStages(actions),
+		tiers:          tiers,
+		configurations: configurations,
+	}
+}
+
+// Run opens a session on the snapshot, executes all configured actions and returns the decisions
+// the scheduler would have made. Binding and eviction requests are discarded.
+func (s *Simulator) Run(snapshot *Snapshot) (*Result, error) {
+	var result *Result
+	err := s.run(snapshot, func(ssn *framework.Session, originStatus map[api.TaskID]api.TaskStatus) {
+		result = collectResult(ssn, originStatus)
+	})
+	return result, err
+}
+
+// run executes all configured actions on the snapshot and calls collect before the session is closed.
+func (s *Simulator) run(snapshot *Snapshot, collect func(*framework.Session, map[api.TaskID]api.TaskStatus)) error {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	schedulerCache, err := buildSchedulerCache(snapshot, stopCh)
+	if err != nil {
+		return err
+	}
+
+	if s.ownEnabledActions {
+		conf.EnabledActionMap = make(map[string]bool, len(s.actions))
+		for _, action := range s.actions {
+			conf.EnabledActionMap[action.Name()] = true
+		}
+	}
+
+	ssn := framework.OpenDryRunSession(schedulerCache, s.tiers, s.configurations)
+	defer framework.CloseSession(ssn)
+
+	originStatus := make(map[api.TaskID]api.TaskStatus)
+	for _, job := range ssn.Jobs {
+		for _, task := range job.Tasks {
+			originStatus[task.UID] = task.Status
+		}
+	}
+
+	for _, action := range s.actions {
+		klog.V(3).Infof("Simulating action %s in session %s", action.Name(), ssn.UID)
+		action.Execute(ssn)
+	}
+
+	collect(ssn, originStatus)
+	return nil
+}
+
+func collectResult(ssn *framework.Session, originStatus map[api.TaskID]api.TaskStatus) *Result {
+	result := &Result{SessionID: string(ssn.UID)}
+
+	for _, job := range ssn.Jobs {
+		for _, task := range job.Tasks {
+			origin := originStatus[task.UID]
+			if origin == task.Status {
+				continue
+			}
+			decision := Decision{
+				Task: fmt.Sprintf("%s/%s", task.Namespace, task.Name),
+				Job:  string(job.UID),
+				Node: task.NodeName,
+			}
+			switch task.Status {
+			case api.Binding, api.Bound:
+				result.Binds = append(result.Binds, decision)
+			case api.Pipelined:
+				result.Pipelines = append(result.Pipelines, decision)
+			case api.Releasing:
+				result.Evictions = append(result.Evictions, decision)
+			}
+		}
+
+		if !job.HasPendingTasks() {
+			continue
+		}
+		pending := PendingJob{
+			Job:    string(job.UID),
+			Queue:  string(job.Queue),
+			Reason: job.FitError(),
+		}
+		if job.PodGroup != nil {
+			pending.Phase = string(job.PodGroup.Status.Phase)
+		}
+		result.Pending = append(result.Pending, pending)
+	}
+
+	sortDecisions(result.Binds)
+	sortDecisions(result.Pipelines)
+	sortDecisions(result.Evictions)
+	sort.Slice(result.Pending, func(i, j int) bool {
+		return result.Pending[i].Job < result.Pending[j].Job
+	})
+
+	return result
+}
+
+func sortDecisions(decisions []Decision) {
+	sort.Slice(decisions, func(i, j int) bool {
+		return decisions[i].Task < decisions[j].Task
+	})
+}
+
+// buildSchedulerCache creates a mock scheduler cache and replays the snapshot objects through its event handlers.
+func buildSchedulerCache(snapshot *Snapshot, stopCh <-chan struct{}) (*cache.SchedulerCache, error) {
+	schedulerCache := cache.NewCustomMockSchedulerCache(SchedulerName, &discardBinder{}, &discardEvictor{},
+		&util.FakeStatusUpdater{}, nil, &record.FakeRecorder{})
+	schedulerCache.SetDryRun(true)
+	schedulerCache.Run(stopCh)
+	schedulerCache.WaitForCacheSync(stopCh)
+
+	for _, pc := range snapshot.PriorityClasses {
+		schedulerCache.AddPriorityClass(pc)
+	}
+	for _, node := range snapshot.Nodes {
+		if err := schedulerCache.AddOrUpdateNode(node); err != nil {
+			return nil, fmt.Errorf("failed to add node %s: %v", node.Name, err)
+		}
+	}
+	for _, pod := range snapshot.Pods {
+		schedulerCache.AddPod(pod)
+	}
+
+	queues := sets.New[string]()
+	for _, pg := range snapshot.PodGroups {
+		podGroup := &vcv1beta1.PodGroup{}
+		if err := schedulingscheme.Scheme.Convert(&pg.PodGroup, podGroup, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert podgroup %s/%s: %v", pg.Namespace, pg.Name, err)
+		}
+		schedulerCache.AddPodGroupV1beta1(podGroup)
+		queues.Insert(pg.Spec.Queue)
+	}
+
+	for _, q := range snapshot.Queues {
+		queue := &vcv1beta1.Queue{}
+		if err := schedulingscheme.Scheme.Convert(q, queue, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert queue %s: %v", q.Name, err)
+		}
+		schedulerCache.AddQueueV1beta1(queue)
+		queues.Delete(queue.Name)
+	}
+	// Snapshots dumped by older schedulers carry no queues, fall back to open queues with default weight.
+	for name := range queues {
+		if name == "" {
+			continue
+		}
+		klog.V(3).Infof("Queue %s is not found in snapshot, simulate it as an open queue", name)
+		schedulerCache.AddQueueV1beta1(util.BuildQueueWithState(name, 1, nil, vcv1beta1.QueueStateOpen))
+	}
+
+	hyperNodes := make(map[string]*api.HyperNodeInfo, len(snapshot.HyperNodes))
+	for _, hn := range snapshot.HyperNodes {
+		hyperNodes[hn.Name] = api.NewHyperNodeInfo(hn)
+	}
+	for _, hni := range hyperNodes {
+		for _, member := range hni.HyperNode.Spec.Members {
+			if member.Type != topologyv1alpha1.MemberTypeHyperNode || member.Selector.ExactMatch == nil {
+				continue
+			}
+			if child, found := hyperNodes[member.Selector.ExactMatch.Name]; found {
+				hni.Children.Insert(child.Name)
+				child.Parent = hni.Name
+			}
+		}
+	}
+	if len(hyperNodes) != 0 {
+		ready := new(atomic.Bool)
+		ready.Store(true)
+		schedulerCache.HyperNodesInfo = api.NewHyperNodesInfoWithCache(hyperNodes, snapshot.HyperNodesSetByTier, snapshot.RealNodesSet, ready)
+	}
+
+	return schedulerCache, nil
+}
+
+// discardBinder accepts all bind requests without sending them to the api server.
+type discardBinder struct{}
+
+func (b *discardBinder) Bind(_ kubernetes.Interface, _ []*api.TaskInfo) map[api.TaskID]string {
+	return nil
+}
+
+// discardEvictor accepts all evict requests without sending them to the api server.
+type discardEvictor struct{}
+
+func (e *discardEvictor) Evict(_ *v1.Pod, _ string) error {
+	return nil
+}
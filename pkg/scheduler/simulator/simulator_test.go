@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	_ "volcano.sh/volcano/pkg/scheduler/actions"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	_ "volcano.sh/volcano/pkg/scheduler/plugins"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const testSchedulerConf = `
+actions: "enqueue, allocate"
+tiers:
+- plugins:
+  - name: priority
+  - name: gang
+- plugins:
+  - name: predicates
+  - name: proportion
+  - name: nodeorder
+`
+
+// dumpSnapshot encodes a cache snapshot in the same layout as the cache Dumper.
+func dumpSnapshot(t *testing.T, sc *cache.SchedulerCache, withQueues bool) *bytes.Buffer {
+	snapshot := sc.Snapshot()
+	values := []interface{}{snapshot.Nodes, snapshot.HyperNodesSetByTier, snapshot.HyperNodeTierNameMap,
+		snapshot.RealNodesSet, snapshot.HyperNodes, snapshot.Jobs}
+	if withQueues {
+		values = append(values, snapshot.Queues)
+	}
+
+	buf := &bytes.Buffer{}
+	for _, v := range values {
+		if err := json.NewEncoder(buf).Encode(v); err != nil {
+			t.Fatalf("failed to encode snapshot: %v", err)
+		}
+	}
+	return buf
+}
+
+func TestSimulatorRun(t *testing.T) {
+	tests := []struct {
+		name          string
+		withQueues    bool
+		pods          []*v1.Pod
+		podGroups     []*schedulingv1beta1.PodGroup
+		expectBinds   map[string]string
+		expectPending []string
+	}{
+		{
+			name:       "gang job fits on the nodes",
+			withQueues: true,
+			pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "4Gi"), "pg1", nil, nil),
+				util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("2", "4Gi"), "pg1", nil, nil),
+			},
+			podGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			expectBinds: map[string]string{"c1/p1": "n1", "c1/p2": "n2"},
+		},
+		{
+			name: "gang job does not fit and is reported as pending, queues are recovered from podgroups",
+			pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("3", "4Gi"), "pg1", nil, nil),
+				util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("3", "4Gi"), "pg1", nil, nil),
+				util.BuildPod("c1", "p3", "", v1.PodPending, api.BuildResourceList("3", "4Gi"), "pg1", nil, nil),
+			},
+			podGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q1", 3, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			expectBinds:   map[string]string{},
+			expectPending: []string{"c1/pg1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := cache.NewDefaultMockSchedulerCache("volcano")
+			for _, node := range []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("3", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				util.BuildNode("n2", api.BuildResourceList("3", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			} {
+				sc.AddOrUpdateNode(node)
+			}
+			for _, pod := range test.pods {
+				sc.AddPod(pod)
+			}
+			for _, pg := range test.podGroups {
+				sc.AddPodGroupV1beta1(pg)
+			}
+			sc.AddQueueV1beta1(util.BuildQueue("q1", 1, nil))
+
+			snapshot, err := LoadSnapshot(dumpSnapshot(t, sc, test.withQueues))
+			if err != nil {
+				t.Fatalf("failed to load snapshot: %v", err)
+			}
+			if test.withQueues != (len(snapshot.Queues) != 0) {
+				t.Fatalf("unexpected queues in snapshot: %v", snapshot.Queues)
+			}
+
+			sim, err := New(testSchedulerConf)
+			if err != nil {
+				t.Fatalf("failed to create simulator: %v", err)
+			}
+			result, err := sim.Run(snapshot)
+			if err != nil {
+				t.Fatalf("failed to run simulator: %v", err)
+			}
+
+			binds := map[string]string{}
+			for _, d := range result.Binds {
+				binds[d.Task] = d.Node
+			}
+			if len(binds) != len(test.expectBinds) {
+				t.Fatalf("expected binds %v, got %v", test.expectBinds, binds)
+			}
+			for task := range test.expectBinds {
+				if _, found := binds[task]; !found {
+					t.Errorf("expected task %s to be bound, got %v", task, binds)
+				}
+			}
+
+			var pending []string
+			for _, p := range result.Pending {
+				pending = append(pending, p.Job)
+				if p.Reason == "" {
+					t.Errorf("expected pending reason for job %s", p.Job)
+				}
+			}
+			if len(pending) != len(test.expectPending) {
+				t.Fatalf("expected pending jobs %v, got %v", test.expectPending, pending)
+			}
+			for i := range pending {
+				if pending[i] != test.expectPending[i] {
+					t.Errorf("expected pending jobs %v, got %v", test.expectPending, pending)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadSnapshotKeepsNodeTasks(t *testing.T) {
+	sc := cache.NewDefaultMockSchedulerCache("volcano")
+	for _, node := range []*v1.Node{
+		util.BuildNode("n1", api.BuildResourceList("3", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		util.BuildNode("n2", api.BuildResourceList("3", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+	} {
+		sc.AddOrUpdateNode(node)
+	}
+	// The pod of another scheduler is only tracked by n1, the running pod of pg1 by both n2 and the job.
+	foreign := util.BuildPod("c1", "foreign", "n1", v1.PodRunning, api.BuildResourceList("2", "4Gi"), "", nil, nil)
+	foreign.Spec.SchedulerName = "default-scheduler"
+	for _, pod := range []*v1.Pod{
+		foreign,
+		util.BuildPod("c1", "p1", "n2", v1.PodRunning, api.BuildResourceList("2", "4Gi"), "pg1", nil, nil),
+		util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("2", "4Gi"), "pg2", nil, nil),
+	} {
+		sc.AddPod(pod)
+	}
+	sc.AddPodGroupV1beta1(util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning))
+	sc.AddPodGroupV1beta1(util.BuildPodGroup("pg2", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue))
+	sc.AddQueueV1beta1(util.BuildQueue("q1", 1, nil))
+
+	snapshot, err := LoadSnapshot(dumpSnapshot(t, sc, true))
+	if err != nil {
+		t.Fatalf("failed to load snapshot: %v", err)
+	}
+	var pods []string
+	for _, pod := range snapshot.Pods {
+		pods = append(pods, pod.Name)
+	}
+	sort.Strings(pods)
+	if strings.Join(pods, ",") != "foreign,p1,p2" {
+		t.Fatalf("expected pods [foreign p1 p2] in snapshot, got %v", pods)
+	}
+
+	sim, err := New(testSchedulerConf)
+	if err != nil {
+		t.Fatalf("failed to create simulator: %v", err)
+	}
+	result, err := sim.Run(snapshot)
+	if err != nil {
+		t.Fatalf("failed to run simulator: %v", err)
+	}
+	// p2 only fits on n1 if the foreign pod is lost.
+	if len(result.Binds) != 0 {
+		t.Errorf("expected no binds, got %v", result.Binds)
+	}
+	if len(result.Pending) != 1 || result.Pending[0].Job != "c1/pg2" {
+		t.Errorf("expected c1/pg2 to be pending, got %v", result.Pending)
+	}
+}
+
+func TestSimulatorRunKeepsMetrics(t *testing.T) {
+	sc := cache.NewDefaultMockSchedulerCache("volcano")
+	sc.AddOrUpdateNode(util.BuildNode("n1", api.BuildResourceList("3", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+	for _, pod := range []*v1.Pod{
+		util.BuildPod("metrics", "p1", "", v1.PodPending, api.BuildResourceList("2", "4Gi"), "pg1", nil, nil),
+		util.BuildPod("metrics", "p2", "", v1.PodPending, api.BuildResourceList("2", "4Gi"), "pg1", nil, nil),
+	} {
+		sc.AddPod(pod)
+	}
+	sc.AddPodGroupV1beta1(util.BuildPodGroup("pg1", "metrics", "metrics-q", 2, nil, schedulingv1beta1.PodGroupInqueue))
+	sc.AddQueueV1beta1(util.BuildQueue("metrics-q", 1, nil))
+
+	snapshot, err := LoadSnapshot(dumpSnapshot(t, sc, true))
+	if err != nil {
+		t.Fatalf("failed to load snapshot: %v", err)
+	}
+	sim, err := New(testSchedulerConf)
+	if err != nil {
+		t.Fatalf("failed to create simulator: %v", err)
+	}
+
+	before := gatherMetrics(t)
+	result, err := sim.Run(snapshot)
+	if err != nil {
+		t.Fatalf("failed to run simulator: %v", err)
+	}
+	if len(result.Pending) != 1 {
+		t.Fatalf("expected the gang job to be pending, got %v", result.Pending)
+	}
+	if after := gatherMetrics(t); after != before {
+		t.Errorf("expected the simulation to keep the scheduler metrics, got\n%s\nwant\n%s", after, before)
+	}
+}
+
+// gatherMetrics returns the text of the scheduler metrics of the default registry.
+func gatherMetrics(t *testing.T) string {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	var buf strings.Builder
+	for _, family := range families {
+		if !strings.HasPrefix(family.GetName(), "volcano_") {
+			continue
+		}
+		buf.WriteString(family.String())
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// Snapshot is the cluster state recovered from a file written by the scheduler cache Dumper.
+// Only the raw Kubernetes/Volcano objects are kept, the derived scheduler state is rebuilt
+// by feeding them through a fresh scheduler cache.
+type Snapshot struct {
+	Nodes               []*v1.Node
+	Pods                []*v1.Pod
+	PodGroups           []*api.PodGroup
+	Queues              []*scheduling.Queue
+	HyperNodes          []*topologyv1alpha1.HyperNode
+	HyperNodesSetByTier map[int]sets.Set[string]
+	RealNodesSet        map[string]sets.Set[string]
+	// PriorityClasses is only available for the live snapshot of a running scheduler.
+	PriorityClasses []*schedulingv1.PriorityClass
+}
+
+// The following types only pick up the fields needed to rebuild the cluster from
+// the json encoded api.NodeInfo, api.JobInfo, api.HyperNodeInfo and api.QueueInfo.
+type dumpedNode struct {
+	Node  *v1.Node
+	Tasks map[api.TaskID]*dumpedTask
+}
+
+type dumpedTask struct {
+	Pod *v1.Pod
+}
+
+type dumpedJob struct {
+	PodGroup *api.PodGroup
+	Tasks    map[api.TaskID]*dumpedTask
+}
+
+type dumpedHyperNode struct {
+	HyperNode *topologyv1alpha1.HyperNode
+}
+
+type dumpedQueue struct {
+	Queue *scheduling.Queue
+}
+
+// LoadSnapshotFile reads a snapshot-<ts>.json file written by the scheduler cache Dumper.
+func LoadSnapshotFile(path string) (*Snapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadSnapshot(file)
+}
+
+// LoadSnapshot decodes the json stream written by the scheduler cache Dumper. The Dumper encodes
+// nodes, hyperNodes set by tier, hyperNode tier names, real nodes set, hyperNodes, jobs and queues
+// one after another; queues are optional because older schedulers did not dump them.
+func LoadSnapshot(r io.Reader) (*Snapshot, error) {
+	var (
+		nodes          map[string]*dumpedNode
+		setByTier      map[int]sets.Set[string]
+		tierNameMap    api.HyperNodeTierNameMap
+		realNodesSet   map[string]sets.Set[string]
+		hyperNodes     map[string]*dumpedHyperNode
+		jobs           map[api.JobID]*dumpedJob
+		queues         map[api.QueueID]*dumpedQueue
+		decoder        = json.NewDecoder(r)
+		requiredValues = []interface{}{&nodes, &setByTier, &tierNameMap, &realNodesSet, &hyperNodes, &jobs}
+	)
+
+	for i, v := range requiredValues {
+		if err := decoder.Decode(v); err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot section %d: %v", i, err)
+		}
+	}
+	if err := decoder.Decode(&queues); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to decode snapshot queues: %v", err)
+	}
+
+	snapshot := &Snapshot{
+		HyperNodesSetByTier: setByTier,
+		RealNodesSet:        realNodesSet,
+	}
+	for _, hn := range hyperNodes {
+		if hn != nil && hn.HyperNode != nil {
+			snapshot.HyperNodes = append(snapshot.HyperNodes, hn.HyperNode)
+		}
+	}
+	pods := sets.New[types.UID]()
+	for _, job := range jobs {
+		if job == nil {
+			continue
+		}
+		if job.PodGroup != nil {
+			snapshot.PodGroups = append(snapshot.PodGroups, job.PodGroup)
+		}
+		for _, task := range job.Tasks {
+			if task != nil && task.Pod != nil && !pods.Has(task.Pod.UID) {
+				pods.Insert(task.Pod.UID)
+				snapshot.Pods = append(snapshot.Pods, task.Pod)
+			}
+		}
+	}
+	for _, n := range nodes {
+		if n == nil || n.Node == nil {
+			continue
+		}
+		snapshot.Nodes = append(snapshot.Nodes, n.Node)
+		// Pods of other schedulers are only tracked by the nodes, but still occupy resources.
+		for _, task := range n.Tasks {
+			if task != nil && task.Pod != nil && !pods.Has(task.Pod.UID) {
+				pods.Insert(task.Pod.UID)
+				snapshot.Pods = append(snapshot.Pods, task.Pod)
+			}
+		}
+	}
+	for _, q := range queues {
+		if q != nil && q.Queue != nil {
+			snapshot.Queues = append(snapshot.Queues, q.Queue)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// SnapshotFromClusterInfo extracts the raw objects of a scheduler cache snapshot, e.g. the live
+// snapshot of a running scheduler, so that it can be replayed without touching the live cache.
+func SnapshotFromClusterInfo(ci *api.ClusterInfo, priorityClasses []*schedulingv1.PriorityClass) *Snapshot {
+	snapshot := &Snapshot{
+		HyperNodesSetByTier: ci.HyperNodesSetByTier,
+		RealNodesSet:        ci.RealNodesSet,
+		PriorityClasses:     priorityClasses,
+	}
+
+	pods := sets.New[types.UID]()
+	for _, job := range ci.Jobs {
+		if job.PodGroup != nil {
+			snapshot.PodGroups = append(snapshot.PodGroups, job.PodGroup)
+		}
+		for _, task := range job.Tasks {
+			if task.Pod != nil && !pods.Has(task.Pod.UID) {
+				pods.Insert(task.Pod.UID)
+				snapshot.Pods = append(snapshot.Pods, task.Pod)
+			}
+		}
+	}
+	for _, node := range ci.Nodes {
+		if node.Node == nil {
+			continue
+		}
+		snapshot.Nodes = append(snapshot.Nodes, node.Node)
+		// Pods of other schedulers are only tracked by the nodes, but still occupy resources.
+		for _, task := range node.Tasks {
+			if task.Pod != nil && !pods.Has(task.Pod.UID) {
+				pods.Insert(task.Pod.UID)
+				snapshot.Pods = append(snapshot.Pods, task.Pod)
+			}
+		}
+	}
+	for _, queue := range ci.Queues {
+		if queue.Queue != nil {
+			snapshot.Queues = append(snapshot.Queues, queue.Queue)
+		}
+	}
+	for _, hn := range ci.HyperNodes {
+		if hn.HyperNode != nil {
+			snapshot.HyperNodes = append(snapshot.HyperNodes, hn.HyperNode)
+		}
+	}
+
+	return snapshot
+}
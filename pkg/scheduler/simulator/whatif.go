@@ -0,0 +1,323 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	schedulingscheme "volcano.sh/apis/pkg/apis/scheduling/scheme"
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
+	"volcano.sh/volcano/pkg/scheduler"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// maxWhatIfRequestBytes limits the size of a what-if request body.
+const maxWhatIfRequestBytes = 4 << 20
+
+// WhatIfRequest is a hypothetical job to place on the cluster. Either Job, or PodGroup together
+// with its Pods, must be set.
+type WhatIfRequest struct {
+	Job      *batch.Job          `json:"job,omitempty"`
+	PodGroup *vcv1beta1.PodGroup `json:"podGroup,omitempty"`
+	Pods     []*v1.Pod           `json:"pods,omitempty"`
+}
+
+// WhatIfResult tells whether and where the hypothetical job would be placed in a session.
+type WhatIfResult struct {
+	// Job is the id of the hypothetical job in the session.
+	Job string `json:"job"`
+	// Allocatable is true if at least minAvailable tasks of the job are bound right away.
+	Allocatable bool `json:"allocatable"`
+	// Binds is the tasks of the job bound to nodes.
+	Binds []Decision `json:"binds,omitempty"`
+	// Pipelines is the tasks of the job pipelined onto resources being released by the victims.
+	Pipelines []Decision `json:"pipelines,omitempty"`
+	// HyperNode is the HyperNode the job is placed in when network topology aware scheduling is used.
+	HyperNode string `json:"hyperNode,omitempty"`
+	// Victims is the running tasks preempt or reclaim evicts on the nodes the job is pipelined to.
+	Victims []Decision `json:"victims,omitempty"`
+	// Reason is the fit errors of the job if any of its tasks is still pending.
+	Reason string `json:"reason,omitempty"`
+}
+
+// WhatIf places the hypothetical job on a copy of the snapshot and runs all configured actions, so the
+// answer comes from the same allocate, preempt and reclaim code paths, including their statements and
+// simulate hooks, as a real session. Nothing is sent to the api server.
+func (s *Simulator) WhatIf(snapshot *Snapshot, req *WhatIfRequest) (*WhatIfResult, error) {
+	podGroup, pods, err := req.build()
+	if err != nil {
+		return nil, err
+	}
+	pg := &api.PodGroup{Version: api.PodGroupVersionV1Beta1}
+	if err := schedulingscheme.Scheme.Convert(podGroup, &pg.PodGroup, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert podgroup %s/%s: %v", podGroup.Namespace, podGroup.Name, err)
+	}
+
+	sim := *snapshot
+	sim.PodGroups = append(append([]*api.PodGroup(nil), snapshot.PodGroups...), pg)
+	sim.Pods = append(append([]*v1.Pod(nil), snapshot.Pods...), pods...)
+
+	jobID := api.JobID(fmt.Sprintf("%s/%s", podGroup.Namespace, podGroup.Name))
+	result := &WhatIfResult{Job: string(jobID)}
+	err = s.run(&sim, func(ssn *framework.Session, originStatus map[api.TaskID]api.TaskStatus) {
+		collectWhatIfResult(ssn, originStatus, jobID, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func collectWhatIfResult(ssn *framework.Session, originStatus map[api.TaskID]api.TaskStatus, jobID api.JobID, result *WhatIfResult) {
+	job, found := ssn.Jobs[jobID]
+	if !found {
+		result.Reason = "job is not scheduled by the session, check its queue and scheduler name"
+		return
+	}
+
+	sessionResult := collectResult(ssn, originStatus)
+	pipelinedNodes := sets.New[string]()
+	for _, d := range sessionResult.Binds {
+		if d.Job == string(jobID) {
+			result.Binds = append(result.Binds, d)
+		}
+	}
+	for _, d := range sessionResult.Pipelines {
+		if d.Job == string(jobID) {
+			result.Pipelines = append(result.Pipelines, d)
+			pipelinedNodes.Insert(d.Node)
+		}
+	}
+	for _, d := range sessionResult.Evictions {
+		if pipelinedNodes.Has(d.Node) {
+			result.Victims = append(result.Victims, d)
+		}
+	}
+
+	result.Allocatable = int32(len(result.Binds)) >= job.MinAvailable
+	result.HyperNode = job.AllocatedHyperNode
+	if job.HasPendingTasks() {
+		result.Reason = job.FitError()
+	}
+}
+
+// build returns the podgroup and pending pods of the hypothetical job.
+func (req *WhatIfRequest) build() (*vcv1beta1.PodGroup, []*v1.Pod, error) {
+	var (
+		podGroup *vcv1beta1.PodGroup
+		pods     []*v1.Pod
+	)
+	switch {
+	case req.Job != nil:
+		podGroup, pods = podGroupAndPodsForJob(req.Job)
+	case req.PodGroup != nil:
+		if len(req.Pods) == 0 {
+			return nil, nil, fmt.Errorf("pods of podgroup %s are required", req.PodGroup.Name)
+		}
+		podGroup = req.PodGroup.DeepCopy()
+		if podGroup.Namespace == "" {
+			podGroup.Namespace = metav1.NamespaceDefault
+		}
+		for _, pod := range req.Pods {
+			pod = pod.DeepCopy()
+			if pod.Namespace == "" {
+				pod.Namespace = podGroup.Namespace
+			}
+			if pod.Annotations == nil {
+				pod.Annotations = map[string]string{}
+			}
+			pod.Annotations[vcv1beta1.KubeGroupNameAnnotationKey] = podGroup.Name
+			pods = append(pods, pod)
+		}
+	default:
+		return nil, nil, fmt.Errorf("either job or podgroup is required")
+	}
+
+	if podGroup.Name == "" {
+		return nil, nil, fmt.Errorf("name of the job is required")
+	}
+	if podGroup.UID == "" {
+		podGroup.UID = types.UID(fmt.Sprintf("whatif-%s-%s", podGroup.Namespace, podGroup.Name))
+	}
+	podGroup.Status = vcv1beta1.PodGroupStatus{Phase: vcv1beta1.PodGroupPending}
+
+	for _, pod := range pods {
+		if pod.Namespace != podGroup.Namespace {
+			return nil, nil, fmt.Errorf("pod %s/%s is not in the namespace of the job", pod.Namespace, pod.Name)
+		}
+		if pod.UID == "" {
+			pod.UID = types.UID(fmt.Sprintf("whatif-%s-%s", pod.Namespace, pod.Name))
+		}
+		pod.Spec.NodeName = ""
+		pod.Status = v1.PodStatus{Phase: v1.PodPending}
+		if pod.CreationTimestamp.IsZero() {
+			pod.CreationTimestamp = metav1.Now()
+		}
+	}
+	return podGroup, pods, nil
+}
+
+// podGroupAndPodsForJob creates the podgroup and pods of a job in the same way as the job controller.
+func podGroupAndPodsForJob(job *batch.Job) (*vcv1beta1.PodGroup, []*v1.Pod) {
+	podGroup := &vcv1beta1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        job.Name,
+			Namespace:   job.Namespace,
+			Annotations: job.Annotations,
+			Labels:      job.Labels,
+		},
+		Spec: vcv1beta1.PodGroupSpec{
+			MinMember:         job.Spec.MinAvailable,
+			MinTaskMember:     map[string]int32{},
+			Queue:             job.Spec.Queue,
+			PriorityClassName: job.Spec.PriorityClassName,
+		},
+	}
+	if podGroup.Namespace == "" {
+		podGroup.Namespace = metav1.NamespaceDefault
+	}
+	if job.Spec.NetworkTopology != nil {
+		podGroup.Spec.NetworkTopology = &vcv1beta1.NetworkTopologySpec{
+			Mode:               vcv1beta1.NetworkTopologyMode(job.Spec.NetworkTopology.Mode),
+			HighestTierAllowed: job.Spec.NetworkTopology.HighestTierAllowed,
+			HighestTierName:    job.Spec.NetworkTopology.HighestTierName,
+		}
+	}
+
+	var pods []*v1.Pod
+	minResources := v1.ResourceList{}
+	for _, task := range job.Spec.Tasks {
+		if task.MinAvailable != nil {
+			podGroup.Spec.MinTaskMember[task.Name] = *task.MinAvailable
+		} else {
+			podGroup.Spec.MinTaskMember[task.Name] = task.Replicas
+		}
+
+		for i := 0; i < int(task.Replicas); i++ {
+			template := task.Template.DeepCopy()
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        jobhelpers.MakePodName(job.Name, task.Name, i),
+					Namespace:   podGroup.Namespace,
+					Labels:      template.Labels,
+					Annotations: template.Annotations,
+				},
+				Spec: template.Spec,
+			}
+			if pod.Annotations == nil {
+				pod.Annotations = map[string]string{}
+			}
+			pod.Annotations[vcv1beta1.KubeGroupNameAnnotationKey] = podGroup.Name
+			pod.Annotations[batch.TaskSpecKey] = task.Name
+			pod.Annotations[batch.JobNameKey] = job.Name
+			if pod.Spec.SchedulerName == "" {
+				pod.Spec.SchedulerName = job.Spec.SchedulerName
+			}
+			if pod.Spec.PriorityClassName == "" {
+				pod.Spec.PriorityClassName = job.Spec.PriorityClassName
+			}
+
+			// The job controller also counts the first minAvailable pods as the min resources.
+			if int32(len(pods)) < job.Spec.MinAvailable {
+				for _, container := range pod.Spec.Containers {
+					for name, quantity := range container.Resources.Requests {
+						total := minResources[name]
+						total.Add(quantity)
+						minResources[name] = total
+					}
+				}
+			}
+			pods = append(pods, pod)
+		}
+	}
+	podGroup.Spec.MinResources = &minResources
+
+	return podGroup, pods
+}
+
+// WhatIfSource provides the live cache snapshot and the loaded configuration of a running scheduler.
+type WhatIfSource interface {
+	Snapshot() *api.ClusterInfo
+	// ReleaseSnapshot hands back a snapshot taken by Snapshot, so that the incremental snapshot of the
+	// next session still reuses the objects released by the last one.
+	ReleaseSnapshot(snapshot *api.ClusterInfo)
+	PriorityClasses() []*schedulingv1.PriorityClass
+	SchedulerConf() string
+}
+
+// WhatIfHandler answers dry-run requests for hypothetical jobs against the live cache snapshot.
+// Requests are served one at a time since each one runs a whole session.
+type WhatIfHandler struct {
+	mutex  sync.Mutex
+	source WhatIfSource
+}
+
+// NewWhatIfHandler creates a WhatIfHandler for a running scheduler.
+func NewWhatIfHandler(source WhatIfSource) *WhatIfHandler {
+	return &WhatIfHandler{source: source}
+}
+
+// ServeHTTP accepts a json encoded WhatIfRequest by POST and replies with a json encoded WhatIfResult.
+func (h *WhatIfHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req := &WhatIfRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWhatIfRequestBytes)).Decode(req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	// The actions of the scheduler keep their state between its sessions, every request runs its own ones.
+	actions, tiers, configurations, _, err := scheduler.UnmarshalSchedulerConf(h.source.SchedulerConf())
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid scheduler configuration: %v", err), http.StatusInternalServerError)
+		return
+	}
+	sim := NewForScheduler(actions, tiers, configurations)
+	// The snapshot is only read, it is released once the simulation does not use its objects anymore.
+	snapshot := h.source.Snapshot()
+	defer h.source.ReleaseSnapshot(snapshot)
+	result, err := sim.WhatIf(SnapshotFromClusterInfo(snapshot, h.source.PriorityClasses()), req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		klog.Errorf("Failed to encode what-if result: %v", err)
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const whatIfSchedulerConf = `
+actions: "enqueue, allocate, preempt"
+tiers:
+- plugins:
+  - name: priority
+  - name: gang
+  - name: conformance
+- plugins:
+  - name: predicates
+  - name: proportion
+  - name: nodeorder
+`
+
+type fakeWhatIfSource struct {
+	sc              *cache.SchedulerCache
+	priorityClasses []*schedulingv1.PriorityClass
+}
+
+func (s *fakeWhatIfSource) Snapshot() *api.ClusterInfo {
+	return s.sc.Snapshot()
+}
+
+func (s *fakeWhatIfSource) ReleaseSnapshot(snapshot *api.ClusterInfo) {
+	s.sc.ReleaseSnapshot(snapshot)
+}
+
+func (s *fakeWhatIfSource) PriorityClasses() []*schedulingv1.PriorityClass {
+	return s.priorityClasses
+}
+
+func (s *fakeWhatIfSource) SchedulerConf() string {
+	return whatIfSchedulerConf
+}
+
+func buildWhatIfJob(name string, replicas, minAvailable int32, cpu, priorityClass string) *batch.Job {
+	return &batch.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "c1"},
+		Spec: batch.JobSpec{
+			MinAvailable:      minAvailable,
+			Queue:             "q1",
+			PriorityClassName: priorityClass,
+			Tasks: []batch.TaskSpec{{
+				Name:     "worker",
+				Replicas: replicas,
+				Template: v1.PodTemplateSpec{Spec: v1.PodSpec{
+					SchedulerName: SchedulerName,
+					Containers: []v1.Container{{
+						Name:      "worker",
+						Resources: v1.ResourceRequirements{Requests: api.BuildResourceList(cpu, "1Gi")},
+					}},
+				}},
+			}},
+		},
+	}
+}
+
+func TestWhatIf(t *testing.T) {
+	priorityClasses := []*schedulingv1.PriorityClass{
+		{ObjectMeta: metav1.ObjectMeta{Name: "low"}, Value: 1},
+		{ObjectMeta: metav1.ObjectMeta{Name: "high"}, Value: 100},
+	}
+
+	tests := []struct {
+		name              string
+		job               *batch.Job
+		expectAllocatable bool
+		expectBinds       int
+		expectPipelines   int
+		expectVictims     bool
+	}{
+		{
+			name:              "job fits on the free resources",
+			job:               buildWhatIfJob("j1", 1, 1, "1", "high"),
+			expectAllocatable: true,
+			expectBinds:       1,
+		},
+		{
+			name:            "job fits only after preempting lower priority tasks",
+			job:             buildWhatIfJob("j1", 2, 2, "1", "high"),
+			expectPipelines: 2,
+			expectVictims:   true,
+		},
+		{
+			name: "job with lower priority can not preempt",
+			job:  buildWhatIfJob("j1", 2, 2, "1", "low"),
+			// The intra-job preemption of the preempt action still pipelines one task onto the idle resources.
+			expectPipelines: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := cache.NewDefaultMockSchedulerCache(SchedulerName)
+			for _, pc := range priorityClasses {
+				sc.AddPriorityClass(pc)
+			}
+			sc.AddOrUpdateNode(util.BuildNode("n1", api.BuildResourceList("3", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+			sc.AddQueueV1beta1(util.BuildQueue("q1", 1, nil))
+			pg := util.BuildPodGroup("pg-low", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning)
+			pg.Spec.PriorityClassName = "low"
+			sc.AddPodGroupV1beta1(pg)
+			for _, name := range []string{"p1", "p2"} {
+				pod := util.BuildPod("c1", name, "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-low", nil, nil)
+				pod.Spec.SchedulerName = SchedulerName
+				sc.AddPod(pod)
+			}
+
+			source := &fakeWhatIfSource{sc: sc, priorityClasses: priorityClasses}
+			body, err := json.Marshal(&WhatIfRequest{Job: test.job})
+			if err != nil {
+				t.Fatalf("failed to encode request: %v", err)
+			}
+
+			recorder := httptest.NewRecorder()
+			NewWhatIfHandler(source).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/debug/whatif", bytes.NewReader(body)))
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("unexpected status %d: %s", recorder.Code, recorder.Body.String())
+			}
+			result := &WhatIfResult{}
+			if err := json.Unmarshal(recorder.Body.Bytes(), result); err != nil {
+				t.Fatalf("failed to decode result: %v", err)
+			}
+
+			if result.Job != "c1/j1" {
+				t.Errorf("expected job c1/j1, got %s", result.Job)
+			}
+			if result.Allocatable != test.expectAllocatable {
+				t.Errorf("expected allocatable %v, got %+v", test.expectAllocatable, result)
+			}
+			if len(result.Binds) != test.expectBinds || len(result.Pipelines) != test.expectPipelines {
+				t.Errorf("expected %d binds and %d pipelines, got %+v", test.expectBinds, test.expectPipelines, result)
+			}
+			if (len(result.Victims) != 0) != test.expectVictims {
+				t.Errorf("expected victims %v, got %+v", test.expectVictims, result.Victims)
+			}
+			if len(result.Binds)+len(result.Pipelines) < int(test.job.Spec.MinAvailable) && result.Reason == "" {
+				t.Errorf("expected reason for job that can not be placed")
+			}
+
+			// The live cache must not be touched by the dry run.
+			if len(sc.Snapshot().Jobs) != 1 {
+				t.Errorf("expected the hypothetical job not to be added to the live cache")
+			}
+		})
+	}
+}
+
+func TestWhatIfHandlerRejectsInvalidRequest(t *testing.T) {
+	handler := NewWhatIfHandler(nil)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/whatif", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, recorder.Code)
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/debug/whatif", bytes.NewReader([]byte("{"))))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, recorder.Code)
+	}
+}
+
+func TestWhatIfHandlerKeepsIncrementalSnapshot(t *testing.T) {
+	originalOpts := options.ServerOpts
+	defer func() {
+		options.ServerOpts = originalOpts
+	}()
+	options.ServerOpts = &options.ServerOption{EnableIncrementalSnapshot: true}
+
+	sc := cache.NewDefaultMockSchedulerCache(SchedulerName)
+	sc.AddOrUpdateNode(util.BuildNode("n1", api.BuildResourceList("3", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+	sc.AddQueueV1beta1(util.BuildQueue("q1", 1, nil))
+	sc.AddPodGroupV1beta1(util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning))
+	pod := util.BuildPod("c1", "p1", "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	pod.Spec.SchedulerName = SchedulerName
+	sc.AddPod(pod)
+
+	// The session of the scheduler releases its snapshot before the what-if request comes in.
+	first := sc.Snapshot()
+	sc.ReleaseSnapshot(first)
+
+	body, err := json.Marshal(&WhatIfRequest{Job: buildWhatIfJob("j1", 1, 1, "1", "")})
+	if err != nil {
+		t.Fatalf("failed to encode request: %v", err)
+	}
+	recorder := httptest.NewRecorder()
+	NewWhatIfHandler(&fakeWhatIfSource{sc: sc}).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/debug/whatif", bytes.NewReader(body)))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", recorder.Code, recorder.Body.String())
+	}
+
+	second := sc.Snapshot()
+	if second.Nodes["n1"] != first.Nodes["n1"] || second.Jobs["c1/pg1"].Tasks["c1-p1"] != first.Jobs["c1/pg1"].Tasks["c1-p1"] {
+		t.Errorf("expected the next session to reuse the objects released by the last one")
+	}
+}
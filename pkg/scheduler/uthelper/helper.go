@@ -93,6 +93,9 @@ type TestCommonStruct struct {
 	// ExpectEvicted the expected evicted results.
 	// evicted pods list of ns/podName
 	ExpectEvicted []string
+	// ExpectEvictReasons the expected reasons of the evicted pods if set.
+	// evict reasons: ns/podName -> reason
+	ExpectEvictReasons map[string]string
 	// ExpectStatus the expected final podgroup status.
 	ExpectStatus map[api.JobID]scheduling.PodGroupPhase
 	// ExpectTaskStatusNums represents the expected number map of various TaskStatuses in podgroup
@@ -334,6 +337,12 @@ func (test *TestCommonStruct) CheckEvict(caseIndex int) error {
 	if !equality.Semantic.DeepEqual(expect, got) {
 		return fmt.Errorf("case %d(%s) check evict: \nwant: %v\n got: %v ", caseIndex, test.Name, expect, got)
 	}
+
+	if test.ExpectEvictReasons != nil {
+		if reasons := evictor.Reasons(); !equality.Semantic.DeepEqual(test.ExpectEvictReasons, reasons) {
+			return fmt.Errorf("case %d(%s) check evict reasons: \nwant: %v\n got: %v ", caseIndex, test.Name, test.ExpectEvictReasons, reasons)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scenario runs scheduling scenarios written in YAML. A scenario holds the nodes, queues, podgroups, pods and
+// scheduler configuration of a cluster, and the outcome expected of one session: the binds, the evictions, and the
+// phases and conditions of the podgroups. It is run by the real actions and plugins through uthelper, and every
+// difference from the expected outcome is reported.
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler"
+	_ "volcano.sh/volcano/pkg/scheduler/actions"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+)
+
+// pluginBuilders are all the registered plugins, kept since closing the session of a scenario cleans up the
+// registered plugin builders.
+var pluginBuilders = framework.GetPluginBuilders()
+
+// Scenario is a cluster and the outcome expected of a scheduling session on it.
+type Scenario struct {
+	Name string `json:"name"`
+	// SchedulerConf is the scheduler configuration in the format of the scheduler ConfigMap, the default scheduler
+	// configuration is used if it is empty.
+	SchedulerConf   string                        `json:"scheduler_conf"`
+	Nodes           []*v1.Node                    `json:"nodes"`
+	Queues          []*vcapisv1.Queue             `json:"queues"`
+	PodGroups       []*vcapisv1.PodGroup          `json:"podgroups"`
+	Pods            []*v1.Pod                     `json:"pods"`
+	PriorityClasses []*schedulingv1.PriorityClass `json:"priorityclasses"`
+	Expect          Expectation                   `json:"expect"`
+}
+
+// Expectation is the outcome expected of a scenario. The pods and podgroups are referred to by namespace/name.
+type Expectation struct {
+	// Binds are the nodes the pods are bound to, no other pod is expected to be bound.
+	Binds map[string]string `json:"binds"`
+	// Evictions are the evicted pods, no other pod is expected to be evicted.
+	Evictions []string `json:"evictions"`
+	// Phases are the phases of the podgroups once the session is closed.
+	Phases map[string]vcapisv1.PodGroupPhase `json:"phases"`
+	// Conditions are conditions the podgroups have once the session is closed.
+	Conditions map[string][]Condition `json:"conditions"`
+}
+
+// Condition is a condition expected of a podgroup. The fields left empty match any value.
+type Condition struct {
+	Type   vcapisv1.PodGroupConditionType `json:"type"`
+	Status v1.ConditionStatus             `json:"status,omitempty"`
+	Reason string                         `json:"reason,omitempty"`
+}
+
+// Load loads the scenario in the file. The name of the scenario is the name of the file if it is not set.
+func Load(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &Scenario{}
+	if err := yaml.UnmarshalStrict(data, s); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario %s: %v", path, err)
+	}
+	if s.Name == "" {
+		s.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	s.setDefaults()
+	return s, nil
+}
+
+// LoadDir loads the scenarios in the .yaml files of the directory, ordered by file name.
+func LoadDir(dir string) ([]*Scenario, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	scenarios := make([]*Scenario, 0, len(paths))
+	for _, path := range paths {
+		s, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		scenarios = append(scenarios, s)
+	}
+	return scenarios, nil
+}
+
+// setDefaults fills the fields which the API server or the controllers set on the objects of a real cluster.
+func (s *Scenario) setDefaults() {
+	for _, node := range s.Nodes {
+		if node.Status.Capacity == nil {
+			node.Status.Capacity = node.Status.Allocatable
+		}
+	}
+	for _, queue := range s.Queues {
+		if queue.Status.State == "" {
+			queue.Status.State = vcapisv1.QueueStateOpen
+		}
+	}
+	for _, pg := range s.PodGroups {
+		if pg.Namespace == "" {
+			pg.Namespace = v1.NamespaceDefault
+		}
+		if pg.Status.Phase == "" {
+			pg.Status.Phase = vcapisv1.PodGroupPending
+		}
+	}
+	for _, pod := range s.Pods {
+		if pod.Namespace == "" {
+			pod.Namespace = v1.NamespaceDefault
+		}
+		if pod.UID == "" {
+			pod.UID = types.UID(fmt.Sprintf("%s-%s", pod.Namespace, pod.Name))
+		}
+		if pod.Status.Phase == "" {
+			pod.Status.Phase = v1.PodPending
+		}
+	}
+}
+
+// Run runs the actions of the scheduler configuration once on the cluster of the scenario, and returns the
+// differences from the expected outcome, nil if there is none.
+func (s *Scenario) Run() error {
+	schedulerConf := s.SchedulerConf
+	if schedulerConf == "" {
+		schedulerConf = scheduler.DefaultSchedulerConf
+	}
+	actions, tiers, configurations, _, err := scheduler.UnmarshalSchedulerConf(schedulerConf)
+	if err != nil {
+		return fmt.Errorf("invalid scheduler configuration: %v", err)
+	}
+
+	test := &uthelper.TestCommonStruct{
+		Name:           s.Name,
+		Plugins:        pluginBuilders,
+		Nodes:          s.Nodes,
+		Queues:         s.Queues,
+		PodGroups:      s.PodGroups,
+		Pods:           s.Pods,
+		PriClass:       s.PriorityClasses,
+		ExpectBindMap:  s.Expect.Binds,
+		ExpectBindsNum: len(s.Expect.Binds),
+		ExpectEvicted:  s.Expect.Evictions,
+		ExpectEvictNum: len(s.Expect.Evictions),
+	}
+	ssn := test.RegisterSession(tiers, configurations)
+	test.Run(actions)
+
+	var errs []error
+	if err := test.CheckBind(0); err != nil {
+		errs = append(errs, err)
+	}
+	if err := test.CheckEvict(0); err != nil {
+		errs = append(errs, err)
+	}
+	// The plugins update the podgroups when the session is closed, which drops the jobs from the session.
+	jobs := ssn.Jobs
+	test.Close()
+	errs = append(errs, s.checkPodGroups(jobs)...)
+
+	return errors.Join(errs...)
+}
+
+// checkPodGroups checks the phases and conditions of the podgroups of the jobs.
+func (s *Scenario) checkPodGroups(jobs map[api.JobID]*api.JobInfo) []error {
+	var errs []error
+	for _, key := range sortedKeys(s.Expect.Phases) {
+		job := jobs[api.JobID(key)]
+		if job == nil || job.PodGroup == nil {
+			errs = append(errs, fmt.Errorf("check podgroup %s phase: podgroup not found", key))
+			continue
+		}
+		want, got := s.Expect.Phases[key], job.PodGroup.Status.Phase
+		if string(want) != string(got) {
+			errs = append(errs, fmt.Errorf("check podgroup %s phase:\n want: %v\n got: %v", key, want, got))
+		}
+	}
+
+	for _, key := range sortedKeys(s.Expect.Conditions) {
+		job := jobs[api.JobID(key)]
+		if job == nil || job.PodGroup == nil {
+			errs = append(errs, fmt.Errorf("check podgroup %s conditions: podgroup not found", key))
+			continue
+		}
+		for _, want := range s.Expect.Conditions[key] {
+			if !hasCondition(job.PodGroup, want) {
+				errs = append(errs, fmt.Errorf("check podgroup %s conditions:\n want: %+v\n got: %+v", key, want, job.PodGroup.Status.Conditions))
+			}
+		}
+	}
+	return errs
+}
+
+func hasCondition(pg *api.PodGroup, want Condition) bool {
+	for _, c := range pg.Status.Conditions {
+		if string(c.Type) != string(want.Type) {
+			continue
+		}
+		if (want.Status == "" || c.Status == want.Status) && (want.Reason == "" || c.Reason == want.Reason) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scenario
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+)
+
+func TestMain(m *testing.M) {
+	options.Default()
+	os.Exit(m.Run())
+}
+
+func TestScenarios(t *testing.T) {
+	scenarios, err := LoadDir("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range scenarios {
+		t.Run(s.Name, func(t *testing.T) {
+			if err := s.Run(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestScenarioReportsDifferences(t *testing.T) {
+	s, err := Load(filepath.Join("testdata", "gang-allocate.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Expect.Binds = map[string]string{"default/worker-0": "n2", "default/worker-1": "n2"}
+	s.Expect.Phases = map[string]vcapisv1.PodGroupPhase{"default/pg1": vcapisv1.PodGroupPending}
+	if err := s.Run(); err == nil {
+		t.Errorf("expected the differences of scenario %s to be reported", s.Name)
+	}
+}
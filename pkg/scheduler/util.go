@@ -26,12 +26,14 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/plugins"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/fairshare"
 	"volcano.sh/volcano/pkg/util"
 )
 
@@ -50,6 +52,8 @@ tiers:
   - name: nodeorder
 `
 
+// UnmarshalSchedulerConf returns the actions, plugin tiers, configurations and metrics configuration of the
+// scheduler configuration. Every call returns new instances of the actions, which keep their state between sessions.
 func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []conf.Configuration, map[string]string, error) {
 	var actions []framework.Action
 
@@ -58,6 +62,24 @@ func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []
 	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
 		return nil, nil, nil, nil, err
 	}
+	// drf and proportion update the same historical usage of the queues, so they must decay it alike.
+	var halfLife time.Duration
+	for _, tier := range schedulerConf.Tiers {
+		for _, plugin := range tier.Plugins {
+			if plugin.Name != "drf" && plugin.Name != "proportion" {
+				continue
+			}
+			fairShare := fairshare.ParseArguments(plugin.Arguments)
+			if !fairShare.Enabled {
+				continue
+			}
+			if halfLife != 0 && halfLife != fairShare.HalfLife {
+				return nil, nil, nil, nil, fmt.Errorf("fair-share half-life of drf and proportion conflicts")
+			}
+			halfLife = fairShare.HalfLife
+		}
+	}
+
 	// Set default settings for each plugin if not set
 	for i, tier := range schedulerConf.Tiers {
 		// drf with hierarchy enabled
@@ -80,9 +102,27 @@ func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []
 		}
 	}
 
+	if len(schedulerConf.Pipeline) > 0 {
+		if strings.TrimSpace(schedulerConf.Actions) != "" {
+			return nil, nil, nil, nil, fmt.Errorf("actions and pipeline can not be set together")
+		}
+		for _, option := range schedulerConf.Pipeline {
+			action, found := framework.NewAction(strings.TrimSpace(option.Action))
+			if !found {
+				return nil, nil, nil, nil, fmt.Errorf("failed to find Action %s", option.Action)
+			}
+			stage, err := framework.NewStage(action, option)
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+			actions = append(actions, stage)
+		}
+		return actions, schedulerConf.Tiers, schedulerConf.Configurations, schedulerConf.MetricsConfiguration, nil
+	}
+
 	actionNames := strings.Split(schedulerConf.Actions, ",")
 	for _, actionName := range actionNames {
-		if action, found := framework.GetAction(strings.TrimSpace(actionName)); found {
+		if action, found := framework.NewAction(strings.TrimSpace(actionName)); found {
 			actions = append(actions, action)
 		} else {
 			return nil, nil, nil, nil, fmt.Errorf("failed to find Action %s", actionName)
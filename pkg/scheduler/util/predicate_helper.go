@@ -39,6 +39,8 @@ type PredicateHelper interface {
 
 type predicateHelper struct {
 	taskPredicateErrorCache map[string]map[string]error
+	// dryRun skips the stage metrics for what-if and simulated sessions
+	dryRun bool
 }
 
 // PredicateNodes returns the specified number of nodes that fit a task
@@ -134,7 +136,9 @@ func (ph *predicateHelper) PredicateNodes(task *api.TaskInfo, nodes []*api.NodeI
 	//workqueue.ParallelizeUntil(context.TODO(), 16, len(nodes), checkNode)
 	predicateStart := time.Now()
 	workqueue.ParallelizeUntil(ctx, 16, allNodes, checkNode)
-	metrics.UpdateSchedulingStageDuration(metrics.SchedulingStagePredicate, time.Since(predicateStart))
+	if !ph.dryRun {
+		metrics.UpdateSchedulingStageDuration(metrics.SchedulingStagePredicate, time.Since(predicateStart))
+	}
 
 	newIndex := int64((startIndex + int(processedNodes)) % allNodes)
 	lastProcessedNodeIndex.Store(newIndex)
@@ -147,8 +151,9 @@ func taskGroupID(task *api.TaskInfo) string {
 	return fmt.Sprintf("%s/%s", task.Job, task.TaskRole)
 }
 
-func NewPredicateHelper() PredicateHelper {
-	return &predicateHelper{taskPredicateErrorCache: map[string]map[string]error{}}
+// NewPredicateHelper returns a PredicateHelper, dryRun is true for the sessions which only simulate scheduling.
+func NewPredicateHelper(dryRun bool) PredicateHelper {
+	return &predicateHelper{taskPredicateErrorCache: map[string]map[string]error{}, dryRun: dryRun}
 }
 
 // GetPredicatedNodeByShard return predicateNodes by shard
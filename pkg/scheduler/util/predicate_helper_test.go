@@ -182,7 +182,7 @@ func TestPredicateNodes(t *testing.T) {
 				ShardingMode:               tt.shardingMode,
 			}
 
-			ph := NewPredicateHelper()
+			ph := NewPredicateHelper(false)
 			result, fitErr := ph.PredicateNodes(tt.task, tt.nodes, tt.predicateFn, tt.enableErrorCache, tt.nodesInShard)
 
 			if len(result) != len(tt.expectedNodes) {
@@ -60,6 +60,15 @@ func (q *PriorityQueue) Pop() interface{} {
 	return heap.Pop(&q.queue)
 }
 
+// Peek returns the element which Pop would return without removing it, nil if the queue is empty
+func (q *PriorityQueue) Peek() interface{} {
+	if q.Len() == 0 {
+		return nil
+	}
+
+	return q.queue.items[0]
+}
+
 // Empty check if queue is empty
 func (q *PriorityQueue) Empty() bool {
 	return q.queue.Len() == 0
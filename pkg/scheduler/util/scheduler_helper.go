@@ -74,8 +74,9 @@ func CalculateNumOfFeasibleNodesToFind(numAllNodes int32) (numNodes int32) {
 	return numNodes
 }
 
-// PrioritizeNodes returns a map whose key is node's score and value are corresponding nodes
-func PrioritizeNodes(task *api.TaskInfo, nodes []*api.NodeInfo, batchFn api.BatchNodeOrderFn, mapFn api.NodeOrderMapFn, reduceFn api.NodeOrderReduceFn) map[float64][]*api.NodeInfo {
+// PrioritizeNodes returns a map whose key is node's score and value are corresponding nodes,
+// dryRun is true for the sessions which only simulate scheduling and skips the stage metrics.
+func PrioritizeNodes(task *api.TaskInfo, nodes []*api.NodeInfo, batchFn api.BatchNodeOrderFn, mapFn api.NodeOrderMapFn, reduceFn api.NodeOrderReduceFn, dryRun bool) map[float64][]*api.NodeInfo {
 	pluginNodeScoreMap := map[string]fwk.NodeScoreList{}
 	nodeOrderScoreMap := map[string]float64{}
 	nodeScores := map[float64][]*api.NodeInfo{}
@@ -115,7 +116,9 @@ func PrioritizeNodes(task *api.TaskInfo, nodes []*api.NodeInfo, batchFn api.Batc
 		klog.Errorf("Error in Calculating batch Priority for the node, err %v", err)
 		return nodeScores
 	}
-	metrics.UpdateSchedulingStageDuration(metrics.SchedulingStageScoring, time.Since(scoreStart))
+	if !dryRun {
+		metrics.UpdateSchedulingStageDuration(metrics.SchedulingStageScoring, time.Since(scoreStart))
+	}
 
 	nodeScoreMap := map[string]float64{}
 	for _, node := range nodes {
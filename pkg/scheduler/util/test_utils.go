@@ -22,6 +22,7 @@ package util
 
 import (
 	"fmt"
+	"maps"
 	"sync"
 
 	v1 "k8s.io/api/core/v1"
@@ -583,6 +584,7 @@ func (fb *FakeBinder) Bind(kubeClient kubernetes.Interface, tasks []*api.TaskInf
 type FakeEvictor struct {
 	sync.RWMutex
 	evicts  []string
+	reasons map[string]string
 	Channel chan string
 }
 
@@ -590,6 +592,7 @@ type FakeEvictor struct {
 func NewFakeEvictor(buffer int) *FakeEvictor {
 	return &FakeEvictor{
 		evicts:  make([]string, 0, buffer),
+		reasons: map[string]string{},
 		Channel: make(chan string, buffer),
 	}
 }
@@ -601,6 +604,13 @@ func (fe *FakeEvictor) Evicts() []string {
 	return append([]string{}, fe.evicts...)
 }
 
+// Reasons returns the reasons of the evicted pods by pod.
+func (fe *FakeEvictor) Reasons() map[string]string {
+	fe.RLock()
+	defer fe.RUnlock()
+	return maps.Clone(fe.reasons)
+}
+
 // Length returns the number of evicts
 func (fe *FakeEvictor) Length() int {
 	fe.RLock()
@@ -616,6 +626,7 @@ func (fe *FakeEvictor) Evict(p *v1.Pod, reason string) error {
 	fmt.Println("PodName: ", p.Name)
 	key := fmt.Sprintf("%v/%v", p.Namespace, p.Name)
 	fe.evicts = append(fe.evicts, key)
+	fe.reasons[key] = reason
 
 	fe.Channel <- key
 
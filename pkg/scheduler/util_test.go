@@ -27,6 +27,7 @@ import (
 
 	_ "volcano.sh/volcano/pkg/scheduler/actions"
 	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
 )
 
 func TestLoadSchedulerConf(t *testing.T) {
@@ -73,6 +74,7 @@ tiers:
 					EnabledSubJobPipelined:   &trueValue,
 					EnabledSubJobOrder:       &trueValue,
 					EnabledHyperNodeGradient: &trueValue,
+					EnabledVictimCost:        &trueValue,
 				},
 				{
 					Name:                     "gang",
@@ -99,6 +101,7 @@ tiers:
 					EnabledSubJobPipelined:   &trueValue,
 					EnabledSubJobOrder:       &trueValue,
 					EnabledHyperNodeGradient: &trueValue,
+					EnabledVictimCost:        &trueValue,
 				},
 				{
 					Name:                     "conformance",
@@ -125,6 +128,7 @@ tiers:
 					EnabledSubJobPipelined:   &trueValue,
 					EnabledSubJobOrder:       &trueValue,
 					EnabledHyperNodeGradient: &trueValue,
+					EnabledVictimCost:        &trueValue,
 				},
 			},
 		},
@@ -155,6 +159,7 @@ tiers:
 					EnabledSubJobPipelined:   &trueValue,
 					EnabledSubJobOrder:       &trueValue,
 					EnabledHyperNodeGradient: &trueValue,
+					EnabledVictimCost:        &trueValue,
 				},
 				{
 					Name:                     "predicates",
@@ -181,6 +186,7 @@ tiers:
 					EnabledSubJobPipelined:   &trueValue,
 					EnabledSubJobOrder:       &trueValue,
 					EnabledHyperNodeGradient: &trueValue,
+					EnabledVictimCost:        &trueValue,
 				},
 				{
 					Name:                     "proportion",
@@ -207,6 +213,7 @@ tiers:
 					EnabledSubJobPipelined:   &trueValue,
 					EnabledSubJobOrder:       &trueValue,
 					EnabledHyperNodeGradient: &trueValue,
+					EnabledVictimCost:        &trueValue,
 				},
 				{
 					Name:                     "nodeorder",
@@ -233,6 +240,7 @@ tiers:
 					EnabledSubJobPipelined:   &trueValue,
 					EnabledSubJobOrder:       &trueValue,
 					EnabledHyperNodeGradient: &trueValue,
+					EnabledVictimCost:        &trueValue,
 				},
 			},
 		},
@@ -253,3 +261,75 @@ tiers:
 			expectedConfigurations, configurations)
 	}
 }
+
+func TestLoadSchedulerConfPipeline(t *testing.T) {
+	configuration := `
+pipeline:
+- action: enqueue
+- action: allocate
+  timeout: 500ms
+  arguments:
+    predicateErrorCacheEnable: false
+- action: reclaim
+  when: [starvingQueue]
+- action: shuffle
+  interval: 5m
+  everySessions: 10
+configurations:
+- name: allocate
+  arguments:
+    predicateErrorCacheEnable: true
+tiers:
+- plugins:
+  - name: priority
+`
+
+	actions, _, configurations, _, err := UnmarshalSchedulerConf(configuration)
+	if err != nil {
+		t.Fatalf("Failed to load Scheduler configuration: %v", err)
+	}
+	var names []string
+	for _, action := range actions {
+		if _, ok := action.(*framework.Stage); !ok {
+			t.Errorf("Expected action %s to be a stage of the pipeline", action.Name())
+		}
+		names = append(names, action.Name())
+	}
+	if !equality.Semantic.DeepEqual(names, []string{"enqueue", "allocate", "reclaim", "shuffle"}) {
+		t.Errorf("Wrong actions, got %v", names)
+	}
+	// The arguments of the stages are not merged into the configurations, they apply while the stage runs.
+	expectedConfigurations := []conf.Configuration{
+		{
+			Name: "allocate",
+			Arguments: map[string]interface{}{
+				"predicateErrorCacheEnable": true,
+			},
+		},
+	}
+	if !equality.Semantic.DeepEqual(configurations, expectedConfigurations) {
+		t.Errorf("Wrong configuration, expected: %+v, got %+v", expectedConfigurations, configurations)
+	}
+
+	for name, invalid := range map[string]string{
+		"actions and pipeline": "actions: \"allocate\"\npipeline:\n- action: allocate\n",
+		"unknown condition":    "pipeline:\n- action: reclaim\n  when: [sunny]\n",
+		"invalid timeout":      "pipeline:\n- action: allocate\n  timeout: soon\n",
+		"fair-share half-life conflicts": `
+actions: "allocate"
+tiers:
+- plugins:
+  - name: drf
+    arguments:
+      fairShare.enable: true
+      fairShare.halfLife: 24h
+  - name: proportion
+    arguments:
+      fairShare.enable: true
+`,
+	} {
+		if _, _, _, _, err := UnmarshalSchedulerConf(invalid); err == nil {
+			t.Errorf("Expected configuration with %s to be invalid", name)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// The annotations below are written by the controllers and read by the scheduler.
+const (
+	// JobRunningEstimate is the podgroup annotation which carries the estimated running duration of a job,
+	// e.g. `2h30m`. Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	JobRunningEstimate = "volcano.sh/running-estimate"
+
+	// NodeSuspicionKey is the node annotation of the number of jobs which stopped scheduling their pods to the node
+	// for the infrastructure failures on it, which the nodeorder plugin lowers the score of the node by.
+	NodeSuspicionKey = "volcano.sh/node-suspicion"
+	// NodeSuspicionTimeKey is the node annotation of the time the suspicion of the node was last raised.
+	NodeSuspicionTimeKey = "volcano.sh/node-suspicion-time"
+	// NodeSuspicionExpiry is the time after which the suspicion of a node expires if it is not raised again.
+	NodeSuspicionExpiry = 24 * time.Hour
+)
+
+// GetNodeSuspicion returns the number of jobs which stopped scheduling their pods to the node for the infrastructure
+// failures on it, or 0 if the suspicion was last raised more than NodeSuspicionExpiry before now.
+func GetNodeSuspicion(node *v1.Node, now time.Time) int {
+	raised, err := time.Parse(time.RFC3339, node.Annotations[NodeSuspicionTimeKey])
+	if err != nil || now.Sub(raised) >= NodeSuspicionExpiry {
+		return 0
+	}
+	suspicion, err := strconv.Atoi(node.Annotations[NodeSuspicionKey])
+	if err != nil || suspicion < 0 {
+		return 0
+	}
+	return suspicion
+}
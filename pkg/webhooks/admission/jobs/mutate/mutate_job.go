@@ -27,6 +27,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/deepspeed"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/mpi"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/pytorch"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/ray"
@@ -241,19 +242,21 @@ func patchDefaultPlugins(job *v1alpha1.Job) *patchOperation {
 		plugins[k] = v
 	}
 
-	// Because the tensorflow-plugin, mpi-plugin and pytorch-plugin depend on svc-plugin.
+	// Because the tensorflow-plugin, mpi-plugin, pytorch-plugin, ray-plugin and deepspeed-plugin depend on svc-plugin.
 	// If the svc-plugin is not defined, we should add it.
 	_, hasTf := job.Spec.Plugins[tensorflow.TFPluginName]
 	_, hasMPI := job.Spec.Plugins[mpi.MPIPluginName]
 	_, hasPytorch := job.Spec.Plugins[pytorch.PytorchPluginName]
 	_, hasRay := job.Spec.Plugins[ray.RayPluginName]
-	if hasTf || hasMPI || hasPytorch || hasRay {
+	_, hasDeepSpeed := job.Spec.Plugins[deepspeed.DeepSpeedPluginName]
+	if hasTf || hasMPI || hasPytorch || hasRay || hasDeepSpeed {
 		if _, ok := plugins["svc"]; !ok {
 			plugins["svc"] = []string{}
 		}
 	}
 
-	if _, ok := job.Spec.Plugins["mpi"]; ok {
+	// The launchers of mpi-plugin and deepspeed-plugin reach the workers over ssh.
+	if hasMPI || hasDeepSpeed {
 		if _, ok := plugins["ssh"]; !ok {
 			plugins["ssh"] = []string{}
 		}
@@ -410,6 +410,30 @@ type JobStatus struct {
 	// +optional
 	RetryCount int32 `json:"retryCount,omitempty" protobuf:"bytes,10,opt,name=retryCount"`
 
+	// The number of Job retries caused by infrastructure failures, e.g. evicted pods or lost nodes, which are also
+	// counted in RetryCount.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InfrastructureRetryCount int32 `json:"infrastructureRetryCount,omitempty" protobuf:"bytes,22,opt,name=infrastructureRetryCount"`
+
+	// The time before which the pods of the restarting Job are not recreated.
+	// +optional
+	RestartAfter *metav1.Time `json:"restartAfter,omitempty" protobuf:"bytes,23,opt,name=restartAfter"`
+
+	// The sequence number of the latest checkpoint taken by all pods of the Job.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	LatestCheckpoint int32 `json:"latestCheckpoint,omitempty" protobuf:"bytes,24,opt,name=latestCheckpoint"`
+
+	// The number of failures of the Job on each node, counted once for a restart however many pods failed on it.
+	// +optional
+	FailedNodes map[string]int32 `json:"failedNodes,omitempty" protobuf:"bytes,25,rep,name=failedNodes"`
+
+	// The checkpoint taken by the pods killed by a planned action, which becomes the latest checkpoint once all pods
+	// of the Job have confirmed it.
+	// +optional
+	PendingCheckpoint *JobCheckpoint `json:"pendingCheckpoint,omitempty" protobuf:"bytes,26,opt,name=pendingCheckpoint"`
+
 	// The job running duration is the length of time from job running to complete.
 	// +optional
 	RunningDuration *metav1.Duration `json:"runningDuration,omitempty" protobuf:"bytes,11,opt,name=runningDuration"`
@@ -445,6 +469,16 @@ type JobCondition struct {
 	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,2,opt,name=lastTransitionTime"`
 }
 
+// JobCheckpoint identifies a checkpoint taken by the pods of a job.
+type JobCheckpoint struct {
+	// The sequence number of the checkpoint.
+	// +kubebuilder:validation:Minimum=1
+	Sequence int32 `json:"sequence" protobuf:"bytes,1,opt,name=sequence"`
+	// The version of the job the pods taking the checkpoint were created for.
+	// +kubebuilder:validation:Minimum=0
+	Version int32 `json:"version" protobuf:"bytes,2,opt,name=version"`
+}
+
 // Iteration defines the phase of the iteration.
 type Iteration string
 
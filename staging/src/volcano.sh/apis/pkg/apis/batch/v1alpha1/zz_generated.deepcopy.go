@@ -228,6 +228,22 @@ func (in *JobCondition) DeepCopy() *JobCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobCheckpoint) DeepCopyInto(out *JobCheckpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobCheckpoint.
+func (in *JobCheckpoint) DeepCopy() *JobCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(JobCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JobList) DeepCopyInto(out *JobList) {
 	*out = *in
@@ -361,6 +377,22 @@ func (in *JobStatus) DeepCopyInto(out *JobStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.RestartAfter != nil {
+		in, out := &in.RestartAfter, &out.RestartAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.FailedNodes != nil {
+		in, out := &in.FailedNodes, &out.FailedNodes
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PendingCheckpoint != nil {
+		in, out := &in.PendingCheckpoint, &out.PendingCheckpoint
+		*out = new(JobCheckpoint)
+		**out = **in
+	}
 	if in.RunningDuration != nil {
 		in, out := &in.RunningDuration, &out.RunningDuration
 		*out = new(metav1.Duration)
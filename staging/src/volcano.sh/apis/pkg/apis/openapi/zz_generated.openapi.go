@@ -107,6 +107,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"volcano.sh/apis/pkg/apis/scheduling/v1beta1.QueueList":             schema_pkg_apis_scheduling_v1beta1_QueueList(ref),
 		"volcano.sh/apis/pkg/apis/scheduling/v1beta1.QueueSpec":             schema_pkg_apis_scheduling_v1beta1_QueueSpec(ref),
 		"volcano.sh/apis/pkg/apis/scheduling/v1beta1.QueueStatus":           schema_pkg_apis_scheduling_v1beta1_QueueStatus(ref),
+		"volcano.sh/apis/pkg/apis/scheduling/v1beta1.QueueUsage":            schema_pkg_apis_scheduling_v1beta1_QueueUsage(ref),
 		"volcano.sh/apis/pkg/apis/scheduling/v1beta1.Reservation":           schema_pkg_apis_scheduling_v1beta1_Reservation(ref),
 	}
 }
@@ -3933,12 +3934,75 @@ func schema_pkg_apis_scheduling_v1beta1_QueueStatus(ref common.ReferenceCallback
 							},
 						},
 					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage is the historical resource consumption of the queue, it is maintained by the scheduler when fair-share by decayed usage is enabled.",
+							Ref:         ref("volcano.sh/apis/pkg/apis/scheduling/v1beta1.QueueUsage"),
+						},
+					},
 				},
 				Required: []string{"allocated"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "volcano.sh/apis/pkg/apis/scheduling/v1beta1.Reservation"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "volcano.sh/apis/pkg/apis/scheduling/v1beta1.QueueUsage", "volcano.sh/apis/pkg/apis/scheduling/v1beta1.Reservation"},
+	}
+}
+
+func schema_pkg_apis_scheduling_v1beta1_QueueUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QueueUsage is the historical resource consumption of a queue, decayed exponentially over time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources is the decayed consumption of the queue in resource-seconds, e.g. 3600 cpu is one cpu used for an hour.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces is the decayed consumption in resource-seconds of each namespace in the queue.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"object"},
+										AdditionalProperties: &spec.SchemaOrBool{
+											Allows: true,
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the last time the usage was decayed and accumulated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
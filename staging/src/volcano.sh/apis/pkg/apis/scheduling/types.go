@@ -64,6 +64,9 @@ const (
 
 	// PodGroupScheduled is scheduled event type
 	PodGroupScheduled PodGroupConditionType = "Scheduled"
+
+	// PodGroupQueuedType is the condition of a waiting PodGroup which tells its position in the queue
+	PodGroupQueuedType PodGroupConditionType = "Queued"
 )
 
 type PodGroupConditionDetail string
@@ -372,6 +375,25 @@ type QueueStatus struct {
 	// Allocated is allocated resources in queue
 	// +optional
 	Allocated v1.ResourceList `json:"allocated,omitempty" protobuf:"bytes,8,opt,name=allocated"`
+
+	// Usage is the historical resource consumption of the queue, it is maintained by the scheduler
+	// when fair-share by decayed usage is enabled.
+	// +optional
+	Usage *QueueUsage `json:"usage,omitempty" protobuf:"bytes,9,opt,name=usage"`
+}
+
+// QueueUsage is the historical resource consumption of a queue, decayed exponentially over time.
+type QueueUsage struct {
+	// Resources is the decayed consumption of the queue in resource-seconds,
+	// e.g. 3600 cpu is one cpu used for an hour.
+	// +optional
+	Resources v1.ResourceList `json:"resources,omitempty" protobuf:"bytes,1,opt,name=resources"`
+	// Namespaces is the decayed consumption in resource-seconds of each namespace in the queue.
+	// +optional
+	Namespaces map[string]v1.ResourceList `json:"namespaces,omitempty" protobuf:"bytes,2,rep,name=namespaces"`
+	// LastUpdateTime is the last time the usage was decayed and accumulated.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,3,opt,name=lastUpdateTime"`
 }
 
 // CluterSpec represents the template of Cluster
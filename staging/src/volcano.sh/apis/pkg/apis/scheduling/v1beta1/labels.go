@@ -81,3 +81,20 @@ const TopologyDecisionAnnotation = "volcano.sh/topology-decision"
 
 // PodQosLevel is the key of pod qos level
 const PodQosLevel = "volcano.sh/qos-level"
+
+// PodCheckpointBeforeEviction is the annotation key of Pod to opt in to checkpoint before eviction, if it is "true"
+// the scheduler requests a checkpoint by PodCheckpointRequested and waits for the acknowledgement by
+// PodLastCheckpointTime before the pod is evicted.
+const PodCheckpointBeforeEviction = AnnotationPrefix + "checkpoint-before-eviction"
+
+// PodCheckpointTimeout is the annotation key of Pod to set how long the scheduler waits for the checkpoint
+// before eviction, e.g. "2m". It is bounded by the timeout configured on the scheduler.
+const PodCheckpointTimeout = AnnotationPrefix + "checkpoint-timeout"
+
+// PodCheckpointRequested is the annotation key set on Pod by the scheduler, in RFC3339 format, when the pod is
+// about to be evicted and should checkpoint.
+const PodCheckpointRequested = AnnotationPrefix + "checkpoint-requested"
+
+// PodLastCheckpointTime is the annotation key set on Pod by the workload, in RFC3339 format, when it completed a
+// checkpoint. A checkpoint no earlier than PodCheckpointRequested acknowledges the request.
+const PodLastCheckpointTime = AnnotationPrefix + "last-checkpoint-time"
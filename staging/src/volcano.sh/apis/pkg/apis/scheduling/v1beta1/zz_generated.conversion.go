@@ -187,6 +187,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QueueUsage)(nil), (*scheduling.QueueUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueUsage_To_scheduling_QueueUsage(a.(*QueueUsage), b.(*scheduling.QueueUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueUsage)(nil), (*QueueUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueUsage_To_v1beta1_QueueUsage(a.(*scheduling.QueueUsage), b.(*QueueUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Reservation)(nil), (*scheduling.Reservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Reservation_To_scheduling_Reservation(a.(*Reservation), b.(*scheduling.Reservation), scope)
 	}); err != nil {
@@ -597,6 +607,7 @@ func autoConvert_v1beta1_QueueStatus_To_scheduling_QueueStatus(in *QueueStatus,
 		return err
 	}
 	out.Allocated = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Usage = (*scheduling.QueueUsage)(unsafe.Pointer(in.Usage))
 	return nil
 }
 
@@ -616,6 +627,7 @@ func autoConvert_scheduling_QueueStatus_To_v1beta1_QueueStatus(in *scheduling.Qu
 		return err
 	}
 	out.Allocated = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Usage = (*QueueUsage)(unsafe.Pointer(in.Usage))
 	return nil
 }
 
@@ -624,6 +636,30 @@ func Convert_scheduling_QueueStatus_To_v1beta1_QueueStatus(in *scheduling.QueueS
 	return autoConvert_scheduling_QueueStatus_To_v1beta1_QueueStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_QueueUsage_To_scheduling_QueueUsage(in *QueueUsage, out *scheduling.QueueUsage, s conversion.Scope) error {
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.Namespaces = *(*map[string]v1.ResourceList)(unsafe.Pointer(&in.Namespaces))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_QueueUsage_To_scheduling_QueueUsage is an autogenerated conversion function.
+func Convert_v1beta1_QueueUsage_To_scheduling_QueueUsage(in *QueueUsage, out *scheduling.QueueUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueUsage_To_scheduling_QueueUsage(in, out, s)
+}
+
+func autoConvert_scheduling_QueueUsage_To_v1beta1_QueueUsage(in *scheduling.QueueUsage, out *QueueUsage, s conversion.Scope) error {
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.Namespaces = *(*map[string]v1.ResourceList)(unsafe.Pointer(&in.Namespaces))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_scheduling_QueueUsage_To_v1beta1_QueueUsage is an autogenerated conversion function.
+func Convert_scheduling_QueueUsage_To_v1beta1_QueueUsage(in *scheduling.QueueUsage, out *QueueUsage, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueUsage_To_v1beta1_QueueUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_Reservation_To_scheduling_Reservation(in *Reservation, out *scheduling.Reservation, s conversion.Scope) error {
 	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
 	out.Resource = *(*v1.ResourceList)(unsafe.Pointer(&in.Resource))
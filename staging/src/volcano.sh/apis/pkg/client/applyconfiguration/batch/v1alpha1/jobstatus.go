@@ -48,6 +48,18 @@ type JobStatusApplyConfiguration struct {
 	Version *int32 `json:"version,omitempty"`
 	// The number of Job retries.
 	RetryCount *int32 `json:"retryCount,omitempty"`
+	// The number of Job retries caused by infrastructure failures, e.g. evicted pods or lost nodes, which are also
+	// counted in RetryCount.
+	InfrastructureRetryCount *int32 `json:"infrastructureRetryCount,omitempty"`
+	// The time before which the pods of the restarting Job are not recreated.
+	RestartAfter *v1.Time `json:"restartAfter,omitempty"`
+	// The sequence number of the latest checkpoint taken by all pods of the Job.
+	LatestCheckpoint *int32 `json:"latestCheckpoint,omitempty"`
+	// The number of failures of the Job on each node, counted once for a restart however many pods failed on it.
+	FailedNodes map[string]int32 `json:"failedNodes,omitempty"`
+	// The checkpoint taken by the pods killed by a planned action, which becomes the latest checkpoint once all pods
+	// of the Job have confirmed it.
+	PendingCheckpoint *JobCheckpointApplyConfiguration `json:"pendingCheckpoint,omitempty"`
 	// The job running duration is the length of time from job running to complete.
 	RunningDuration *v1.Duration `json:"runningDuration,omitempty"`
 	// The resources that controlled by this job, e.g. Service, ConfigMap
@@ -156,6 +168,52 @@ func (b *JobStatusApplyConfiguration) WithRetryCount(value int32) *JobStatusAppl
 	return b
 }
 
+// WithInfrastructureRetryCount sets the InfrastructureRetryCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InfrastructureRetryCount field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithInfrastructureRetryCount(value int32) *JobStatusApplyConfiguration {
+	b.InfrastructureRetryCount = &value
+	return b
+}
+
+// WithRestartAfter sets the RestartAfter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartAfter field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithRestartAfter(value v1.Time) *JobStatusApplyConfiguration {
+	b.RestartAfter = &value
+	return b
+}
+
+// WithLatestCheckpoint sets the LatestCheckpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LatestCheckpoint field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithLatestCheckpoint(value int32) *JobStatusApplyConfiguration {
+	b.LatestCheckpoint = &value
+	return b
+}
+
+// WithFailedNodes puts the entries into the FailedNodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the FailedNodes field,
+// overwriting an existing map entries in FailedNodes field with the same key.
+func (b *JobStatusApplyConfiguration) WithFailedNodes(entries map[string]int32) *JobStatusApplyConfiguration {
+	if b.FailedNodes == nil && len(entries) > 0 {
+		b.FailedNodes = make(map[string]int32, len(entries))
+	}
+	for k, v := range entries {
+		b.FailedNodes[k] = v
+	}
+	return b
+}
+
+// WithPendingCheckpoint sets the PendingCheckpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PendingCheckpoint field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithPendingCheckpoint(value *JobCheckpointApplyConfiguration) *JobStatusApplyConfiguration {
+	b.PendingCheckpoint = value
+	return b
+}
+
 // WithRunningDuration sets the RunningDuration field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RunningDuration field is set to the value of the last call.
@@ -43,6 +43,9 @@ type QueueStatusApplyConfiguration struct {
 	Reservation *ReservationApplyConfiguration `json:"reservation,omitempty"`
 	// Allocated is allocated resources in queue
 	Allocated *v1.ResourceList `json:"allocated,omitempty"`
+	// Usage is the historical resource consumption of the queue, it is maintained by the scheduler
+	// when fair-share by decayed usage is enabled.
+	Usage *QueueUsageApplyConfiguration `json:"usage,omitempty"`
 }
 
 // QueueStatusApplyConfiguration constructs a declarative configuration of the QueueStatus type for use with
@@ -114,3 +117,11 @@ func (b *QueueStatusApplyConfiguration) WithAllocated(value v1.ResourceList) *Qu
 	b.Allocated = &value
 	return b
 }
+
+// WithUsage sets the Usage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Usage field is set to the value of the last call.
+func (b *QueueStatusApplyConfiguration) WithUsage(value *QueueUsageApplyConfiguration) *QueueStatusApplyConfiguration {
+	b.Usage = value
+	return b
+}
@@ -59,6 +59,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &batchv1alpha1.DependsOnApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Job"):
 		return &batchv1alpha1.JobApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JobCheckpoint"):
+		return &batchv1alpha1.JobCheckpointApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("JobCondition"):
 		return &batchv1alpha1.JobConditionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("JobSpec"):
@@ -191,6 +193,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &schedulingv1beta1.QueueSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("QueueStatus"):
 		return &schedulingv1beta1.QueueStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueUsage"):
+		return &schedulingv1beta1.QueueUsageApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Reservation"):
 		return &schedulingv1beta1.ReservationApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("SubGroupPolicySpec"):